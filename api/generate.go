@@ -0,0 +1,4 @@
+package openapi
+
+//go:generate sh -c "oapi-codegen -generate types,chi-server,spec -package openapi specfile/openapi.yml > server.gen.go"
+//go:generate sh -c "oapi-codegen -generate client -package openapi specfile/openapi.yml > client.gen.go"
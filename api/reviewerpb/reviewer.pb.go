@@ -0,0 +1,3222 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: reviewer.proto
+
+// gRPC API сервиса назначения ревьюверов. Операции и значения перечислений (статусы, роли, сортировки)
+// совпадают с REST API из specfile/openapi.yml; ошибки - статусы gRPC с кодом ErrorResponse в ErrorInfo.reason.
+// Организация запроса - метаданные authorization (Bearer-токен) или x-organization, инициатор - user-id
+
+package reviewerpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type TeamMember struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	UserId   string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	IsActive bool                   `protobuf:"varint,3,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	// member, lead или observer; пусто - member
+	Role string `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
+	// только в ответах
+	IsPrimary     bool `protobuf:"varint,5,opt,name=is_primary,json=isPrimary,proto3" json:"is_primary,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TeamMember) Reset() {
+	*x = TeamMember{}
+	mi := &file_reviewer_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamMember) ProtoMessage() {}
+
+func (x *TeamMember) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamMember.ProtoReflect.Descriptor instead.
+func (*TeamMember) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TeamMember) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *TeamMember) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *TeamMember) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *TeamMember) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *TeamMember) GetIsPrimary() bool {
+	if x != nil {
+		return x.IsPrimary
+	}
+	return false
+}
+
+type TeamSla struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	FirstReviewHours int32                  `protobuf:"varint,1,opt,name=first_review_hours,json=firstReviewHours,proto3" json:"first_review_hours,omitempty"`
+	// remind или reassign
+	Action        string `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TeamSla) Reset() {
+	*x = TeamSla{}
+	mi := &file_reviewer_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamSla) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamSla) ProtoMessage() {}
+
+func (x *TeamSla) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamSla.ProtoReflect.Descriptor instead.
+func (*TeamSla) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TeamSla) GetFirstReviewHours() int32 {
+	if x != nil {
+		return x.FirstReviewHours
+	}
+	return 0
+}
+
+func (x *TeamSla) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+type Team struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	Members       []*TeamMember          `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
+	Sla           *TeamSla               `protobuf:"bytes,3,opt,name=sla,proto3" json:"sla,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Team) Reset() {
+	*x = Team{}
+	mi := &file_reviewer_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Team) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Team) ProtoMessage() {}
+
+func (x *Team) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Team.ProtoReflect.Descriptor instead.
+func (*Team) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Team) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *Team) GetMembers() []*TeamMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *Team) GetSla() *TeamSla {
+	if x != nil {
+		return x.Sla
+	}
+	return nil
+}
+
+type User struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	TeamName      string                 `protobuf:"bytes,3,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	IsActive      bool                   `protobuf:"varint,4,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *User) Reset() {
+	*x = User{}
+	mi := &file_reviewer_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*User) ProtoMessage() {}
+
+func (x *User) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use User.ProtoReflect.Descriptor instead.
+func (*User) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *User) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *User) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *User) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *User) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+type Reassignment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	Repository    *string                `protobuf:"bytes,2,opt,name=repository,proto3,oneof" json:"repository,omitempty"`
+	OldReviewerId string                 `protobuf:"bytes,3,opt,name=old_reviewer_id,json=oldReviewerId,proto3" json:"old_reviewer_id,omitempty"`
+	NewReviewerId string                 `protobuf:"bytes,4,opt,name=new_reviewer_id,json=newReviewerId,proto3" json:"new_reviewer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Reassignment) Reset() {
+	*x = Reassignment{}
+	mi := &file_reviewer_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Reassignment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reassignment) ProtoMessage() {}
+
+func (x *Reassignment) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reassignment.ProtoReflect.Descriptor instead.
+func (*Reassignment) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Reassignment) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *Reassignment) GetRepository() string {
+	if x != nil && x.Repository != nil {
+		return *x.Repository
+	}
+	return ""
+}
+
+func (x *Reassignment) GetOldReviewerId() string {
+	if x != nil {
+		return x.OldReviewerId
+	}
+	return ""
+}
+
+func (x *Reassignment) GetNewReviewerId() string {
+	if x != nil {
+		return x.NewReviewerId
+	}
+	return ""
+}
+
+type AddTeamRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Team  *Team                  `protobuf:"bytes,1,opt,name=team,proto3" json:"team,omitempty"`
+	// исключить из команды участников, которых нет в team.members
+	Prune bool `protobuf:"varint,2,opt,name=prune,proto3" json:"prune,omitempty"`
+	// только посчитать изменения
+	DryRun        bool `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddTeamRequest) Reset() {
+	*x = AddTeamRequest{}
+	mi := &file_reviewer_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddTeamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTeamRequest) ProtoMessage() {}
+
+func (x *AddTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTeamRequest.ProtoReflect.Descriptor instead.
+func (*AddTeamRequest) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *AddTeamRequest) GetTeam() *Team {
+	if x != nil {
+		return x.Team
+	}
+	return nil
+}
+
+func (x *AddTeamRequest) GetPrune() bool {
+	if x != nil {
+		return x.Prune
+	}
+	return false
+}
+
+func (x *AddTeamRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type TeamMemberUpdate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ChangedFields []string               `protobuf:"bytes,2,rep,name=changed_fields,json=changedFields,proto3" json:"changed_fields,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TeamMemberUpdate) Reset() {
+	*x = TeamMemberUpdate{}
+	mi := &file_reviewer_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamMemberUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamMemberUpdate) ProtoMessage() {}
+
+func (x *TeamMemberUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamMemberUpdate.ProtoReflect.Descriptor instead.
+func (*TeamMemberUpdate) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *TeamMemberUpdate) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *TeamMemberUpdate) GetChangedFields() []string {
+	if x != nil {
+		return x.ChangedFields
+	}
+	return nil
+}
+
+type TeamSyncDiff struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamCreated   bool                   `protobuf:"varint,1,opt,name=team_created,json=teamCreated,proto3" json:"team_created,omitempty"`
+	Created       []string               `protobuf:"bytes,2,rep,name=created,proto3" json:"created,omitempty"`
+	Attached      []string               `protobuf:"bytes,3,rep,name=attached,proto3" json:"attached,omitempty"`
+	Updated       []*TeamMemberUpdate    `protobuf:"bytes,4,rep,name=updated,proto3" json:"updated,omitempty"`
+	Detached      []string               `protobuf:"bytes,5,rep,name=detached,proto3" json:"detached,omitempty"`
+	Reassignments []*Reassignment        `protobuf:"bytes,6,rep,name=reassignments,proto3" json:"reassignments,omitempty"`
+	NotReassigned []string               `protobuf:"bytes,7,rep,name=not_reassigned,json=notReassigned,proto3" json:"not_reassigned,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TeamSyncDiff) Reset() {
+	*x = TeamSyncDiff{}
+	mi := &file_reviewer_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamSyncDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamSyncDiff) ProtoMessage() {}
+
+func (x *TeamSyncDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamSyncDiff.ProtoReflect.Descriptor instead.
+func (*TeamSyncDiff) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *TeamSyncDiff) GetTeamCreated() bool {
+	if x != nil {
+		return x.TeamCreated
+	}
+	return false
+}
+
+func (x *TeamSyncDiff) GetCreated() []string {
+	if x != nil {
+		return x.Created
+	}
+	return nil
+}
+
+func (x *TeamSyncDiff) GetAttached() []string {
+	if x != nil {
+		return x.Attached
+	}
+	return nil
+}
+
+func (x *TeamSyncDiff) GetUpdated() []*TeamMemberUpdate {
+	if x != nil {
+		return x.Updated
+	}
+	return nil
+}
+
+func (x *TeamSyncDiff) GetDetached() []string {
+	if x != nil {
+		return x.Detached
+	}
+	return nil
+}
+
+func (x *TeamSyncDiff) GetReassignments() []*Reassignment {
+	if x != nil {
+		return x.Reassignments
+	}
+	return nil
+}
+
+func (x *TeamSyncDiff) GetNotReassigned() []string {
+	if x != nil {
+		return x.NotReassigned
+	}
+	return nil
+}
+
+type AddTeamResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Team          *Team                  `protobuf:"bytes,1,opt,name=team,proto3" json:"team,omitempty"`
+	Diff          *TeamSyncDiff          `protobuf:"bytes,2,opt,name=diff,proto3" json:"diff,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddTeamResponse) Reset() {
+	*x = AddTeamResponse{}
+	mi := &file_reviewer_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddTeamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTeamResponse) ProtoMessage() {}
+
+func (x *AddTeamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTeamResponse.ProtoReflect.Descriptor instead.
+func (*AddTeamResponse) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *AddTeamResponse) GetTeam() *Team {
+	if x != nil {
+		return x.Team
+	}
+	return nil
+}
+
+func (x *AddTeamResponse) GetDiff() *TeamSyncDiff {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+type GetTeamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTeamRequest) Reset() {
+	*x = GetTeamRequest{}
+	mi := &file_reviewer_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTeamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTeamRequest) ProtoMessage() {}
+
+func (x *GetTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTeamRequest.ProtoReflect.Descriptor instead.
+func (*GetTeamRequest) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetTeamRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+type AddTeamMembersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	UserIds       []string               `protobuf:"bytes,2,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	Role          *string                `protobuf:"bytes,3,opt,name=role,proto3,oneof" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddTeamMembersRequest) Reset() {
+	*x = AddTeamMembersRequest{}
+	mi := &file_reviewer_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddTeamMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTeamMembersRequest) ProtoMessage() {}
+
+func (x *AddTeamMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTeamMembersRequest.ProtoReflect.Descriptor instead.
+func (*AddTeamMembersRequest) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *AddTeamMembersRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *AddTeamMembersRequest) GetUserIds() []string {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+func (x *AddTeamMembersRequest) GetRole() string {
+	if x != nil && x.Role != nil {
+		return *x.Role
+	}
+	return ""
+}
+
+type RemoveTeamMembersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	UserIds       []string               `protobuf:"bytes,2,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveTeamMembersRequest) Reset() {
+	*x = RemoveTeamMembersRequest{}
+	mi := &file_reviewer_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveTeamMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveTeamMembersRequest) ProtoMessage() {}
+
+func (x *RemoveTeamMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveTeamMembersRequest.ProtoReflect.Descriptor instead.
+func (*RemoveTeamMembersRequest) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RemoveTeamMembersRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *RemoveTeamMembersRequest) GetUserIds() []string {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+type MoveTeamMemberRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	UserId     string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ToTeamName string                 `protobuf:"bytes,2,opt,name=to_team_name,json=toTeamName,proto3" json:"to_team_name,omitempty"`
+	// команда, из которой переводится пользователь; обязательна, если он состоит в нескольких
+	FromTeamName  *string `protobuf:"bytes,3,opt,name=from_team_name,json=fromTeamName,proto3,oneof" json:"from_team_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveTeamMemberRequest) Reset() {
+	*x = MoveTeamMemberRequest{}
+	mi := &file_reviewer_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveTeamMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveTeamMemberRequest) ProtoMessage() {}
+
+func (x *MoveTeamMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveTeamMemberRequest.ProtoReflect.Descriptor instead.
+func (*MoveTeamMemberRequest) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *MoveTeamMemberRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *MoveTeamMemberRequest) GetToTeamName() string {
+	if x != nil {
+		return x.ToTeamName
+	}
+	return ""
+}
+
+func (x *MoveTeamMemberRequest) GetFromTeamName() string {
+	if x != nil && x.FromTeamName != nil {
+		return *x.FromTeamName
+	}
+	return ""
+}
+
+type TeamMembersChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Team          *Team                  `protobuf:"bytes,1,opt,name=team,proto3" json:"team,omitempty"`
+	Reassignments []*Reassignment        `protobuf:"bytes,2,rep,name=reassignments,proto3" json:"reassignments,omitempty"`
+	NotReassigned []string               `protobuf:"bytes,3,rep,name=not_reassigned,json=notReassigned,proto3" json:"not_reassigned,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TeamMembersChange) Reset() {
+	*x = TeamMembersChange{}
+	mi := &file_reviewer_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamMembersChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamMembersChange) ProtoMessage() {}
+
+func (x *TeamMembersChange) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamMembersChange.ProtoReflect.Descriptor instead.
+func (*TeamMembersChange) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *TeamMembersChange) GetTeam() *Team {
+	if x != nil {
+		return x.Team
+	}
+	return nil
+}
+
+func (x *TeamMembersChange) GetReassignments() []*Reassignment {
+	if x != nil {
+		return x.Reassignments
+	}
+	return nil
+}
+
+func (x *TeamMembersChange) GetNotReassigned() []string {
+	if x != nil {
+		return x.NotReassigned
+	}
+	return nil
+}
+
+type RenameTeamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	NewTeamName   string                 `protobuf:"bytes,2,opt,name=new_team_name,json=newTeamName,proto3" json:"new_team_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameTeamRequest) Reset() {
+	*x = RenameTeamRequest{}
+	mi := &file_reviewer_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameTeamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameTeamRequest) ProtoMessage() {}
+
+func (x *RenameTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameTeamRequest.ProtoReflect.Descriptor instead.
+func (*RenameTeamRequest) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RenameTeamRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *RenameTeamRequest) GetNewTeamName() string {
+	if x != nil {
+		return x.NewTeamName
+	}
+	return ""
+}
+
+type SetTeamSlaRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	TeamName         string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	FirstReviewHours int32                  `protobuf:"varint,2,opt,name=first_review_hours,json=firstReviewHours,proto3" json:"first_review_hours,omitempty"`
+	Action           string                 `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SetTeamSlaRequest) Reset() {
+	*x = SetTeamSlaRequest{}
+	mi := &file_reviewer_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTeamSlaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTeamSlaRequest) ProtoMessage() {}
+
+func (x *SetTeamSlaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTeamSlaRequest.ProtoReflect.Descriptor instead.
+func (*SetTeamSlaRequest) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SetTeamSlaRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *SetTeamSlaRequest) GetFirstReviewHours() int32 {
+	if x != nil {
+		return x.FirstReviewHours
+	}
+	return 0
+}
+
+func (x *SetTeamSlaRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+type DeleteTeamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTeamRequest) Reset() {
+	*x = DeleteTeamRequest{}
+	mi := &file_reviewer_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTeamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTeamRequest) ProtoMessage() {}
+
+func (x *DeleteTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTeamRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTeamRequest) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *DeleteTeamRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+type DeleteTeamResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTeamResponse) Reset() {
+	*x = DeleteTeamResponse{}
+	mi := &file_reviewer_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTeamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTeamResponse) ProtoMessage() {}
+
+func (x *DeleteTeamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTeamResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTeamResponse) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *DeleteTeamResponse) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+type SetUserActiveRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	UserId   string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	IsActive bool                   `protobuf:"varint,2,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	// при деактивации переназначить открытые ревью пользователя
+	ReassignReviews bool `protobuf:"varint,3,opt,name=reassign_reviews,json=reassignReviews,proto3" json:"reassign_reviews,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SetUserActiveRequest) Reset() {
+	*x = SetUserActiveRequest{}
+	mi := &file_reviewer_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetUserActiveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUserActiveRequest) ProtoMessage() {}
+
+func (x *SetUserActiveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUserActiveRequest.ProtoReflect.Descriptor instead.
+func (*SetUserActiveRequest) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *SetUserActiveRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetUserActiveRequest) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *SetUserActiveRequest) GetReassignReviews() bool {
+	if x != nil {
+		return x.ReassignReviews
+	}
+	return false
+}
+
+type UserActivityChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Reassignments []*Reassignment        `protobuf:"bytes,2,rep,name=reassignments,proto3" json:"reassignments,omitempty"`
+	NotReassigned []string               `protobuf:"bytes,3,rep,name=not_reassigned,json=notReassigned,proto3" json:"not_reassigned,omitempty"`
+	ChangesetId   *string                `protobuf:"bytes,4,opt,name=changeset_id,json=changesetId,proto3,oneof" json:"changeset_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserActivityChange) Reset() {
+	*x = UserActivityChange{}
+	mi := &file_reviewer_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserActivityChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserActivityChange) ProtoMessage() {}
+
+func (x *UserActivityChange) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserActivityChange.ProtoReflect.Descriptor instead.
+func (*UserActivityChange) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *UserActivityChange) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *UserActivityChange) GetReassignments() []*Reassignment {
+	if x != nil {
+		return x.Reassignments
+	}
+	return nil
+}
+
+func (x *UserActivityChange) GetNotReassigned() []string {
+	if x != nil {
+		return x.NotReassigned
+	}
+	return nil
+}
+
+func (x *UserActivityChange) GetChangesetId() string {
+	if x != nil && x.ChangesetId != nil {
+		return *x.ChangesetId
+	}
+	return ""
+}
+
+type GetUserReviewsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// 1..100, по умолчанию 20
+	Limit  *int32  `protobuf:"varint,2,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	Cursor *string `protobuf:"bytes,3,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
+	// OPEN или MERGED
+	Status      *string                `protobuf:"bytes,4,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	AuthorId    *string                `protobuf:"bytes,5,opt,name=author_id,json=authorId,proto3,oneof" json:"author_id,omitempty"`
+	TeamName    *string                `protobuf:"bytes,6,opt,name=team_name,json=teamName,proto3,oneof" json:"team_name,omitempty"`
+	CreatedFrom *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_from,json=createdFrom,proto3" json:"created_from,omitempty"`
+	CreatedTo   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_to,json=createdTo,proto3" json:"created_to,omitempty"`
+	// created_at_desc (по умолчанию), created_at_asc, name_asc или name_desc
+	Sort          *string `protobuf:"bytes,9,opt,name=sort,proto3,oneof" json:"sort,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserReviewsRequest) Reset() {
+	*x = GetUserReviewsRequest{}
+	mi := &file_reviewer_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserReviewsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserReviewsRequest) ProtoMessage() {}
+
+func (x *GetUserReviewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserReviewsRequest.ProtoReflect.Descriptor instead.
+func (*GetUserReviewsRequest) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetUserReviewsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetUserReviewsRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+func (x *GetUserReviewsRequest) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
+
+func (x *GetUserReviewsRequest) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ""
+}
+
+func (x *GetUserReviewsRequest) GetAuthorId() string {
+	if x != nil && x.AuthorId != nil {
+		return *x.AuthorId
+	}
+	return ""
+}
+
+func (x *GetUserReviewsRequest) GetTeamName() string {
+	if x != nil && x.TeamName != nil {
+		return *x.TeamName
+	}
+	return ""
+}
+
+func (x *GetUserReviewsRequest) GetCreatedFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedFrom
+	}
+	return nil
+}
+
+func (x *GetUserReviewsRequest) GetCreatedTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedTo
+	}
+	return nil
+}
+
+func (x *GetUserReviewsRequest) GetSort() string {
+	if x != nil && x.Sort != nil {
+		return *x.Sort
+	}
+	return ""
+}
+
+type PullRequestShort struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId   string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	Repository      *string                `protobuf:"bytes,2,opt,name=repository,proto3,oneof" json:"repository,omitempty"`
+	PullRequestName string                 `protobuf:"bytes,3,opt,name=pull_request_name,json=pullRequestName,proto3" json:"pull_request_name,omitempty"`
+	AuthorId        string                 `protobuf:"bytes,4,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	// OPEN или MERGED
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PullRequestShort) Reset() {
+	*x = PullRequestShort{}
+	mi := &file_reviewer_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PullRequestShort) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PullRequestShort) ProtoMessage() {}
+
+func (x *PullRequestShort) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PullRequestShort.ProtoReflect.Descriptor instead.
+func (*PullRequestShort) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *PullRequestShort) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *PullRequestShort) GetRepository() string {
+	if x != nil && x.Repository != nil {
+		return *x.Repository
+	}
+	return ""
+}
+
+func (x *PullRequestShort) GetPullRequestName() string {
+	if x != nil {
+		return x.PullRequestName
+	}
+	return ""
+}
+
+func (x *PullRequestShort) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *PullRequestShort) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *PullRequestShort) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type GetUserReviewsResponse struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	UserId       string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PullRequests []*PullRequestShort    `protobuf:"bytes,2,rep,name=pull_requests,json=pullRequests,proto3" json:"pull_requests,omitempty"`
+	// пусто - страница последняя
+	NextCursor    *string `protobuf:"bytes,3,opt,name=next_cursor,json=nextCursor,proto3,oneof" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserReviewsResponse) Reset() {
+	*x = GetUserReviewsResponse{}
+	mi := &file_reviewer_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserReviewsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserReviewsResponse) ProtoMessage() {}
+
+func (x *GetUserReviewsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserReviewsResponse.ProtoReflect.Descriptor instead.
+func (*GetUserReviewsResponse) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetUserReviewsResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetUserReviewsResponse) GetPullRequests() []*PullRequestShort {
+	if x != nil {
+		return x.PullRequests
+	}
+	return nil
+}
+
+func (x *GetUserReviewsResponse) GetNextCursor() string {
+	if x != nil && x.NextCursor != nil {
+		return *x.NextCursor
+	}
+	return ""
+}
+
+type PullRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId     string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	Repository        *string                `protobuf:"bytes,2,opt,name=repository,proto3,oneof" json:"repository,omitempty"`
+	PullRequestName   string                 `protobuf:"bytes,3,opt,name=pull_request_name,json=pullRequestName,proto3" json:"pull_request_name,omitempty"`
+	AuthorId          string                 `protobuf:"bytes,4,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	Status            string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	AssignedReviewers []string               `protobuf:"bytes,6,rep,name=assigned_reviewers,json=assignedReviewers,proto3" json:"assigned_reviewers,omitempty"`
+	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	MergedAt          *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=merged_at,json=mergedAt,proto3" json:"merged_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *PullRequest) Reset() {
+	*x = PullRequest{}
+	mi := &file_reviewer_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PullRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PullRequest) ProtoMessage() {}
+
+func (x *PullRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PullRequest.ProtoReflect.Descriptor instead.
+func (*PullRequest) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *PullRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *PullRequest) GetRepository() string {
+	if x != nil && x.Repository != nil {
+		return *x.Repository
+	}
+	return ""
+}
+
+func (x *PullRequest) GetPullRequestName() string {
+	if x != nil {
+		return x.PullRequestName
+	}
+	return ""
+}
+
+func (x *PullRequest) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *PullRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *PullRequest) GetAssignedReviewers() []string {
+	if x != nil {
+		return x.AssignedReviewers
+	}
+	return nil
+}
+
+func (x *PullRequest) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *PullRequest) GetMergedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.MergedAt
+	}
+	return nil
+}
+
+type ReviewerAssignment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	AssignedAt    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=assigned_at,json=assignedAt,proto3" json:"assigned_at,omitempty"`
+	RespondedAt   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=responded_at,json=respondedAt,proto3" json:"responded_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewerAssignment) Reset() {
+	*x = ReviewerAssignment{}
+	mi := &file_reviewer_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewerAssignment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewerAssignment) ProtoMessage() {}
+
+func (x *ReviewerAssignment) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewerAssignment.ProtoReflect.Descriptor instead.
+func (*ReviewerAssignment) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ReviewerAssignment) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ReviewerAssignment) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *ReviewerAssignment) GetAssignedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AssignedAt
+	}
+	return nil
+}
+
+func (x *ReviewerAssignment) GetRespondedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RespondedAt
+	}
+	return nil
+}
+
+type PullRequestEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// CREATED, REVIEWER_ASSIGNED, REVIEWER_REASSIGNED, REVIEW_SUBMITTED, SLA_BREACHED или MERGED
+	Event  string                 `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	At     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=at,proto3" json:"at,omitempty"`
+	UserId *string                `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`
+	// снятый ревьювер для REVIEWER_REASSIGNED
+	OldReviewerId *string `protobuf:"bytes,4,opt,name=old_reviewer_id,json=oldReviewerId,proto3,oneof" json:"old_reviewer_id,omitempty"`
+	// причина переназначения или нарушения SLA
+	Reason        *string `protobuf:"bytes,5,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PullRequestEvent) Reset() {
+	*x = PullRequestEvent{}
+	mi := &file_reviewer_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PullRequestEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PullRequestEvent) ProtoMessage() {}
+
+func (x *PullRequestEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PullRequestEvent.ProtoReflect.Descriptor instead.
+func (*PullRequestEvent) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *PullRequestEvent) GetEvent() string {
+	if x != nil {
+		return x.Event
+	}
+	return ""
+}
+
+func (x *PullRequestEvent) GetAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.At
+	}
+	return nil
+}
+
+func (x *PullRequestEvent) GetUserId() string {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return ""
+}
+
+func (x *PullRequestEvent) GetOldReviewerId() string {
+	if x != nil && x.OldReviewerId != nil {
+		return *x.OldReviewerId
+	}
+	return ""
+}
+
+func (x *PullRequestEvent) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
+type PullRequestDetail struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId   string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	Repository      *string                `protobuf:"bytes,2,opt,name=repository,proto3,oneof" json:"repository,omitempty"`
+	PullRequestName string                 `protobuf:"bytes,3,opt,name=pull_request_name,json=pullRequestName,proto3" json:"pull_request_name,omitempty"`
+	AuthorId        string                 `protobuf:"bytes,4,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	Status          string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	Reviewers       []*ReviewerAssignment  `protobuf:"bytes,6,rep,name=reviewers,proto3" json:"reviewers,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	MergedAt        *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=merged_at,json=mergedAt,proto3" json:"merged_at,omitempty"`
+	History         []*PullRequestEvent    `protobuf:"bytes,9,rep,name=history,proto3" json:"history,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PullRequestDetail) Reset() {
+	*x = PullRequestDetail{}
+	mi := &file_reviewer_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PullRequestDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PullRequestDetail) ProtoMessage() {}
+
+func (x *PullRequestDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PullRequestDetail.ProtoReflect.Descriptor instead.
+func (*PullRequestDetail) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *PullRequestDetail) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *PullRequestDetail) GetRepository() string {
+	if x != nil && x.Repository != nil {
+		return *x.Repository
+	}
+	return ""
+}
+
+func (x *PullRequestDetail) GetPullRequestName() string {
+	if x != nil {
+		return x.PullRequestName
+	}
+	return ""
+}
+
+func (x *PullRequestDetail) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *PullRequestDetail) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *PullRequestDetail) GetReviewers() []*ReviewerAssignment {
+	if x != nil {
+		return x.Reviewers
+	}
+	return nil
+}
+
+func (x *PullRequestDetail) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *PullRequestDetail) GetMergedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.MergedAt
+	}
+	return nil
+}
+
+func (x *PullRequestDetail) GetHistory() []*PullRequestEvent {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+// PR по идентификатору; repository нужен, если pull_request_id есть в нескольких репозиториях
+type PullRequestRef struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	Repository    *string                `protobuf:"bytes,2,opt,name=repository,proto3,oneof" json:"repository,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PullRequestRef) Reset() {
+	*x = PullRequestRef{}
+	mi := &file_reviewer_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PullRequestRef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PullRequestRef) ProtoMessage() {}
+
+func (x *PullRequestRef) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PullRequestRef.ProtoReflect.Descriptor instead.
+func (*PullRequestRef) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *PullRequestRef) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *PullRequestRef) GetRepository() string {
+	if x != nil && x.Repository != nil {
+		return *x.Repository
+	}
+	return ""
+}
+
+type CreatePullRequestRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId   string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	PullRequestName string                 `protobuf:"bytes,2,opt,name=pull_request_name,json=pullRequestName,proto3" json:"pull_request_name,omitempty"`
+	AuthorId        string                 `protobuf:"bytes,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	Repository      *string                `protobuf:"bytes,4,opt,name=repository,proto3,oneof" json:"repository,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreatePullRequestRequest) Reset() {
+	*x = CreatePullRequestRequest{}
+	mi := &file_reviewer_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePullRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePullRequestRequest) ProtoMessage() {}
+
+func (x *CreatePullRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePullRequestRequest.ProtoReflect.Descriptor instead.
+func (*CreatePullRequestRequest) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *CreatePullRequestRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *CreatePullRequestRequest) GetPullRequestName() string {
+	if x != nil {
+		return x.PullRequestName
+	}
+	return ""
+}
+
+func (x *CreatePullRequestRequest) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *CreatePullRequestRequest) GetRepository() string {
+	if x != nil && x.Repository != nil {
+		return *x.Repository
+	}
+	return ""
+}
+
+type ReassignReviewerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	Repository    *string                `protobuf:"bytes,2,opt,name=repository,proto3,oneof" json:"repository,omitempty"`
+	OldUserId     string                 `protobuf:"bytes,3,opt,name=old_user_id,json=oldUserId,proto3" json:"old_user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReassignReviewerRequest) Reset() {
+	*x = ReassignReviewerRequest{}
+	mi := &file_reviewer_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReassignReviewerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReassignReviewerRequest) ProtoMessage() {}
+
+func (x *ReassignReviewerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReassignReviewerRequest.ProtoReflect.Descriptor instead.
+func (*ReassignReviewerRequest) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ReassignReviewerRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *ReassignReviewerRequest) GetRepository() string {
+	if x != nil && x.Repository != nil {
+		return *x.Repository
+	}
+	return ""
+}
+
+func (x *ReassignReviewerRequest) GetOldUserId() string {
+	if x != nil {
+		return x.OldUserId
+	}
+	return ""
+}
+
+type ReassignReviewerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pr            *PullRequest           `protobuf:"bytes,1,opt,name=pr,proto3" json:"pr,omitempty"`
+	ReplacedBy    string                 `protobuf:"bytes,2,opt,name=replaced_by,json=replacedBy,proto3" json:"replaced_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReassignReviewerResponse) Reset() {
+	*x = ReassignReviewerResponse{}
+	mi := &file_reviewer_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReassignReviewerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReassignReviewerResponse) ProtoMessage() {}
+
+func (x *ReassignReviewerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReassignReviewerResponse.ProtoReflect.Descriptor instead.
+func (*ReassignReviewerResponse) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ReassignReviewerResponse) GetPr() *PullRequest {
+	if x != nil {
+		return x.Pr
+	}
+	return nil
+}
+
+func (x *ReassignReviewerResponse) GetReplacedBy() string {
+	if x != nil {
+		return x.ReplacedBy
+	}
+	return ""
+}
+
+type SubmitReviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	Repository    *string                `protobuf:"bytes,2,opt,name=repository,proto3,oneof" json:"repository,omitempty"`
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitReviewRequest) Reset() {
+	*x = SubmitReviewRequest{}
+	mi := &file_reviewer_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitReviewRequest) ProtoMessage() {}
+
+func (x *SubmitReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitReviewRequest.ProtoReflect.Descriptor instead.
+func (*SubmitReviewRequest) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *SubmitReviewRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *SubmitReviewRequest) GetRepository() string {
+	if x != nil && x.Repository != nil {
+		return *x.Repository
+	}
+	return ""
+}
+
+func (x *SubmitReviewRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetStatsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// начало диапазона (включительно)
+	From *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	// конец диапазона (не включительно)
+	To            *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStatsRequest) Reset() {
+	*x = GetStatsRequest{}
+	mi := &file_reviewer_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatsRequest) ProtoMessage() {}
+
+func (x *GetStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetStatsRequest) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetStatsRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetStatsRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+type DurationPercentiles struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Count         int32                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	P50Hours      float64                `protobuf:"fixed64,2,opt,name=p50_hours,json=p50Hours,proto3" json:"p50_hours,omitempty"`
+	P90Hours      float64                `protobuf:"fixed64,3,opt,name=p90_hours,json=p90Hours,proto3" json:"p90_hours,omitempty"`
+	P99Hours      float64                `protobuf:"fixed64,4,opt,name=p99_hours,json=p99Hours,proto3" json:"p99_hours,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DurationPercentiles) Reset() {
+	*x = DurationPercentiles{}
+	mi := &file_reviewer_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DurationPercentiles) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DurationPercentiles) ProtoMessage() {}
+
+func (x *DurationPercentiles) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DurationPercentiles.ProtoReflect.Descriptor instead.
+func (*DurationPercentiles) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *DurationPercentiles) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *DurationPercentiles) GetP50Hours() float64 {
+	if x != nil {
+		return x.P50Hours
+	}
+	return 0
+}
+
+func (x *DurationPercentiles) GetP90Hours() float64 {
+	if x != nil {
+		return x.P90Hours
+	}
+	return 0
+}
+
+func (x *DurationPercentiles) GetP99Hours() float64 {
+	if x != nil {
+		return x.P99Hours
+	}
+	return 0
+}
+
+type WeeklyCounts struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// начало недели (YYYY-MM-DD) -> количество
+	Weeks         map[string]int32 `protobuf:"bytes,1,rep,name=weeks,proto3" json:"weeks,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WeeklyCounts) Reset() {
+	*x = WeeklyCounts{}
+	mi := &file_reviewer_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WeeklyCounts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WeeklyCounts) ProtoMessage() {}
+
+func (x *WeeklyCounts) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WeeklyCounts.ProtoReflect.Descriptor instead.
+func (*WeeklyCounts) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *WeeklyCounts) GetWeeks() map[string]int32 {
+	if x != nil {
+		return x.Weeks
+	}
+	return nil
+}
+
+type ReassignmentTrendPoint struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	WeekStart           string                 `protobuf:"bytes,1,opt,name=week_start,json=weekStart,proto3" json:"week_start,omitempty"`
+	Reassignments       int32                  `protobuf:"varint,2,opt,name=reassignments,proto3" json:"reassignments,omitempty"`
+	PullRequestsCreated int32                  `protobuf:"varint,3,opt,name=pull_requests_created,json=pullRequestsCreated,proto3" json:"pull_requests_created,omitempty"`
+	ReassignmentsPerPr  float64                `protobuf:"fixed64,4,opt,name=reassignments_per_pr,json=reassignmentsPerPr,proto3" json:"reassignments_per_pr,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ReassignmentTrendPoint) Reset() {
+	*x = ReassignmentTrendPoint{}
+	mi := &file_reviewer_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReassignmentTrendPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReassignmentTrendPoint) ProtoMessage() {}
+
+func (x *ReassignmentTrendPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReassignmentTrendPoint.ProtoReflect.Descriptor instead.
+func (*ReassignmentTrendPoint) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ReassignmentTrendPoint) GetWeekStart() string {
+	if x != nil {
+		return x.WeekStart
+	}
+	return ""
+}
+
+func (x *ReassignmentTrendPoint) GetReassignments() int32 {
+	if x != nil {
+		return x.Reassignments
+	}
+	return 0
+}
+
+func (x *ReassignmentTrendPoint) GetPullRequestsCreated() int32 {
+	if x != nil {
+		return x.PullRequestsCreated
+	}
+	return 0
+}
+
+func (x *ReassignmentTrendPoint) GetReassignmentsPerPr() float64 {
+	if x != nil {
+		return x.ReassignmentsPerPr
+	}
+	return 0
+}
+
+type Stats struct {
+	state                     protoimpl.MessageState          `protogen:"open.v1"`
+	AssignmentsPerUser        map[string]int64                `protobuf:"bytes,1,rep,name=assignments_per_user,json=assignmentsPerUser,proto3" json:"assignments_per_user,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	AssignmentsPerPr          map[string]int64                `protobuf:"bytes,2,rep,name=assignments_per_pr,json=assignmentsPerPr,proto3" json:"assignments_per_pr,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	SlaBreachesPerTeam        map[string]int64                `protobuf:"bytes,3,rep,name=sla_breaches_per_team,json=slaBreachesPerTeam,proto3" json:"sla_breaches_per_team,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	TimeToMergePerTeam        map[string]*DurationPercentiles `protobuf:"bytes,4,rep,name=time_to_merge_per_team,json=timeToMergePerTeam,proto3" json:"time_to_merge_per_team,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	TimeToMergePerAuthor      map[string]*DurationPercentiles `protobuf:"bytes,5,rep,name=time_to_merge_per_author,json=timeToMergePerAuthor,proto3" json:"time_to_merge_per_author,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ReviewerThroughputPerWeek map[string]*WeeklyCounts        `protobuf:"bytes,6,rep,name=reviewer_throughput_per_week,json=reviewerThroughputPerWeek,proto3" json:"reviewer_throughput_per_week,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	OpenPrAge                 map[string]int32                `protobuf:"bytes,7,rep,name=open_pr_age,json=openPrAge,proto3" json:"open_pr_age,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	ReassignmentTrend         []*ReassignmentTrendPoint       `protobuf:"bytes,8,rep,name=reassignment_trend,json=reassignmentTrend,proto3" json:"reassignment_trend,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *Stats) Reset() {
+	*x = Stats{}
+	mi := &file_reviewer_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Stats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Stats) ProtoMessage() {}
+
+func (x *Stats) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Stats.ProtoReflect.Descriptor instead.
+func (*Stats) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *Stats) GetAssignmentsPerUser() map[string]int64 {
+	if x != nil {
+		return x.AssignmentsPerUser
+	}
+	return nil
+}
+
+func (x *Stats) GetAssignmentsPerPr() map[string]int64 {
+	if x != nil {
+		return x.AssignmentsPerPr
+	}
+	return nil
+}
+
+func (x *Stats) GetSlaBreachesPerTeam() map[string]int64 {
+	if x != nil {
+		return x.SlaBreachesPerTeam
+	}
+	return nil
+}
+
+func (x *Stats) GetTimeToMergePerTeam() map[string]*DurationPercentiles {
+	if x != nil {
+		return x.TimeToMergePerTeam
+	}
+	return nil
+}
+
+func (x *Stats) GetTimeToMergePerAuthor() map[string]*DurationPercentiles {
+	if x != nil {
+		return x.TimeToMergePerAuthor
+	}
+	return nil
+}
+
+func (x *Stats) GetReviewerThroughputPerWeek() map[string]*WeeklyCounts {
+	if x != nil {
+		return x.ReviewerThroughputPerWeek
+	}
+	return nil
+}
+
+func (x *Stats) GetOpenPrAge() map[string]int32 {
+	if x != nil {
+		return x.OpenPrAge
+	}
+	return nil
+}
+
+func (x *Stats) GetReassignmentTrend() []*ReassignmentTrendPoint {
+	if x != nil {
+		return x.ReassignmentTrend
+	}
+	return nil
+}
+
+// хотя бы одно из user_id и team_name обязательно
+type StreamEventsRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	UserId   string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TeamName string                 `protobuf:"bytes,2,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	// id последнего полученного события; пропущенные события приходят первыми
+	LastEventId   string `protobuf:"bytes,3,opt,name=last_event_id,json=lastEventId,proto3" json:"last_event_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamEventsRequest) Reset() {
+	*x = StreamEventsRequest{}
+	mi := &file_reviewer_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamEventsRequest) ProtoMessage() {}
+
+func (x *StreamEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamEventsRequest.ProtoReflect.Descriptor instead.
+func (*StreamEventsRequest) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *StreamEventsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *StreamEventsRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *StreamEventsRequest) GetLastEventId() string {
+	if x != nil {
+		return x.LastEventId
+	}
+	return ""
+}
+
+type ReviewEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// идентификатор для last_event_id
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// reviewer.assigned, reviewer.reassigned_away, pull_request.merged или sla.reminder
+	Type              string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	At                *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=at,proto3" json:"at,omitempty"`
+	PullRequestId     string                 `protobuf:"bytes,4,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	Repository        *string                `protobuf:"bytes,5,opt,name=repository,proto3,oneof" json:"repository,omitempty"`
+	PullRequestName   string                 `protobuf:"bytes,6,opt,name=pull_request_name,json=pullRequestName,proto3" json:"pull_request_name,omitempty"`
+	AuthorId          string                 `protobuf:"bytes,7,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	TeamName          *string                `protobuf:"bytes,8,opt,name=team_name,json=teamName,proto3,oneof" json:"team_name,omitempty"`
+	ReviewerId        *string                `protobuf:"bytes,9,opt,name=reviewer_id,json=reviewerId,proto3,oneof" json:"reviewer_id,omitempty"`
+	Replaces          *string                `protobuf:"bytes,10,opt,name=replaces,proto3,oneof" json:"replaces,omitempty"`
+	ReplacedBy        *string                `protobuf:"bytes,11,opt,name=replaced_by,json=replacedBy,proto3,oneof" json:"replaced_by,omitempty"`
+	AssignedReviewers []string               `protobuf:"bytes,12,rep,name=assigned_reviewers,json=assignedReviewers,proto3" json:"assigned_reviewers,omitempty"`
+	Reason            *string                `protobuf:"bytes,13,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
+	// срок первого ответа по SLA для sla.reminder
+	Deadline      *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewEvent) Reset() {
+	*x = ReviewEvent{}
+	mi := &file_reviewer_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewEvent) ProtoMessage() {}
+
+func (x *ReviewEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewEvent.ProtoReflect.Descriptor instead.
+func (*ReviewEvent) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ReviewEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ReviewEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ReviewEvent) GetAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.At
+	}
+	return nil
+}
+
+func (x *ReviewEvent) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *ReviewEvent) GetRepository() string {
+	if x != nil && x.Repository != nil {
+		return *x.Repository
+	}
+	return ""
+}
+
+func (x *ReviewEvent) GetPullRequestName() string {
+	if x != nil {
+		return x.PullRequestName
+	}
+	return ""
+}
+
+func (x *ReviewEvent) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *ReviewEvent) GetTeamName() string {
+	if x != nil && x.TeamName != nil {
+		return *x.TeamName
+	}
+	return ""
+}
+
+func (x *ReviewEvent) GetReviewerId() string {
+	if x != nil && x.ReviewerId != nil {
+		return *x.ReviewerId
+	}
+	return ""
+}
+
+func (x *ReviewEvent) GetReplaces() string {
+	if x != nil && x.Replaces != nil {
+		return *x.Replaces
+	}
+	return ""
+}
+
+func (x *ReviewEvent) GetReplacedBy() string {
+	if x != nil && x.ReplacedBy != nil {
+		return *x.ReplacedBy
+	}
+	return ""
+}
+
+func (x *ReviewEvent) GetAssignedReviewers() []string {
+	if x != nil {
+		return x.AssignedReviewers
+	}
+	return nil
+}
+
+func (x *ReviewEvent) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
+func (x *ReviewEvent) GetDeadline() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Deadline
+	}
+	return nil
+}
+
+// reset_required - продолжить с last_event_id нельзя, состояние стоит перечитать; event при этом пустой
+type StreamEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ResetRequired bool                   `protobuf:"varint,1,opt,name=reset_required,json=resetRequired,proto3" json:"reset_required,omitempty"`
+	Event         *ReviewEvent           `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamEventsResponse) Reset() {
+	*x = StreamEventsResponse{}
+	mi := &file_reviewer_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamEventsResponse) ProtoMessage() {}
+
+func (x *StreamEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamEventsResponse.ProtoReflect.Descriptor instead.
+func (*StreamEventsResponse) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *StreamEventsResponse) GetResetRequired() bool {
+	if x != nil {
+		return x.ResetRequired
+	}
+	return false
+}
+
+func (x *StreamEventsResponse) GetEvent() *ReviewEvent {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+var File_reviewer_proto protoreflect.FileDescriptor
+
+const file_reviewer_proto_rawDesc = "" +
+	"\n" +
+	"\x0ereviewer.proto\x12\vreviewer.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x91\x01\n" +
+	"\n" +
+	"TeamMember\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x1b\n" +
+	"\tis_active\x18\x03 \x01(\bR\bisActive\x12\x12\n" +
+	"\x04role\x18\x04 \x01(\tR\x04role\x12\x1d\n" +
+	"\n" +
+	"is_primary\x18\x05 \x01(\bR\tisPrimary\"O\n" +
+	"\aTeamSla\x12,\n" +
+	"\x12first_review_hours\x18\x01 \x01(\x05R\x10firstReviewHours\x12\x16\n" +
+	"\x06action\x18\x02 \x01(\tR\x06action\"~\n" +
+	"\x04Team\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x121\n" +
+	"\amembers\x18\x02 \x03(\v2\x17.reviewer.v1.TeamMemberR\amembers\x12&\n" +
+	"\x03sla\x18\x03 \x01(\v2\x14.reviewer.v1.TeamSlaR\x03sla\"u\n" +
+	"\x04User\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x1b\n" +
+	"\tteam_name\x18\x03 \x01(\tR\bteamName\x12\x1b\n" +
+	"\tis_active\x18\x04 \x01(\bR\bisActive\"\xba\x01\n" +
+	"\fReassignment\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12#\n" +
+	"\n" +
+	"repository\x18\x02 \x01(\tH\x00R\n" +
+	"repository\x88\x01\x01\x12&\n" +
+	"\x0fold_reviewer_id\x18\x03 \x01(\tR\roldReviewerId\x12&\n" +
+	"\x0fnew_reviewer_id\x18\x04 \x01(\tR\rnewReviewerIdB\r\n" +
+	"\v_repository\"f\n" +
+	"\x0eAddTeamRequest\x12%\n" +
+	"\x04team\x18\x01 \x01(\v2\x11.reviewer.v1.TeamR\x04team\x12\x14\n" +
+	"\x05prune\x18\x02 \x01(\bR\x05prune\x12\x17\n" +
+	"\adry_run\x18\x03 \x01(\bR\x06dryRun\"R\n" +
+	"\x10TeamMemberUpdate\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12%\n" +
+	"\x0echanged_fields\x18\x02 \x03(\tR\rchangedFields\"\xa4\x02\n" +
+	"\fTeamSyncDiff\x12!\n" +
+	"\fteam_created\x18\x01 \x01(\bR\vteamCreated\x12\x18\n" +
+	"\acreated\x18\x02 \x03(\tR\acreated\x12\x1a\n" +
+	"\battached\x18\x03 \x03(\tR\battached\x127\n" +
+	"\aupdated\x18\x04 \x03(\v2\x1d.reviewer.v1.TeamMemberUpdateR\aupdated\x12\x1a\n" +
+	"\bdetached\x18\x05 \x03(\tR\bdetached\x12?\n" +
+	"\rreassignments\x18\x06 \x03(\v2\x19.reviewer.v1.ReassignmentR\rreassignments\x12%\n" +
+	"\x0enot_reassigned\x18\a \x03(\tR\rnotReassigned\"g\n" +
+	"\x0fAddTeamResponse\x12%\n" +
+	"\x04team\x18\x01 \x01(\v2\x11.reviewer.v1.TeamR\x04team\x12-\n" +
+	"\x04diff\x18\x02 \x01(\v2\x19.reviewer.v1.TeamSyncDiffR\x04diff\"-\n" +
+	"\x0eGetTeamRequest\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\"q\n" +
+	"\x15AddTeamMembersRequest\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x12\x19\n" +
+	"\buser_ids\x18\x02 \x03(\tR\auserIds\x12\x17\n" +
+	"\x04role\x18\x03 \x01(\tH\x00R\x04role\x88\x01\x01B\a\n" +
+	"\x05_role\"R\n" +
+	"\x18RemoveTeamMembersRequest\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x12\x19\n" +
+	"\buser_ids\x18\x02 \x03(\tR\auserIds\"\x90\x01\n" +
+	"\x15MoveTeamMemberRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12 \n" +
+	"\fto_team_name\x18\x02 \x01(\tR\n" +
+	"toTeamName\x12)\n" +
+	"\x0efrom_team_name\x18\x03 \x01(\tH\x00R\ffromTeamName\x88\x01\x01B\x11\n" +
+	"\x0f_from_team_name\"\xa2\x01\n" +
+	"\x11TeamMembersChange\x12%\n" +
+	"\x04team\x18\x01 \x01(\v2\x11.reviewer.v1.TeamR\x04team\x12?\n" +
+	"\rreassignments\x18\x02 \x03(\v2\x19.reviewer.v1.ReassignmentR\rreassignments\x12%\n" +
+	"\x0enot_reassigned\x18\x03 \x03(\tR\rnotReassigned\"T\n" +
+	"\x11RenameTeamRequest\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x12\"\n" +
+	"\rnew_team_name\x18\x02 \x01(\tR\vnewTeamName\"v\n" +
+	"\x11SetTeamSlaRequest\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x12,\n" +
+	"\x12first_review_hours\x18\x02 \x01(\x05R\x10firstReviewHours\x12\x16\n" +
+	"\x06action\x18\x03 \x01(\tR\x06action\"0\n" +
+	"\x11DeleteTeamRequest\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\"1\n" +
+	"\x12DeleteTeamResponse\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\"w\n" +
+	"\x14SetUserActiveRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tis_active\x18\x02 \x01(\bR\bisActive\x12)\n" +
+	"\x10reassign_reviews\x18\x03 \x01(\bR\x0freassignReviews\"\xdc\x01\n" +
+	"\x12UserActivityChange\x12%\n" +
+	"\x04user\x18\x01 \x01(\v2\x11.reviewer.v1.UserR\x04user\x12?\n" +
+	"\rreassignments\x18\x02 \x03(\v2\x19.reviewer.v1.ReassignmentR\rreassignments\x12%\n" +
+	"\x0enot_reassigned\x18\x03 \x03(\tR\rnotReassigned\x12&\n" +
+	"\fchangeset_id\x18\x04 \x01(\tH\x00R\vchangesetId\x88\x01\x01B\x0f\n" +
+	"\r_changeset_id\"\xa1\x03\n" +
+	"\x15GetUserReviewsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x19\n" +
+	"\x05limit\x18\x02 \x01(\x05H\x00R\x05limit\x88\x01\x01\x12\x1b\n" +
+	"\x06cursor\x18\x03 \x01(\tH\x01R\x06cursor\x88\x01\x01\x12\x1b\n" +
+	"\x06status\x18\x04 \x01(\tH\x02R\x06status\x88\x01\x01\x12 \n" +
+	"\tauthor_id\x18\x05 \x01(\tH\x03R\bauthorId\x88\x01\x01\x12 \n" +
+	"\tteam_name\x18\x06 \x01(\tH\x04R\bteamName\x88\x01\x01\x12=\n" +
+	"\fcreated_from\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\vcreatedFrom\x129\n" +
+	"\n" +
+	"created_to\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedTo\x12\x17\n" +
+	"\x04sort\x18\t \x01(\tH\x05R\x04sort\x88\x01\x01B\b\n" +
+	"\x06_limitB\t\n" +
+	"\a_cursorB\t\n" +
+	"\a_statusB\f\n" +
+	"\n" +
+	"_author_idB\f\n" +
+	"\n" +
+	"_team_nameB\a\n" +
+	"\x05_sort\"\x8a\x02\n" +
+	"\x10PullRequestShort\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12#\n" +
+	"\n" +
+	"repository\x18\x02 \x01(\tH\x00R\n" +
+	"repository\x88\x01\x01\x12*\n" +
+	"\x11pull_request_name\x18\x03 \x01(\tR\x0fpullRequestName\x12\x1b\n" +
+	"\tauthor_id\x18\x04 \x01(\tR\bauthorId\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\r\n" +
+	"\v_repository\"\xab\x01\n" +
+	"\x16GetUserReviewsResponse\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12B\n" +
+	"\rpull_requests\x18\x02 \x03(\v2\x1d.reviewer.v1.PullRequestShortR\fpullRequests\x12$\n" +
+	"\vnext_cursor\x18\x03 \x01(\tH\x00R\n" +
+	"nextCursor\x88\x01\x01B\x0e\n" +
+	"\f_next_cursor\"\xed\x02\n" +
+	"\vPullRequest\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12#\n" +
+	"\n" +
+	"repository\x18\x02 \x01(\tH\x00R\n" +
+	"repository\x88\x01\x01\x12*\n" +
+	"\x11pull_request_name\x18\x03 \x01(\tR\x0fpullRequestName\x12\x1b\n" +
+	"\tauthor_id\x18\x04 \x01(\tR\bauthorId\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12-\n" +
+	"\x12assigned_reviewers\x18\x06 \x03(\tR\x11assignedReviewers\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x127\n" +
+	"\tmerged_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\bmergedAtB\r\n" +
+	"\v_repository\"\xc5\x01\n" +
+	"\x12ReviewerAssignment\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12;\n" +
+	"\vassigned_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"assignedAt\x12=\n" +
+	"\fresponded_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vrespondedAt\"\xe7\x01\n" +
+	"\x10PullRequestEvent\x12\x14\n" +
+	"\x05event\x18\x01 \x01(\tR\x05event\x12*\n" +
+	"\x02at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x02at\x12\x1c\n" +
+	"\auser_id\x18\x03 \x01(\tH\x00R\x06userId\x88\x01\x01\x12+\n" +
+	"\x0fold_reviewer_id\x18\x04 \x01(\tH\x01R\roldReviewerId\x88\x01\x01\x12\x1b\n" +
+	"\x06reason\x18\x05 \x01(\tH\x02R\x06reason\x88\x01\x01B\n" +
+	"\n" +
+	"\b_user_idB\x12\n" +
+	"\x10_old_reviewer_idB\t\n" +
+	"\a_reason\"\xbc\x03\n" +
+	"\x11PullRequestDetail\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12#\n" +
+	"\n" +
+	"repository\x18\x02 \x01(\tH\x00R\n" +
+	"repository\x88\x01\x01\x12*\n" +
+	"\x11pull_request_name\x18\x03 \x01(\tR\x0fpullRequestName\x12\x1b\n" +
+	"\tauthor_id\x18\x04 \x01(\tR\bauthorId\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12=\n" +
+	"\treviewers\x18\x06 \x03(\v2\x1f.reviewer.v1.ReviewerAssignmentR\treviewers\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x127\n" +
+	"\tmerged_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\bmergedAt\x127\n" +
+	"\ahistory\x18\t \x03(\v2\x1d.reviewer.v1.PullRequestEventR\ahistoryB\r\n" +
+	"\v_repository\"l\n" +
+	"\x0ePullRequestRef\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12#\n" +
+	"\n" +
+	"repository\x18\x02 \x01(\tH\x00R\n" +
+	"repository\x88\x01\x01B\r\n" +
+	"\v_repository\"\xbf\x01\n" +
+	"\x18CreatePullRequestRequest\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12*\n" +
+	"\x11pull_request_name\x18\x02 \x01(\tR\x0fpullRequestName\x12\x1b\n" +
+	"\tauthor_id\x18\x03 \x01(\tR\bauthorId\x12#\n" +
+	"\n" +
+	"repository\x18\x04 \x01(\tH\x00R\n" +
+	"repository\x88\x01\x01B\r\n" +
+	"\v_repository\"\x95\x01\n" +
+	"\x17ReassignReviewerRequest\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12#\n" +
+	"\n" +
+	"repository\x18\x02 \x01(\tH\x00R\n" +
+	"repository\x88\x01\x01\x12\x1e\n" +
+	"\vold_user_id\x18\x03 \x01(\tR\toldUserIdB\r\n" +
+	"\v_repository\"e\n" +
+	"\x18ReassignReviewerResponse\x12(\n" +
+	"\x02pr\x18\x01 \x01(\v2\x18.reviewer.v1.PullRequestR\x02pr\x12\x1f\n" +
+	"\vreplaced_by\x18\x02 \x01(\tR\n" +
+	"replacedBy\"\x8a\x01\n" +
+	"\x13SubmitReviewRequest\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12#\n" +
+	"\n" +
+	"repository\x18\x02 \x01(\tH\x00R\n" +
+	"repository\x88\x01\x01\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userIdB\r\n" +
+	"\v_repository\"m\n" +
+	"\x0fGetStatsRequest\x12.\n" +
+	"\x04from\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\"\x82\x01\n" +
+	"\x13DurationPercentiles\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\x05R\x05count\x12\x1b\n" +
+	"\tp50_hours\x18\x02 \x01(\x01R\bp50Hours\x12\x1b\n" +
+	"\tp90_hours\x18\x03 \x01(\x01R\bp90Hours\x12\x1b\n" +
+	"\tp99_hours\x18\x04 \x01(\x01R\bp99Hours\"\x84\x01\n" +
+	"\fWeeklyCounts\x12:\n" +
+	"\x05weeks\x18\x01 \x03(\v2$.reviewer.v1.WeeklyCounts.WeeksEntryR\x05weeks\x1a8\n" +
+	"\n" +
+	"WeeksEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"\xc3\x01\n" +
+	"\x16ReassignmentTrendPoint\x12\x1d\n" +
+	"\n" +
+	"week_start\x18\x01 \x01(\tR\tweekStart\x12$\n" +
+	"\rreassignments\x18\x02 \x01(\x05R\rreassignments\x122\n" +
+	"\x15pull_requests_created\x18\x03 \x01(\x05R\x13pullRequestsCreated\x120\n" +
+	"\x14reassignments_per_pr\x18\x04 \x01(\x01R\x12reassignmentsPerPr\"\xbb\n" +
+	"\n" +
+	"\x05Stats\x12\\\n" +
+	"\x14assignments_per_user\x18\x01 \x03(\v2*.reviewer.v1.Stats.AssignmentsPerUserEntryR\x12assignmentsPerUser\x12V\n" +
+	"\x12assignments_per_pr\x18\x02 \x03(\v2(.reviewer.v1.Stats.AssignmentsPerPrEntryR\x10assignmentsPerPr\x12]\n" +
+	"\x15sla_breaches_per_team\x18\x03 \x03(\v2*.reviewer.v1.Stats.SlaBreachesPerTeamEntryR\x12slaBreachesPerTeam\x12^\n" +
+	"\x16time_to_merge_per_team\x18\x04 \x03(\v2*.reviewer.v1.Stats.TimeToMergePerTeamEntryR\x12timeToMergePerTeam\x12d\n" +
+	"\x18time_to_merge_per_author\x18\x05 \x03(\v2,.reviewer.v1.Stats.TimeToMergePerAuthorEntryR\x14timeToMergePerAuthor\x12r\n" +
+	"\x1creviewer_throughput_per_week\x18\x06 \x03(\v21.reviewer.v1.Stats.ReviewerThroughputPerWeekEntryR\x19reviewerThroughputPerWeek\x12A\n" +
+	"\vopen_pr_age\x18\a \x03(\v2!.reviewer.v1.Stats.OpenPrAgeEntryR\topenPrAge\x12R\n" +
+	"\x12reassignment_trend\x18\b \x03(\v2#.reviewer.v1.ReassignmentTrendPointR\x11reassignmentTrend\x1aE\n" +
+	"\x17AssignmentsPerUserEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\x1aC\n" +
+	"\x15AssignmentsPerPrEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\x1aE\n" +
+	"\x17SlaBreachesPerTeamEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\x1ag\n" +
+	"\x17TimeToMergePerTeamEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x126\n" +
+	"\x05value\x18\x02 \x01(\v2 .reviewer.v1.DurationPercentilesR\x05value:\x028\x01\x1ai\n" +
+	"\x19TimeToMergePerAuthorEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x126\n" +
+	"\x05value\x18\x02 \x01(\v2 .reviewer.v1.DurationPercentilesR\x05value:\x028\x01\x1ag\n" +
+	"\x1eReviewerThroughputPerWeekEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12/\n" +
+	"\x05value\x18\x02 \x01(\v2\x19.reviewer.v1.WeeklyCountsR\x05value:\x028\x01\x1a<\n" +
+	"\x0eOpenPrAgeEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"o\n" +
+	"\x13StreamEventsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tteam_name\x18\x02 \x01(\tR\bteamName\x12\"\n" +
+	"\rlast_event_id\x18\x03 \x01(\tR\vlastEventId\"\xdb\x04\n" +
+	"\vReviewEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12*\n" +
+	"\x02at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x02at\x12&\n" +
+	"\x0fpull_request_id\x18\x04 \x01(\tR\rpullRequestId\x12#\n" +
+	"\n" +
+	"repository\x18\x05 \x01(\tH\x00R\n" +
+	"repository\x88\x01\x01\x12*\n" +
+	"\x11pull_request_name\x18\x06 \x01(\tR\x0fpullRequestName\x12\x1b\n" +
+	"\tauthor_id\x18\a \x01(\tR\bauthorId\x12 \n" +
+	"\tteam_name\x18\b \x01(\tH\x01R\bteamName\x88\x01\x01\x12$\n" +
+	"\vreviewer_id\x18\t \x01(\tH\x02R\n" +
+	"reviewerId\x88\x01\x01\x12\x1f\n" +
+	"\breplaces\x18\n" +
+	" \x01(\tH\x03R\breplaces\x88\x01\x01\x12$\n" +
+	"\vreplaced_by\x18\v \x01(\tH\x04R\n" +
+	"replacedBy\x88\x01\x01\x12-\n" +
+	"\x12assigned_reviewers\x18\f \x03(\tR\x11assignedReviewers\x12\x1b\n" +
+	"\x06reason\x18\r \x01(\tH\x05R\x06reason\x88\x01\x01\x126\n" +
+	"\bdeadline\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\bdeadlineB\r\n" +
+	"\v_repositoryB\f\n" +
+	"\n" +
+	"_team_nameB\x0e\n" +
+	"\f_reviewer_idB\v\n" +
+	"\t_replacesB\x0e\n" +
+	"\f_replaced_byB\t\n" +
+	"\a_reason\"m\n" +
+	"\x14StreamEventsResponse\x12%\n" +
+	"\x0ereset_required\x18\x01 \x01(\bR\rresetRequired\x12.\n" +
+	"\x05event\x18\x02 \x01(\v2\x18.reviewer.v1.ReviewEventR\x05event2\xc6\n" +
+	"\n" +
+	"\x0fReviewerService\x12D\n" +
+	"\aAddTeam\x12\x1b.reviewer.v1.AddTeamRequest\x1a\x1c.reviewer.v1.AddTeamResponse\x129\n" +
+	"\aGetTeam\x12\x1b.reviewer.v1.GetTeamRequest\x1a\x11.reviewer.v1.Team\x12G\n" +
+	"\x0eAddTeamMembers\x12\".reviewer.v1.AddTeamMembersRequest\x1a\x11.reviewer.v1.Team\x12Z\n" +
+	"\x11RemoveTeamMembers\x12%.reviewer.v1.RemoveTeamMembersRequest\x1a\x1e.reviewer.v1.TeamMembersChange\x12T\n" +
+	"\x0eMoveTeamMember\x12\".reviewer.v1.MoveTeamMemberRequest\x1a\x1e.reviewer.v1.TeamMembersChange\x12?\n" +
+	"\n" +
+	"RenameTeam\x12\x1e.reviewer.v1.RenameTeamRequest\x1a\x11.reviewer.v1.Team\x12?\n" +
+	"\n" +
+	"SetTeamSla\x12\x1e.reviewer.v1.SetTeamSlaRequest\x1a\x11.reviewer.v1.Team\x12M\n" +
+	"\n" +
+	"DeleteTeam\x12\x1e.reviewer.v1.DeleteTeamRequest\x1a\x1f.reviewer.v1.DeleteTeamResponse\x12S\n" +
+	"\rSetUserActive\x12!.reviewer.v1.SetUserActiveRequest\x1a\x1f.reviewer.v1.UserActivityChange\x12Y\n" +
+	"\x0eGetUserReviews\x12\".reviewer.v1.GetUserReviewsRequest\x1a#.reviewer.v1.GetUserReviewsResponse\x12T\n" +
+	"\x11CreatePullRequest\x12%.reviewer.v1.CreatePullRequestRequest\x1a\x18.reviewer.v1.PullRequest\x12M\n" +
+	"\x0eGetPullRequest\x12\x1b.reviewer.v1.PullRequestRef\x1a\x1e.reviewer.v1.PullRequestDetail\x12I\n" +
+	"\x10MergePullRequest\x12\x1b.reviewer.v1.PullRequestRef\x1a\x18.reviewer.v1.PullRequest\x12_\n" +
+	"\x10ReassignReviewer\x12$.reviewer.v1.ReassignReviewerRequest\x1a%.reviewer.v1.ReassignReviewerResponse\x12P\n" +
+	"\fSubmitReview\x12 .reviewer.v1.SubmitReviewRequest\x1a\x1e.reviewer.v1.PullRequestDetail\x12<\n" +
+	"\bGetStats\x12\x1c.reviewer.v1.GetStatsRequest\x1a\x12.reviewer.v1.Stats\x12U\n" +
+	"\fStreamEvents\x12 .reviewer.v1.StreamEventsRequest\x1a!.reviewer.v1.StreamEventsResponse0\x01B>Z<github.com/wozhdeleniye/avito-tech-internship/api/reviewerpbb\x06proto3"
+
+var (
+	file_reviewer_proto_rawDescOnce sync.Once
+	file_reviewer_proto_rawDescData []byte
+)
+
+func file_reviewer_proto_rawDescGZIP() []byte {
+	file_reviewer_proto_rawDescOnce.Do(func() {
+		file_reviewer_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_reviewer_proto_rawDesc), len(file_reviewer_proto_rawDesc)))
+	})
+	return file_reviewer_proto_rawDescData
+}
+
+var file_reviewer_proto_msgTypes = make([]protoimpl.MessageInfo, 48)
+var file_reviewer_proto_goTypes = []any{
+	(*TeamMember)(nil),               // 0: reviewer.v1.TeamMember
+	(*TeamSla)(nil),                  // 1: reviewer.v1.TeamSla
+	(*Team)(nil),                     // 2: reviewer.v1.Team
+	(*User)(nil),                     // 3: reviewer.v1.User
+	(*Reassignment)(nil),             // 4: reviewer.v1.Reassignment
+	(*AddTeamRequest)(nil),           // 5: reviewer.v1.AddTeamRequest
+	(*TeamMemberUpdate)(nil),         // 6: reviewer.v1.TeamMemberUpdate
+	(*TeamSyncDiff)(nil),             // 7: reviewer.v1.TeamSyncDiff
+	(*AddTeamResponse)(nil),          // 8: reviewer.v1.AddTeamResponse
+	(*GetTeamRequest)(nil),           // 9: reviewer.v1.GetTeamRequest
+	(*AddTeamMembersRequest)(nil),    // 10: reviewer.v1.AddTeamMembersRequest
+	(*RemoveTeamMembersRequest)(nil), // 11: reviewer.v1.RemoveTeamMembersRequest
+	(*MoveTeamMemberRequest)(nil),    // 12: reviewer.v1.MoveTeamMemberRequest
+	(*TeamMembersChange)(nil),        // 13: reviewer.v1.TeamMembersChange
+	(*RenameTeamRequest)(nil),        // 14: reviewer.v1.RenameTeamRequest
+	(*SetTeamSlaRequest)(nil),        // 15: reviewer.v1.SetTeamSlaRequest
+	(*DeleteTeamRequest)(nil),        // 16: reviewer.v1.DeleteTeamRequest
+	(*DeleteTeamResponse)(nil),       // 17: reviewer.v1.DeleteTeamResponse
+	(*SetUserActiveRequest)(nil),     // 18: reviewer.v1.SetUserActiveRequest
+	(*UserActivityChange)(nil),       // 19: reviewer.v1.UserActivityChange
+	(*GetUserReviewsRequest)(nil),    // 20: reviewer.v1.GetUserReviewsRequest
+	(*PullRequestShort)(nil),         // 21: reviewer.v1.PullRequestShort
+	(*GetUserReviewsResponse)(nil),   // 22: reviewer.v1.GetUserReviewsResponse
+	(*PullRequest)(nil),              // 23: reviewer.v1.PullRequest
+	(*ReviewerAssignment)(nil),       // 24: reviewer.v1.ReviewerAssignment
+	(*PullRequestEvent)(nil),         // 25: reviewer.v1.PullRequestEvent
+	(*PullRequestDetail)(nil),        // 26: reviewer.v1.PullRequestDetail
+	(*PullRequestRef)(nil),           // 27: reviewer.v1.PullRequestRef
+	(*CreatePullRequestRequest)(nil), // 28: reviewer.v1.CreatePullRequestRequest
+	(*ReassignReviewerRequest)(nil),  // 29: reviewer.v1.ReassignReviewerRequest
+	(*ReassignReviewerResponse)(nil), // 30: reviewer.v1.ReassignReviewerResponse
+	(*SubmitReviewRequest)(nil),      // 31: reviewer.v1.SubmitReviewRequest
+	(*GetStatsRequest)(nil),          // 32: reviewer.v1.GetStatsRequest
+	(*DurationPercentiles)(nil),      // 33: reviewer.v1.DurationPercentiles
+	(*WeeklyCounts)(nil),             // 34: reviewer.v1.WeeklyCounts
+	(*ReassignmentTrendPoint)(nil),   // 35: reviewer.v1.ReassignmentTrendPoint
+	(*Stats)(nil),                    // 36: reviewer.v1.Stats
+	(*StreamEventsRequest)(nil),      // 37: reviewer.v1.StreamEventsRequest
+	(*ReviewEvent)(nil),              // 38: reviewer.v1.ReviewEvent
+	(*StreamEventsResponse)(nil),     // 39: reviewer.v1.StreamEventsResponse
+	nil,                              // 40: reviewer.v1.WeeklyCounts.WeeksEntry
+	nil,                              // 41: reviewer.v1.Stats.AssignmentsPerUserEntry
+	nil,                              // 42: reviewer.v1.Stats.AssignmentsPerPrEntry
+	nil,                              // 43: reviewer.v1.Stats.SlaBreachesPerTeamEntry
+	nil,                              // 44: reviewer.v1.Stats.TimeToMergePerTeamEntry
+	nil,                              // 45: reviewer.v1.Stats.TimeToMergePerAuthorEntry
+	nil,                              // 46: reviewer.v1.Stats.ReviewerThroughputPerWeekEntry
+	nil,                              // 47: reviewer.v1.Stats.OpenPrAgeEntry
+	(*timestamppb.Timestamp)(nil),    // 48: google.protobuf.Timestamp
+}
+var file_reviewer_proto_depIdxs = []int32{
+	0,  // 0: reviewer.v1.Team.members:type_name -> reviewer.v1.TeamMember
+	1,  // 1: reviewer.v1.Team.sla:type_name -> reviewer.v1.TeamSla
+	2,  // 2: reviewer.v1.AddTeamRequest.team:type_name -> reviewer.v1.Team
+	6,  // 3: reviewer.v1.TeamSyncDiff.updated:type_name -> reviewer.v1.TeamMemberUpdate
+	4,  // 4: reviewer.v1.TeamSyncDiff.reassignments:type_name -> reviewer.v1.Reassignment
+	2,  // 5: reviewer.v1.AddTeamResponse.team:type_name -> reviewer.v1.Team
+	7,  // 6: reviewer.v1.AddTeamResponse.diff:type_name -> reviewer.v1.TeamSyncDiff
+	2,  // 7: reviewer.v1.TeamMembersChange.team:type_name -> reviewer.v1.Team
+	4,  // 8: reviewer.v1.TeamMembersChange.reassignments:type_name -> reviewer.v1.Reassignment
+	3,  // 9: reviewer.v1.UserActivityChange.user:type_name -> reviewer.v1.User
+	4,  // 10: reviewer.v1.UserActivityChange.reassignments:type_name -> reviewer.v1.Reassignment
+	48, // 11: reviewer.v1.GetUserReviewsRequest.created_from:type_name -> google.protobuf.Timestamp
+	48, // 12: reviewer.v1.GetUserReviewsRequest.created_to:type_name -> google.protobuf.Timestamp
+	48, // 13: reviewer.v1.PullRequestShort.created_at:type_name -> google.protobuf.Timestamp
+	21, // 14: reviewer.v1.GetUserReviewsResponse.pull_requests:type_name -> reviewer.v1.PullRequestShort
+	48, // 15: reviewer.v1.PullRequest.created_at:type_name -> google.protobuf.Timestamp
+	48, // 16: reviewer.v1.PullRequest.merged_at:type_name -> google.protobuf.Timestamp
+	48, // 17: reviewer.v1.ReviewerAssignment.assigned_at:type_name -> google.protobuf.Timestamp
+	48, // 18: reviewer.v1.ReviewerAssignment.responded_at:type_name -> google.protobuf.Timestamp
+	48, // 19: reviewer.v1.PullRequestEvent.at:type_name -> google.protobuf.Timestamp
+	24, // 20: reviewer.v1.PullRequestDetail.reviewers:type_name -> reviewer.v1.ReviewerAssignment
+	48, // 21: reviewer.v1.PullRequestDetail.created_at:type_name -> google.protobuf.Timestamp
+	48, // 22: reviewer.v1.PullRequestDetail.merged_at:type_name -> google.protobuf.Timestamp
+	25, // 23: reviewer.v1.PullRequestDetail.history:type_name -> reviewer.v1.PullRequestEvent
+	23, // 24: reviewer.v1.ReassignReviewerResponse.pr:type_name -> reviewer.v1.PullRequest
+	48, // 25: reviewer.v1.GetStatsRequest.from:type_name -> google.protobuf.Timestamp
+	48, // 26: reviewer.v1.GetStatsRequest.to:type_name -> google.protobuf.Timestamp
+	40, // 27: reviewer.v1.WeeklyCounts.weeks:type_name -> reviewer.v1.WeeklyCounts.WeeksEntry
+	41, // 28: reviewer.v1.Stats.assignments_per_user:type_name -> reviewer.v1.Stats.AssignmentsPerUserEntry
+	42, // 29: reviewer.v1.Stats.assignments_per_pr:type_name -> reviewer.v1.Stats.AssignmentsPerPrEntry
+	43, // 30: reviewer.v1.Stats.sla_breaches_per_team:type_name -> reviewer.v1.Stats.SlaBreachesPerTeamEntry
+	44, // 31: reviewer.v1.Stats.time_to_merge_per_team:type_name -> reviewer.v1.Stats.TimeToMergePerTeamEntry
+	45, // 32: reviewer.v1.Stats.time_to_merge_per_author:type_name -> reviewer.v1.Stats.TimeToMergePerAuthorEntry
+	46, // 33: reviewer.v1.Stats.reviewer_throughput_per_week:type_name -> reviewer.v1.Stats.ReviewerThroughputPerWeekEntry
+	47, // 34: reviewer.v1.Stats.open_pr_age:type_name -> reviewer.v1.Stats.OpenPrAgeEntry
+	35, // 35: reviewer.v1.Stats.reassignment_trend:type_name -> reviewer.v1.ReassignmentTrendPoint
+	48, // 36: reviewer.v1.ReviewEvent.at:type_name -> google.protobuf.Timestamp
+	48, // 37: reviewer.v1.ReviewEvent.deadline:type_name -> google.protobuf.Timestamp
+	38, // 38: reviewer.v1.StreamEventsResponse.event:type_name -> reviewer.v1.ReviewEvent
+	33, // 39: reviewer.v1.Stats.TimeToMergePerTeamEntry.value:type_name -> reviewer.v1.DurationPercentiles
+	33, // 40: reviewer.v1.Stats.TimeToMergePerAuthorEntry.value:type_name -> reviewer.v1.DurationPercentiles
+	34, // 41: reviewer.v1.Stats.ReviewerThroughputPerWeekEntry.value:type_name -> reviewer.v1.WeeklyCounts
+	5,  // 42: reviewer.v1.ReviewerService.AddTeam:input_type -> reviewer.v1.AddTeamRequest
+	9,  // 43: reviewer.v1.ReviewerService.GetTeam:input_type -> reviewer.v1.GetTeamRequest
+	10, // 44: reviewer.v1.ReviewerService.AddTeamMembers:input_type -> reviewer.v1.AddTeamMembersRequest
+	11, // 45: reviewer.v1.ReviewerService.RemoveTeamMembers:input_type -> reviewer.v1.RemoveTeamMembersRequest
+	12, // 46: reviewer.v1.ReviewerService.MoveTeamMember:input_type -> reviewer.v1.MoveTeamMemberRequest
+	14, // 47: reviewer.v1.ReviewerService.RenameTeam:input_type -> reviewer.v1.RenameTeamRequest
+	15, // 48: reviewer.v1.ReviewerService.SetTeamSla:input_type -> reviewer.v1.SetTeamSlaRequest
+	16, // 49: reviewer.v1.ReviewerService.DeleteTeam:input_type -> reviewer.v1.DeleteTeamRequest
+	18, // 50: reviewer.v1.ReviewerService.SetUserActive:input_type -> reviewer.v1.SetUserActiveRequest
+	20, // 51: reviewer.v1.ReviewerService.GetUserReviews:input_type -> reviewer.v1.GetUserReviewsRequest
+	28, // 52: reviewer.v1.ReviewerService.CreatePullRequest:input_type -> reviewer.v1.CreatePullRequestRequest
+	27, // 53: reviewer.v1.ReviewerService.GetPullRequest:input_type -> reviewer.v1.PullRequestRef
+	27, // 54: reviewer.v1.ReviewerService.MergePullRequest:input_type -> reviewer.v1.PullRequestRef
+	29, // 55: reviewer.v1.ReviewerService.ReassignReviewer:input_type -> reviewer.v1.ReassignReviewerRequest
+	31, // 56: reviewer.v1.ReviewerService.SubmitReview:input_type -> reviewer.v1.SubmitReviewRequest
+	32, // 57: reviewer.v1.ReviewerService.GetStats:input_type -> reviewer.v1.GetStatsRequest
+	37, // 58: reviewer.v1.ReviewerService.StreamEvents:input_type -> reviewer.v1.StreamEventsRequest
+	8,  // 59: reviewer.v1.ReviewerService.AddTeam:output_type -> reviewer.v1.AddTeamResponse
+	2,  // 60: reviewer.v1.ReviewerService.GetTeam:output_type -> reviewer.v1.Team
+	2,  // 61: reviewer.v1.ReviewerService.AddTeamMembers:output_type -> reviewer.v1.Team
+	13, // 62: reviewer.v1.ReviewerService.RemoveTeamMembers:output_type -> reviewer.v1.TeamMembersChange
+	13, // 63: reviewer.v1.ReviewerService.MoveTeamMember:output_type -> reviewer.v1.TeamMembersChange
+	2,  // 64: reviewer.v1.ReviewerService.RenameTeam:output_type -> reviewer.v1.Team
+	2,  // 65: reviewer.v1.ReviewerService.SetTeamSla:output_type -> reviewer.v1.Team
+	17, // 66: reviewer.v1.ReviewerService.DeleteTeam:output_type -> reviewer.v1.DeleteTeamResponse
+	19, // 67: reviewer.v1.ReviewerService.SetUserActive:output_type -> reviewer.v1.UserActivityChange
+	22, // 68: reviewer.v1.ReviewerService.GetUserReviews:output_type -> reviewer.v1.GetUserReviewsResponse
+	23, // 69: reviewer.v1.ReviewerService.CreatePullRequest:output_type -> reviewer.v1.PullRequest
+	26, // 70: reviewer.v1.ReviewerService.GetPullRequest:output_type -> reviewer.v1.PullRequestDetail
+	23, // 71: reviewer.v1.ReviewerService.MergePullRequest:output_type -> reviewer.v1.PullRequest
+	30, // 72: reviewer.v1.ReviewerService.ReassignReviewer:output_type -> reviewer.v1.ReassignReviewerResponse
+	26, // 73: reviewer.v1.ReviewerService.SubmitReview:output_type -> reviewer.v1.PullRequestDetail
+	36, // 74: reviewer.v1.ReviewerService.GetStats:output_type -> reviewer.v1.Stats
+	39, // 75: reviewer.v1.ReviewerService.StreamEvents:output_type -> reviewer.v1.StreamEventsResponse
+	59, // [59:76] is the sub-list for method output_type
+	42, // [42:59] is the sub-list for method input_type
+	42, // [42:42] is the sub-list for extension type_name
+	42, // [42:42] is the sub-list for extension extendee
+	0,  // [0:42] is the sub-list for field type_name
+}
+
+func init() { file_reviewer_proto_init() }
+func file_reviewer_proto_init() {
+	if File_reviewer_proto != nil {
+		return
+	}
+	file_reviewer_proto_msgTypes[4].OneofWrappers = []any{}
+	file_reviewer_proto_msgTypes[10].OneofWrappers = []any{}
+	file_reviewer_proto_msgTypes[12].OneofWrappers = []any{}
+	file_reviewer_proto_msgTypes[19].OneofWrappers = []any{}
+	file_reviewer_proto_msgTypes[20].OneofWrappers = []any{}
+	file_reviewer_proto_msgTypes[21].OneofWrappers = []any{}
+	file_reviewer_proto_msgTypes[22].OneofWrappers = []any{}
+	file_reviewer_proto_msgTypes[23].OneofWrappers = []any{}
+	file_reviewer_proto_msgTypes[25].OneofWrappers = []any{}
+	file_reviewer_proto_msgTypes[26].OneofWrappers = []any{}
+	file_reviewer_proto_msgTypes[27].OneofWrappers = []any{}
+	file_reviewer_proto_msgTypes[28].OneofWrappers = []any{}
+	file_reviewer_proto_msgTypes[29].OneofWrappers = []any{}
+	file_reviewer_proto_msgTypes[31].OneofWrappers = []any{}
+	file_reviewer_proto_msgTypes[38].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_reviewer_proto_rawDesc), len(file_reviewer_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   48,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_reviewer_proto_goTypes,
+		DependencyIndexes: file_reviewer_proto_depIdxs,
+		MessageInfos:      file_reviewer_proto_msgTypes,
+	}.Build()
+	File_reviewer_proto = out.File
+	file_reviewer_proto_goTypes = nil
+	file_reviewer_proto_depIdxs = nil
+}
@@ -0,0 +1,771 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: reviewer.proto
+
+// gRPC API сервиса назначения ревьюверов. Операции и значения перечислений (статусы, роли, сортировки)
+// совпадают с REST API из specfile/openapi.yml; ошибки - статусы gRPC с кодом ErrorResponse в ErrorInfo.reason.
+// Организация запроса - метаданные authorization (Bearer-токен) или x-organization, инициатор - user-id
+
+package reviewerpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ReviewerService_AddTeam_FullMethodName           = "/reviewer.v1.ReviewerService/AddTeam"
+	ReviewerService_GetTeam_FullMethodName           = "/reviewer.v1.ReviewerService/GetTeam"
+	ReviewerService_AddTeamMembers_FullMethodName    = "/reviewer.v1.ReviewerService/AddTeamMembers"
+	ReviewerService_RemoveTeamMembers_FullMethodName = "/reviewer.v1.ReviewerService/RemoveTeamMembers"
+	ReviewerService_MoveTeamMember_FullMethodName    = "/reviewer.v1.ReviewerService/MoveTeamMember"
+	ReviewerService_RenameTeam_FullMethodName        = "/reviewer.v1.ReviewerService/RenameTeam"
+	ReviewerService_SetTeamSla_FullMethodName        = "/reviewer.v1.ReviewerService/SetTeamSla"
+	ReviewerService_DeleteTeam_FullMethodName        = "/reviewer.v1.ReviewerService/DeleteTeam"
+	ReviewerService_SetUserActive_FullMethodName     = "/reviewer.v1.ReviewerService/SetUserActive"
+	ReviewerService_GetUserReviews_FullMethodName    = "/reviewer.v1.ReviewerService/GetUserReviews"
+	ReviewerService_CreatePullRequest_FullMethodName = "/reviewer.v1.ReviewerService/CreatePullRequest"
+	ReviewerService_GetPullRequest_FullMethodName    = "/reviewer.v1.ReviewerService/GetPullRequest"
+	ReviewerService_MergePullRequest_FullMethodName  = "/reviewer.v1.ReviewerService/MergePullRequest"
+	ReviewerService_ReassignReviewer_FullMethodName  = "/reviewer.v1.ReviewerService/ReassignReviewer"
+	ReviewerService_SubmitReview_FullMethodName      = "/reviewer.v1.ReviewerService/SubmitReview"
+	ReviewerService_GetStats_FullMethodName          = "/reviewer.v1.ReviewerService/GetStats"
+	ReviewerService_StreamEvents_FullMethodName      = "/reviewer.v1.ReviewerService/StreamEvents"
+)
+
+// ReviewerServiceClient is the client API for ReviewerService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ReviewerServiceClient interface {
+	// Создать команду с участниками или синхронизировать состав существующей (POST /team/add)
+	AddTeam(ctx context.Context, in *AddTeamRequest, opts ...grpc.CallOption) (*AddTeamResponse, error)
+	// Получить команду с участниками (GET /team/get)
+	GetTeam(ctx context.Context, in *GetTeamRequest, opts ...grpc.CallOption) (*Team, error)
+	// Добавить существующих пользователей в команду (POST /team/addMembers)
+	AddTeamMembers(ctx context.Context, in *AddTeamMembersRequest, opts ...grpc.CallOption) (*Team, error)
+	// Исключить пользователей из команды с переназначением их открытых ревью (POST /team/removeMembers)
+	RemoveTeamMembers(ctx context.Context, in *RemoveTeamMembersRequest, opts ...grpc.CallOption) (*TeamMembersChange, error)
+	// Перевести пользователя в другую команду (POST /team/moveMember)
+	MoveTeamMember(ctx context.Context, in *MoveTeamMemberRequest, opts ...grpc.CallOption) (*TeamMembersChange, error)
+	// Переименовать команду (POST /team/rename)
+	RenameTeam(ctx context.Context, in *RenameTeamRequest, opts ...grpc.CallOption) (*Team, error)
+	// Задать SLA первого ответа ревьювера (POST /team/setSla)
+	SetTeamSla(ctx context.Context, in *SetTeamSlaRequest, opts ...grpc.CallOption) (*Team, error)
+	// Удалить пустую команду (POST /team/delete)
+	DeleteTeam(ctx context.Context, in *DeleteTeamRequest, opts ...grpc.CallOption) (*DeleteTeamResponse, error)
+	// Установить флаг активности пользователя (POST /users/setIsActive)
+	SetUserActive(ctx context.Context, in *SetUserActiveRequest, opts ...grpc.CallOption) (*UserActivityChange, error)
+	// PR, где пользователь назначен ревьювером (GET /users/getReview)
+	GetUserReviews(ctx context.Context, in *GetUserReviewsRequest, opts ...grpc.CallOption) (*GetUserReviewsResponse, error)
+	// Создать PR и назначить ревьюверов (POST /pullRequest/create)
+	CreatePullRequest(ctx context.Context, in *CreatePullRequestRequest, opts ...grpc.CallOption) (*PullRequest, error)
+	// Получить PR с ревьюверами и историей (GET /pullRequest/get)
+	GetPullRequest(ctx context.Context, in *PullRequestRef, opts ...grpc.CallOption) (*PullRequestDetail, error)
+	// Пометить PR как MERGED, идемпотентно (POST /pullRequest/merge)
+	MergePullRequest(ctx context.Context, in *PullRequestRef, opts ...grpc.CallOption) (*PullRequest, error)
+	// Переназначить ревьювера на другого из его команды (POST /pullRequest/reassign)
+	ReassignReviewer(ctx context.Context, in *ReassignReviewerRequest, opts ...grpc.CallOption) (*ReassignReviewerResponse, error)
+	// Отметить ответ ревьювера по PR (POST /pullRequest/review)
+	SubmitReview(ctx context.Context, in *SubmitReviewRequest, opts ...grpc.CallOption) (*PullRequestDetail, error)
+	// Статистика назначений, нарушений SLA и метрики по времени (GET /admin/stats)
+	GetStats(ctx context.Context, in *GetStatsRequest, opts ...grpc.CallOption) (*Stats, error)
+	// Поток событий о назначениях пользователя или команды (GET /events/stream)
+	StreamEvents(ctx context.Context, in *StreamEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamEventsResponse], error)
+}
+
+type reviewerServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewReviewerServiceClient(cc grpc.ClientConnInterface) ReviewerServiceClient {
+	return &reviewerServiceClient{cc}
+}
+
+func (c *reviewerServiceClient) AddTeam(ctx context.Context, in *AddTeamRequest, opts ...grpc.CallOption) (*AddTeamResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddTeamResponse)
+	err := c.cc.Invoke(ctx, ReviewerService_AddTeam_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewerServiceClient) GetTeam(ctx context.Context, in *GetTeamRequest, opts ...grpc.CallOption) (*Team, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Team)
+	err := c.cc.Invoke(ctx, ReviewerService_GetTeam_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewerServiceClient) AddTeamMembers(ctx context.Context, in *AddTeamMembersRequest, opts ...grpc.CallOption) (*Team, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Team)
+	err := c.cc.Invoke(ctx, ReviewerService_AddTeamMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewerServiceClient) RemoveTeamMembers(ctx context.Context, in *RemoveTeamMembersRequest, opts ...grpc.CallOption) (*TeamMembersChange, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TeamMembersChange)
+	err := c.cc.Invoke(ctx, ReviewerService_RemoveTeamMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewerServiceClient) MoveTeamMember(ctx context.Context, in *MoveTeamMemberRequest, opts ...grpc.CallOption) (*TeamMembersChange, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TeamMembersChange)
+	err := c.cc.Invoke(ctx, ReviewerService_MoveTeamMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewerServiceClient) RenameTeam(ctx context.Context, in *RenameTeamRequest, opts ...grpc.CallOption) (*Team, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Team)
+	err := c.cc.Invoke(ctx, ReviewerService_RenameTeam_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewerServiceClient) SetTeamSla(ctx context.Context, in *SetTeamSlaRequest, opts ...grpc.CallOption) (*Team, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Team)
+	err := c.cc.Invoke(ctx, ReviewerService_SetTeamSla_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewerServiceClient) DeleteTeam(ctx context.Context, in *DeleteTeamRequest, opts ...grpc.CallOption) (*DeleteTeamResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteTeamResponse)
+	err := c.cc.Invoke(ctx, ReviewerService_DeleteTeam_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewerServiceClient) SetUserActive(ctx context.Context, in *SetUserActiveRequest, opts ...grpc.CallOption) (*UserActivityChange, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserActivityChange)
+	err := c.cc.Invoke(ctx, ReviewerService_SetUserActive_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewerServiceClient) GetUserReviews(ctx context.Context, in *GetUserReviewsRequest, opts ...grpc.CallOption) (*GetUserReviewsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUserReviewsResponse)
+	err := c.cc.Invoke(ctx, ReviewerService_GetUserReviews_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewerServiceClient) CreatePullRequest(ctx context.Context, in *CreatePullRequestRequest, opts ...grpc.CallOption) (*PullRequest, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PullRequest)
+	err := c.cc.Invoke(ctx, ReviewerService_CreatePullRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewerServiceClient) GetPullRequest(ctx context.Context, in *PullRequestRef, opts ...grpc.CallOption) (*PullRequestDetail, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PullRequestDetail)
+	err := c.cc.Invoke(ctx, ReviewerService_GetPullRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewerServiceClient) MergePullRequest(ctx context.Context, in *PullRequestRef, opts ...grpc.CallOption) (*PullRequest, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PullRequest)
+	err := c.cc.Invoke(ctx, ReviewerService_MergePullRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewerServiceClient) ReassignReviewer(ctx context.Context, in *ReassignReviewerRequest, opts ...grpc.CallOption) (*ReassignReviewerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReassignReviewerResponse)
+	err := c.cc.Invoke(ctx, ReviewerService_ReassignReviewer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewerServiceClient) SubmitReview(ctx context.Context, in *SubmitReviewRequest, opts ...grpc.CallOption) (*PullRequestDetail, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PullRequestDetail)
+	err := c.cc.Invoke(ctx, ReviewerService_SubmitReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewerServiceClient) GetStats(ctx context.Context, in *GetStatsRequest, opts ...grpc.CallOption) (*Stats, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Stats)
+	err := c.cc.Invoke(ctx, ReviewerService_GetStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewerServiceClient) StreamEvents(ctx context.Context, in *StreamEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamEventsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ReviewerService_ServiceDesc.Streams[0], ReviewerService_StreamEvents_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamEventsRequest, StreamEventsResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ReviewerService_StreamEventsClient = grpc.ServerStreamingClient[StreamEventsResponse]
+
+// ReviewerServiceServer is the server API for ReviewerService service.
+// All implementations must embed UnimplementedReviewerServiceServer
+// for forward compatibility.
+type ReviewerServiceServer interface {
+	// Создать команду с участниками или синхронизировать состав существующей (POST /team/add)
+	AddTeam(context.Context, *AddTeamRequest) (*AddTeamResponse, error)
+	// Получить команду с участниками (GET /team/get)
+	GetTeam(context.Context, *GetTeamRequest) (*Team, error)
+	// Добавить существующих пользователей в команду (POST /team/addMembers)
+	AddTeamMembers(context.Context, *AddTeamMembersRequest) (*Team, error)
+	// Исключить пользователей из команды с переназначением их открытых ревью (POST /team/removeMembers)
+	RemoveTeamMembers(context.Context, *RemoveTeamMembersRequest) (*TeamMembersChange, error)
+	// Перевести пользователя в другую команду (POST /team/moveMember)
+	MoveTeamMember(context.Context, *MoveTeamMemberRequest) (*TeamMembersChange, error)
+	// Переименовать команду (POST /team/rename)
+	RenameTeam(context.Context, *RenameTeamRequest) (*Team, error)
+	// Задать SLA первого ответа ревьювера (POST /team/setSla)
+	SetTeamSla(context.Context, *SetTeamSlaRequest) (*Team, error)
+	// Удалить пустую команду (POST /team/delete)
+	DeleteTeam(context.Context, *DeleteTeamRequest) (*DeleteTeamResponse, error)
+	// Установить флаг активности пользователя (POST /users/setIsActive)
+	SetUserActive(context.Context, *SetUserActiveRequest) (*UserActivityChange, error)
+	// PR, где пользователь назначен ревьювером (GET /users/getReview)
+	GetUserReviews(context.Context, *GetUserReviewsRequest) (*GetUserReviewsResponse, error)
+	// Создать PR и назначить ревьюверов (POST /pullRequest/create)
+	CreatePullRequest(context.Context, *CreatePullRequestRequest) (*PullRequest, error)
+	// Получить PR с ревьюверами и историей (GET /pullRequest/get)
+	GetPullRequest(context.Context, *PullRequestRef) (*PullRequestDetail, error)
+	// Пометить PR как MERGED, идемпотентно (POST /pullRequest/merge)
+	MergePullRequest(context.Context, *PullRequestRef) (*PullRequest, error)
+	// Переназначить ревьювера на другого из его команды (POST /pullRequest/reassign)
+	ReassignReviewer(context.Context, *ReassignReviewerRequest) (*ReassignReviewerResponse, error)
+	// Отметить ответ ревьювера по PR (POST /pullRequest/review)
+	SubmitReview(context.Context, *SubmitReviewRequest) (*PullRequestDetail, error)
+	// Статистика назначений, нарушений SLA и метрики по времени (GET /admin/stats)
+	GetStats(context.Context, *GetStatsRequest) (*Stats, error)
+	// Поток событий о назначениях пользователя или команды (GET /events/stream)
+	StreamEvents(*StreamEventsRequest, grpc.ServerStreamingServer[StreamEventsResponse]) error
+	mustEmbedUnimplementedReviewerServiceServer()
+}
+
+// UnimplementedReviewerServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedReviewerServiceServer struct{}
+
+func (UnimplementedReviewerServiceServer) AddTeam(context.Context, *AddTeamRequest) (*AddTeamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddTeam not implemented")
+}
+func (UnimplementedReviewerServiceServer) GetTeam(context.Context, *GetTeamRequest) (*Team, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTeam not implemented")
+}
+func (UnimplementedReviewerServiceServer) AddTeamMembers(context.Context, *AddTeamMembersRequest) (*Team, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddTeamMembers not implemented")
+}
+func (UnimplementedReviewerServiceServer) RemoveTeamMembers(context.Context, *RemoveTeamMembersRequest) (*TeamMembersChange, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveTeamMembers not implemented")
+}
+func (UnimplementedReviewerServiceServer) MoveTeamMember(context.Context, *MoveTeamMemberRequest) (*TeamMembersChange, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveTeamMember not implemented")
+}
+func (UnimplementedReviewerServiceServer) RenameTeam(context.Context, *RenameTeamRequest) (*Team, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameTeam not implemented")
+}
+func (UnimplementedReviewerServiceServer) SetTeamSla(context.Context, *SetTeamSlaRequest) (*Team, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTeamSla not implemented")
+}
+func (UnimplementedReviewerServiceServer) DeleteTeam(context.Context, *DeleteTeamRequest) (*DeleteTeamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTeam not implemented")
+}
+func (UnimplementedReviewerServiceServer) SetUserActive(context.Context, *SetUserActiveRequest) (*UserActivityChange, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetUserActive not implemented")
+}
+func (UnimplementedReviewerServiceServer) GetUserReviews(context.Context, *GetUserReviewsRequest) (*GetUserReviewsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserReviews not implemented")
+}
+func (UnimplementedReviewerServiceServer) CreatePullRequest(context.Context, *CreatePullRequestRequest) (*PullRequest, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePullRequest not implemented")
+}
+func (UnimplementedReviewerServiceServer) GetPullRequest(context.Context, *PullRequestRef) (*PullRequestDetail, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPullRequest not implemented")
+}
+func (UnimplementedReviewerServiceServer) MergePullRequest(context.Context, *PullRequestRef) (*PullRequest, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergePullRequest not implemented")
+}
+func (UnimplementedReviewerServiceServer) ReassignReviewer(context.Context, *ReassignReviewerRequest) (*ReassignReviewerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReassignReviewer not implemented")
+}
+func (UnimplementedReviewerServiceServer) SubmitReview(context.Context, *SubmitReviewRequest) (*PullRequestDetail, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitReview not implemented")
+}
+func (UnimplementedReviewerServiceServer) GetStats(context.Context, *GetStatsRequest) (*Stats, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStats not implemented")
+}
+func (UnimplementedReviewerServiceServer) StreamEvents(*StreamEventsRequest, grpc.ServerStreamingServer[StreamEventsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamEvents not implemented")
+}
+func (UnimplementedReviewerServiceServer) mustEmbedUnimplementedReviewerServiceServer() {}
+func (UnimplementedReviewerServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeReviewerServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ReviewerServiceServer will
+// result in compilation errors.
+type UnsafeReviewerServiceServer interface {
+	mustEmbedUnimplementedReviewerServiceServer()
+}
+
+func RegisterReviewerServiceServer(s grpc.ServiceRegistrar, srv ReviewerServiceServer) {
+	// If the following call pancis, it indicates UnimplementedReviewerServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ReviewerService_ServiceDesc, srv)
+}
+
+func _ReviewerService_AddTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddTeamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewerServiceServer).AddTeam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewerService_AddTeam_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewerServiceServer).AddTeam(ctx, req.(*AddTeamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewerService_GetTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTeamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewerServiceServer).GetTeam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewerService_GetTeam_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewerServiceServer).GetTeam(ctx, req.(*GetTeamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewerService_AddTeamMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddTeamMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewerServiceServer).AddTeamMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewerService_AddTeamMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewerServiceServer).AddTeamMembers(ctx, req.(*AddTeamMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewerService_RemoveTeamMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveTeamMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewerServiceServer).RemoveTeamMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewerService_RemoveTeamMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewerServiceServer).RemoveTeamMembers(ctx, req.(*RemoveTeamMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewerService_MoveTeamMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveTeamMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewerServiceServer).MoveTeamMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewerService_MoveTeamMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewerServiceServer).MoveTeamMember(ctx, req.(*MoveTeamMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewerService_RenameTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameTeamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewerServiceServer).RenameTeam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewerService_RenameTeam_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewerServiceServer).RenameTeam(ctx, req.(*RenameTeamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewerService_SetTeamSla_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTeamSlaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewerServiceServer).SetTeamSla(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewerService_SetTeamSla_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewerServiceServer).SetTeamSla(ctx, req.(*SetTeamSlaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewerService_DeleteTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTeamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewerServiceServer).DeleteTeam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewerService_DeleteTeam_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewerServiceServer).DeleteTeam(ctx, req.(*DeleteTeamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewerService_SetUserActive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetUserActiveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewerServiceServer).SetUserActive(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewerService_SetUserActive_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewerServiceServer).SetUserActive(ctx, req.(*SetUserActiveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewerService_GetUserReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserReviewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewerServiceServer).GetUserReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewerService_GetUserReviews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewerServiceServer).GetUserReviews(ctx, req.(*GetUserReviewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewerService_CreatePullRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePullRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewerServiceServer).CreatePullRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewerService_CreatePullRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewerServiceServer).CreatePullRequest(ctx, req.(*CreatePullRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewerService_GetPullRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PullRequestRef)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewerServiceServer).GetPullRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewerService_GetPullRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewerServiceServer).GetPullRequest(ctx, req.(*PullRequestRef))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewerService_MergePullRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PullRequestRef)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewerServiceServer).MergePullRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewerService_MergePullRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewerServiceServer).MergePullRequest(ctx, req.(*PullRequestRef))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewerService_ReassignReviewer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReassignReviewerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewerServiceServer).ReassignReviewer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewerService_ReassignReviewer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewerServiceServer).ReassignReviewer(ctx, req.(*ReassignReviewerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewerService_SubmitReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewerServiceServer).SubmitReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewerService_SubmitReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewerServiceServer).SubmitReview(ctx, req.(*SubmitReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewerService_GetStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewerServiceServer).GetStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewerService_GetStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewerServiceServer).GetStats(ctx, req.(*GetStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewerService_StreamEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ReviewerServiceServer).StreamEvents(m, &grpc.GenericServerStream[StreamEventsRequest, StreamEventsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ReviewerService_StreamEventsServer = grpc.ServerStreamingServer[StreamEventsResponse]
+
+// ReviewerService_ServiceDesc is the grpc.ServiceDesc for ReviewerService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ReviewerService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "reviewer.v1.ReviewerService",
+	HandlerType: (*ReviewerServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddTeam",
+			Handler:    _ReviewerService_AddTeam_Handler,
+		},
+		{
+			MethodName: "GetTeam",
+			Handler:    _ReviewerService_GetTeam_Handler,
+		},
+		{
+			MethodName: "AddTeamMembers",
+			Handler:    _ReviewerService_AddTeamMembers_Handler,
+		},
+		{
+			MethodName: "RemoveTeamMembers",
+			Handler:    _ReviewerService_RemoveTeamMembers_Handler,
+		},
+		{
+			MethodName: "MoveTeamMember",
+			Handler:    _ReviewerService_MoveTeamMember_Handler,
+		},
+		{
+			MethodName: "RenameTeam",
+			Handler:    _ReviewerService_RenameTeam_Handler,
+		},
+		{
+			MethodName: "SetTeamSla",
+			Handler:    _ReviewerService_SetTeamSla_Handler,
+		},
+		{
+			MethodName: "DeleteTeam",
+			Handler:    _ReviewerService_DeleteTeam_Handler,
+		},
+		{
+			MethodName: "SetUserActive",
+			Handler:    _ReviewerService_SetUserActive_Handler,
+		},
+		{
+			MethodName: "GetUserReviews",
+			Handler:    _ReviewerService_GetUserReviews_Handler,
+		},
+		{
+			MethodName: "CreatePullRequest",
+			Handler:    _ReviewerService_CreatePullRequest_Handler,
+		},
+		{
+			MethodName: "GetPullRequest",
+			Handler:    _ReviewerService_GetPullRequest_Handler,
+		},
+		{
+			MethodName: "MergePullRequest",
+			Handler:    _ReviewerService_MergePullRequest_Handler,
+		},
+		{
+			MethodName: "ReassignReviewer",
+			Handler:    _ReviewerService_ReassignReviewer_Handler,
+		},
+		{
+			MethodName: "SubmitReview",
+			Handler:    _ReviewerService_SubmitReview_Handler,
+		},
+		{
+			MethodName: "GetStats",
+			Handler:    _ReviewerService_GetStats_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamEvents",
+			Handler:       _ReviewerService_StreamEvents_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "reviewer.proto",
+}
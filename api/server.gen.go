@@ -4,22 +4,121 @@
 package openapi
 
 import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
 	"fmt"
 	"net/http"
+	"net/url"
+	"path"
+	"strings"
 	"time"
 
+	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/go-chi/chi/v5"
 	"github.com/oapi-codegen/runtime"
 )
 
+// Defines values for AuditAction.
+const (
+	AuditActionCHANGESETREVERTED   AuditAction = "CHANGESET_REVERTED"
+	AuditActionPRCREATED           AuditAction = "PR_CREATED"
+	AuditActionPRMERGED            AuditAction = "PR_MERGED"
+	AuditActionREVIEWERASSIGNED    AuditAction = "REVIEWER_ASSIGNED"
+	AuditActionREVIEWERREASSIGNED  AuditAction = "REVIEWER_REASSIGNED"
+	AuditActionREVIEWSUBMITTED     AuditAction = "REVIEW_SUBMITTED"
+	AuditActionSLABREACHED         AuditAction = "SLA_BREACHED"
+	AuditActionTEAMMASSDEACTIVATED AuditAction = "TEAM_MASS_DEACTIVATED"
+	AuditActionUSERACTIVATED       AuditAction = "USER_ACTIVATED"
+	AuditActionUSERDEACTIVATED     AuditAction = "USER_DEACTIVATED"
+)
+
+// Defines values for ChangesetKind.
+const (
+	ChangesetKindMassDeactivation ChangesetKind = "mass_deactivation"
+	ChangesetKindUserDeactivation ChangesetKind = "user_deactivation"
+)
+
+// Defines values for ChangesetConflictReason.
+const (
+	AlreadyAssigned ChangesetConflictReason = "already_assigned"
+	PrMerged        ChangesetConflictReason = "pr_merged"
+	PrNotFound      ChangesetConflictReason = "pr_not_found"
+	ReviewerChanged ChangesetConflictReason = "reviewer_changed"
+	UserChanged     ChangesetConflictReason = "user_changed"
+	UserNotFound    ChangesetConflictReason = "user_not_found"
+)
+
 // Defines values for ErrorResponseErrorCode.
 const (
-	NOCANDIDATE ErrorResponseErrorCode = "NO_CANDIDATE"
-	NOTASSIGNED ErrorResponseErrorCode = "NOT_ASSIGNED"
-	NOTFOUND    ErrorResponseErrorCode = "NOT_FOUND"
-	PREXISTS    ErrorResponseErrorCode = "PR_EXISTS"
-	PRMERGED    ErrorResponseErrorCode = "PR_MERGED"
-	TEAMEXISTS  ErrorResponseErrorCode = "TEAM_EXISTS"
+	ErrorResponseErrorCodeCHANGESETINPROGRESS   ErrorResponseErrorCode = "CHANGESET_IN_PROGRESS"
+	ErrorResponseErrorCodeCHANGESETNOTFOUND     ErrorResponseErrorCode = "CHANGESET_NOT_FOUND"
+	ErrorResponseErrorCodeCHANGESETREVERTED     ErrorResponseErrorCode = "CHANGESET_REVERTED"
+	ErrorResponseErrorCodeFORBIDDEN             ErrorResponseErrorCode = "FORBIDDEN"
+	ErrorResponseErrorCodeIDEMPOTENCYINPROGRESS ErrorResponseErrorCode = "IDEMPOTENCY_IN_PROGRESS"
+	ErrorResponseErrorCodeIDEMPOTENCYKEYREUSED  ErrorResponseErrorCode = "IDEMPOTENCY_KEY_REUSED"
+	ErrorResponseErrorCodeINVALIDCURSOR         ErrorResponseErrorCode = "INVALID_CURSOR"
+	ErrorResponseErrorCodeINVALIDFORMAT         ErrorResponseErrorCode = "INVALID_FORMAT"
+	ErrorResponseErrorCodeINVALIDIMPORTFILE     ErrorResponseErrorCode = "INVALID_IMPORT_FILE"
+	ErrorResponseErrorCodeINVALIDLIMIT          ErrorResponseErrorCode = "INVALID_LIMIT"
+	ErrorResponseErrorCodeINVALIDQUERY          ErrorResponseErrorCode = "INVALID_QUERY"
+	ErrorResponseErrorCodeINVALIDREQUEST        ErrorResponseErrorCode = "INVALID_REQUEST"
+	ErrorResponseErrorCodeINVALIDROLE           ErrorResponseErrorCode = "INVALID_ROLE"
+	ErrorResponseErrorCodeINVALIDSLA            ErrorResponseErrorCode = "INVALID_SLA"
+	ErrorResponseErrorCodeINVALIDTOKEN          ErrorResponseErrorCode = "INVALID_TOKEN"
+	ErrorResponseErrorCodeJOBNOTFOUND           ErrorResponseErrorCode = "JOB_NOT_FOUND"
+	ErrorResponseErrorCodeMETHODNOTALLOWED      ErrorResponseErrorCode = "METHOD_NOT_ALLOWED"
+	ErrorResponseErrorCodeNOAVAILABLEREVIEWERS  ErrorResponseErrorCode = "NO_AVAILABLE_REVIEWERS"
+	ErrorResponseErrorCodeNOCANDIDATE           ErrorResponseErrorCode = "NO_CANDIDATE"
+	ErrorResponseErrorCodeNOTASSIGNED           ErrorResponseErrorCode = "NOT_ASSIGNED"
+	ErrorResponseErrorCodeNOTFOUND              ErrorResponseErrorCode = "NOT_FOUND"
+	ErrorResponseErrorCodeNOTTEAMMEMBER         ErrorResponseErrorCode = "NOT_TEAM_MEMBER"
+	ErrorResponseErrorCodeORGANIZATIONEXISTS    ErrorResponseErrorCode = "ORGANIZATION_EXISTS"
+	ErrorResponseErrorCodeORGANIZATIONNOTFOUND  ErrorResponseErrorCode = "ORGANIZATION_NOT_FOUND"
+	ErrorResponseErrorCodePRAMBIGUOUS           ErrorResponseErrorCode = "PR_AMBIGUOUS"
+	ErrorResponseErrorCodePREXISTS              ErrorResponseErrorCode = "PR_EXISTS"
+	ErrorResponseErrorCodePRMERGED              ErrorResponseErrorCode = "PR_MERGED"
+	ErrorResponseErrorCodePRNOTFOUND            ErrorResponseErrorCode = "PR_NOT_FOUND"
+	ErrorResponseErrorCodeRATELIMITED           ErrorResponseErrorCode = "RATE_LIMITED"
+	ErrorResponseErrorCodeREPOSITORYEXISTS      ErrorResponseErrorCode = "REPOSITORY_EXISTS"
+	ErrorResponseErrorCodeREPOSITORYNOTFOUND    ErrorResponseErrorCode = "REPOSITORY_NOT_FOUND"
+	ErrorResponseErrorCodeTEAMEXISTS            ErrorResponseErrorCode = "TEAM_EXISTS"
+	ErrorResponseErrorCodeTEAMNOTEMPTY          ErrorResponseErrorCode = "TEAM_NOT_EMPTY"
+	ErrorResponseErrorCodeTEAMNOTFOUND          ErrorResponseErrorCode = "TEAM_NOT_FOUND"
+	ErrorResponseErrorCodeUNAUTHORIZED          ErrorResponseErrorCode = "UNAUTHORIZED"
+	ErrorResponseErrorCodeUNKNOWNERROR          ErrorResponseErrorCode = "UNKNOWN_ERROR"
+	ErrorResponseErrorCodeUSEREXISTS            ErrorResponseErrorCode = "USER_EXISTS"
+	ErrorResponseErrorCodeUSERNOTFOUND          ErrorResponseErrorCode = "USER_NOT_FOUND"
+)
+
+// Defines values for ImportRowResultResult.
+const (
+	Attached  ImportRowResultResult = "attached"
+	Created   ImportRowResultResult = "created"
+	Invalid   ImportRowResultResult = "invalid"
+	Unchanged ImportRowResultResult = "unchanged"
+	Updated   ImportRowResultResult = "updated"
+)
+
+// Defines values for JobKind.
+const (
+	JobKindMassDeactivation JobKind = "mass_deactivation"
+)
+
+// Defines values for JobOutcomeOutcome.
+const (
+	JobOutcomeOutcomeNoCandidate JobOutcomeOutcome = "no_candidate"
+	JobOutcomeOutcomePending     JobOutcomeOutcome = "pending"
+	JobOutcomeOutcomeReassigned  JobOutcomeOutcome = "reassigned"
+	JobOutcomeOutcomeSkipped     JobOutcomeOutcome = "skipped"
+)
+
+// Defines values for JobStatus.
+const (
+	JobStatusFailed    JobStatus = "failed"
+	JobStatusPending   JobStatus = "pending"
+	JobStatusRunning   JobStatus = "running"
+	JobStatusSucceeded JobStatus = "succeeded"
 )
 
 // Defines values for PullRequestStatus.
@@ -28,60 +127,618 @@ const (
 	PullRequestStatusOPEN   PullRequestStatus = "OPEN"
 )
 
+// Defines values for PullRequestDetailStatus.
+const (
+	PullRequestDetailStatusMERGED PullRequestDetailStatus = "MERGED"
+	PullRequestDetailStatusOPEN   PullRequestDetailStatus = "OPEN"
+)
+
+// Defines values for PullRequestEventEvent.
+const (
+	PullRequestEventEventCREATED            PullRequestEventEvent = "CREATED"
+	PullRequestEventEventMERGED             PullRequestEventEvent = "MERGED"
+	PullRequestEventEventREVIEWERASSIGNED   PullRequestEventEvent = "REVIEWER_ASSIGNED"
+	PullRequestEventEventREVIEWERREASSIGNED PullRequestEventEvent = "REVIEWER_REASSIGNED"
+	PullRequestEventEventREVIEWSUBMITTED    PullRequestEventEvent = "REVIEW_SUBMITTED"
+	PullRequestEventEventSLABREACHED        PullRequestEventEvent = "SLA_BREACHED"
+)
+
 // Defines values for PullRequestShortStatus.
 const (
 	PullRequestShortStatusMERGED PullRequestShortStatus = "MERGED"
 	PullRequestShortStatusOPEN   PullRequestShortStatus = "OPEN"
 )
 
+// Defines values for PullRequestSort.
+const (
+	CreatedAtAsc  PullRequestSort = "created_at_asc"
+	CreatedAtDesc PullRequestSort = "created_at_desc"
+	NameAsc       PullRequestSort = "name_asc"
+	NameDesc      PullRequestSort = "name_desc"
+)
+
+// Defines values for PullRequestStatusFilter.
+const (
+	MERGED PullRequestStatusFilter = "MERGED"
+	OPEN   PullRequestStatusFilter = "OPEN"
+)
+
+// Defines values for ReviewEventType.
+const (
+	PullRequestMerged      ReviewEventType = "pull_request.merged"
+	ReviewerAssigned       ReviewEventType = "reviewer.assigned"
+	ReviewerReassignedAway ReviewEventType = "reviewer.reassigned_away"
+	SlaReminder            ReviewEventType = "sla.reminder"
+)
+
+// Defines values for ReviewerSource.
+const (
+	AuthorTeam ReviewerSource = "author_team"
+	OwnerTeam  ReviewerSource = "owner_team"
+)
+
+// Defines values for SlaAction.
+const (
+	Reassign SlaAction = "reassign"
+	Remind   SlaAction = "remind"
+)
+
+// Defines values for TeamMemberRole.
+const (
+	Lead     TeamMemberRole = "lead"
+	Member   TeamMemberRole = "member"
+	Observer TeamMemberRole = "observer"
+)
+
+// Defines values for TeamMemberUpdateChangedFields.
+const (
+	IsActive TeamMemberUpdateChangedFields = "is_active"
+	Role     TeamMemberUpdateChangedFields = "role"
+	Username TeamMemberUpdateChangedFields = "username"
+)
+
+// Defines values for PostAdminImportParamsFormat.
+const (
+	Csv  PostAdminImportParamsFormat = "csv"
+	Yaml PostAdminImportParamsFormat = "yaml"
+)
+
+// AdminStats defines model for AdminStats.
+type AdminStats struct {
+	// AssignmentsPerPr pull_request_id (для PR из репозитория - repository/pull_request_id) -> количество ревьюверов
+	AssignmentsPerPr map[string]int64 `json:"assignments_per_pr"`
+
+	// AssignmentsPerUser user_id -> количество назначений ревьювером
+	AssignmentsPerUser map[string]int64 `json:"assignments_per_user"`
+
+	// OpenPrAge Корзина возраста (lt_1d, 1d_3d, 3d_7d, 7d_14d, gte_14d) -> количество открытых PR
+	OpenPrAge         map[string]int           `json:"open_pr_age"`
+	ReassignmentTrend []ReassignmentTrendPoint `json:"reassignment_trend"`
+
+	// ReviewerThroughputPerWeek user_id ревьювера -> начало недели -> количество смерженных PR
+	ReviewerThroughputPerWeek map[string]map[string]int `json:"reviewer_throughput_per_week"`
+
+	// SlaBreachesPerTeam team_name -> количество нарушений SLA
+	SlaBreachesPerTeam   map[string]int64               `json:"sla_breaches_per_team"`
+	TimeToMergePerAuthor map[string]DurationPercentiles `json:"time_to_merge_per_author"`
+	TimeToMergePerTeam   map[string]DurationPercentiles `json:"time_to_merge_per_team"`
+}
+
+// AuditAction defines model for AuditAction.
+type AuditAction string
+
+// AuditEntry defines model for AuditEntry.
+type AuditEntry struct {
+	Action AuditAction `json:"action"`
+
+	// Actor Инициатор действия из заголовка User-id (anonymous, если заголовок не передан)
+	Actor         string    `json:"actor"`
+	At            time.Time `json:"at"`
+	Id            int64     `json:"id"`
+	NewReviewerId *string   `json:"new_reviewer_id,omitempty"`
+	OldReviewerId *string   `json:"old_reviewer_id,omitempty"`
+	PullRequestId *string   `json:"pull_request_id,omitempty"`
+
+	// Reason Причина действия (manual, member_removed, member_moved, team_sync, mass_deactivation, user_deactivated, sla_breach, changeset_reverted)
+	Reason *string `json:"reason,omitempty"`
+
+	// Repository Репозиторий PR, отсутствует у PR вне репозиториев
+	Repository *string `json:"repository,omitempty"`
+
+	// Strategy Стратегия выбора ревьювера (random_author_team, random_owner_team, random_reviewer_team, random_team, random_target_team)
+	Strategy *string `json:"strategy,omitempty"`
+	TeamName *string `json:"team_name,omitempty"`
+
+	// UserId Пользователь, которого касается действие (назначенный ревьювер, активированный пользователь)
+	UserId *string `json:"user_id,omitempty"`
+}
+
+// AuditLog defines model for AuditLog.
+type AuditLog struct {
+	Entries    []AuditEntry `json:"entries"`
+	NextCursor *string      `json:"next_cursor,omitempty"`
+}
+
+// Changeset Обратимый набор изменений массовой операции - деактивированные пользователи и замены ревьюверов
+type Changeset struct {
+	Actor string `json:"actor"`
+
+	// Complete Операция завершена (в том числе с ошибкой) и набор можно откатить
+	Complete         bool          `json:"complete"`
+	CreatedAt        time.Time     `json:"created_at"`
+	DeactivatedUsers []string      `json:"deactivated_users"`
+	Id               string        `json:"id"`
+	Kind             ChangesetKind `json:"kind"`
+
+	// Reassignments Замены ревьюверов в порядке применения
+	Reassignments []Reassignment `json:"reassignments"`
+	RevertedAt    *time.Time     `json:"reverted_at,omitempty"`
+	RevertedBy    *string        `json:"reverted_by,omitempty"`
+
+	// TeamName Команда, участники которой деактивированы (для mass_deactivation)
+	TeamName *string `json:"team_name,omitempty"`
+}
+
+// ChangesetKind defines model for Changeset.Kind.
+type ChangesetKind string
+
+// ChangesetConflict defines model for ChangesetConflict.
+type ChangesetConflict struct {
+	PullRequestId *string `json:"pull_request_id,omitempty"`
+
+	// Reason pr_merged - PR уже не открыт, reviewer_changed - заменяющий ревьювер уже снят с PR,
+	// already_assigned - исходный ревьювер снова назначен на PR, user_changed - активность пользователя
+	// меняли после операции, поэтому откат её не трогает
+	Reason ChangesetConflictReason `json:"reason"`
+
+	// Repository Репозиторий PR, отсутствует у PR вне репозиториев
+	Repository *string `json:"repository,omitempty"`
+	UserId     *string `json:"user_id,omitempty"`
+}
+
+// ChangesetConflictReason pr_merged - PR уже не открыт, reviewer_changed - заменяющий ревьювер уже снят с PR,
+// already_assigned - исходный ревьювер снова назначен на PR, user_changed - активность пользователя
+// меняли после операции, поэтому откат её не трогает
+type ChangesetConflictReason string
+
+// ChangesetRevert defines model for ChangesetRevert.
+type ChangesetRevert struct {
+	// Changeset Обратимый набор изменений массовой операции - деактивированные пользователи и замены ревьюверов
+	Changeset Changeset `json:"changeset"`
+
+	// Conflicts Изменения, которые не откатывались, потому что состояние уже изменилось
+	Conflicts        []ChangesetConflict `json:"conflicts"`
+	ReactivatedUsers []string            `json:"reactivated_users"`
+
+	// RestoredReviewers old_reviewer_id - снятый заменяющий ревьювер, new_reviewer_id - возвращённый исходный
+	RestoredReviewers []Reassignment `json:"restored_reviewers"`
+}
+
+// DurationPercentiles Перцентили длительности в часах, метод ближайшего ранга
+type DurationPercentiles struct {
+	Count    int     `json:"count"`
+	P50Hours float64 `json:"p50_hours"`
+	P90Hours float64 `json:"p90_hours"`
+	P99Hours float64 `json:"p99_hours"`
+}
+
 // ErrorResponse defines model for ErrorResponse.
 type ErrorResponse struct {
 	Error struct {
-		Code    ErrorResponseErrorCode `json:"code"`
-		Message string                 `json:"message"`
+		// Code Полный каталог кодов; HTTP-статус для каждого кода фиксирован
+		Code ErrorResponseErrorCode `json:"code"`
+
+		// Details Ошибки по полям запроса (для INVALID_REQUEST)
+		Details *[]FieldError `json:"details,omitempty"`
+		Message string        `json:"message"`
 	} `json:"error"`
 }
 
-// ErrorResponseErrorCode defines model for ErrorResponse.Error.Code.
+// ErrorResponseErrorCode Полный каталог кодов; HTTP-статус для каждого кода фиксирован
 type ErrorResponseErrorCode string
 
+// FieldError defines model for FieldError.
+type FieldError struct {
+	// Field Путь к полю, например members[1].user_id
+	Field   string `json:"field"`
+	Message string `json:"message"`
+
+	// Rule Нарушенное правило (required, id, name, max, unique, oneof, ...)
+	Rule string `json:"rule"`
+}
+
+// GraphQLError defines model for GraphQLError.
+type GraphQLError struct {
+	// Extensions code - код ErrorResponse, если ошибку вернул сервис
+	Extensions *map[string]interface{} `json:"extensions,omitempty"`
+	Locations  *[]struct {
+		Column *int `json:"column,omitempty"`
+		Line   *int `json:"line,omitempty"`
+	} `json:"locations,omitempty"`
+	Message string         `json:"message"`
+	Path    *[]interface{} `json:"path,omitempty"`
+}
+
+// GraphQLRequest defines model for GraphQLRequest.
+type GraphQLRequest struct {
+	OperationName *string `json:"operationName,omitempty"`
+
+	// Query Документ GraphQL по схеме internal/app/graphqlapi/schema.graphql
+	Query     string                  `json:"query"`
+	Variables *map[string]interface{} `json:"variables,omitempty"`
+}
+
+// GraphQLResponse defines model for GraphQLResponse.
+type GraphQLResponse struct {
+	Data   *map[string]interface{} `json:"data"`
+	Errors *[]GraphQLError         `json:"errors,omitempty"`
+}
+
+// ImportReport defines model for ImportReport.
+type ImportReport struct {
+	// Applied Изменения сохранены (false при dry_run или ошибках в файле)
+	Applied bool              `json:"applied"`
+	DryRun  bool              `json:"dry_run"`
+	Rows    []ImportRowResult `json:"rows"`
+	Summary ImportSummary     `json:"summary"`
+}
+
+// ImportRowResult defines model for ImportRowResult.
+type ImportRowResult struct {
+	ChangedFields *[]string `json:"changed_fields,omitempty"`
+	Error         *string   `json:"error,omitempty"`
+
+	// Kind team - строка команды, member - строка участника
+	Kind   string                `json:"kind"`
+	Result ImportRowResultResult `json:"result"`
+
+	// Row Номер строки файла (для YAML - строка начала элемента)
+	Row      int     `json:"row"`
+	TeamName *string `json:"team_name,omitempty"`
+	UserId   *string `json:"user_id,omitempty"`
+}
+
+// ImportRowResultResult defines model for ImportRowResult.Result.
+type ImportRowResultResult string
+
+// ImportSummary defines model for ImportSummary.
+type ImportSummary struct {
+	Errors           int `json:"errors"`
+	MembershipsAdded int `json:"memberships_added"`
+	TeamsCreated     int `json:"teams_created"`
+	TeamsUpdated     int `json:"teams_updated"`
+	UsersCreated     int `json:"users_created"`
+	UsersUpdated     int `json:"users_updated"`
+}
+
+// Job Фоновая задача; состояние сохраняется в БД после каждой пачки, после перезапуска сервиса задача продолжается с необработанных элементов
+type Job struct {
+	// ChangesetId Набор изменений задачи для отката (кроме dry_run)
+	ChangesetId *string   `json:"changeset_id,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+
+	// Deactivated user_id уже деактивированных участников (при dry_run - тех, кто был бы деактивирован)
+	Deactivated []string `json:"deactivated"`
+
+	// DryRun Предпросмотр - каждая пачка выполняется и откатывается, сохраняются только исходы
+	DryRun bool `json:"dry_run"`
+
+	// Error Причина остановки задачи со статусом failed
+	Error      *string    `json:"error,omitempty"`
+	FinishedAt *time.Time `json:"finished_at,omitempty"`
+	Id         string     `json:"id"`
+
+	// KeptActive user_id участников, для которых команда не основная
+	KeptActive  []string `json:"kept_active"`
+	Kind        JobKind  `json:"kind"`
+	NewTeamName string   `json:"new_team_name"`
+	OldTeamName string   `json:"old_team_name"`
+
+	// Outcomes Исход по каждому открытому PR и уходящему ревьюверу, включая ещё не обработанные
+	Outcomes  []JobOutcome `json:"outcomes"`
+	Progress  JobProgress  `json:"progress"`
+	Status    JobStatus    `json:"status"`
+	UpdatedAt time.Time    `json:"updated_at"`
+}
+
+// JobKind defines model for Job.Kind.
+type JobKind string
+
+// JobOutcome defines model for JobOutcome.
+type JobOutcome struct {
+	NewReviewerId *string `json:"new_reviewer_id,omitempty"`
+	OldReviewerId string  `json:"old_reviewer_id"`
+
+	// Outcome skipped - PR смержен или ревьювер снят с него до обработки
+	Outcome       JobOutcomeOutcome `json:"outcome"`
+	PullRequestId string            `json:"pull_request_id"`
+
+	// Repository Репозиторий PR, отсутствует у PR вне репозиториев
+	Repository *string `json:"repository,omitempty"`
+}
+
+// JobOutcomeOutcome skipped - PR смержен или ревьювер снят с него до обработки
+type JobOutcomeOutcome string
+
+// JobProgress defines model for JobProgress.
+type JobProgress struct {
+	Processed int `json:"processed"`
+
+	// Total Количество шагов задачи - деактиваций и переназначений
+	Total int `json:"total"`
+}
+
+// JobStatus defines model for JobStatus.
+type JobStatus string
+
+// Organization defines model for Organization.
+type Organization struct {
+	Name string `json:"name"`
+
+	// Slug Идентификатор организации для заголовка X-Organization и claim org токена
+	Slug string `json:"slug"`
+}
+
+// ProblemDetails Ошибка в формате RFC 7807, отдаётся вместо ErrorResponse, если клиент передал
+// `Accept: application/problem+json`. Код каталога передаётся в расширении `code`.
+type ProblemDetails struct {
+	// Code Код из каталога ErrorResponse
+	Code string `json:"code"`
+
+	// Detail То же, что message в ErrorResponse
+	Detail  *string       `json:"detail,omitempty"`
+	Details *[]FieldError `json:"details,omitempty"`
+
+	// Instance Путь запроса
+	Instance *string `json:"instance,omitempty"`
+	Status   int     `json:"status"`
+
+	// Title Текст HTTP-статуса
+	Title string `json:"title"`
+
+	// Type Всегда about:blank, тип ошибки различается по code
+	Type string `json:"type"`
+}
+
 // PullRequest defines model for PullRequest.
 type PullRequest struct {
 	// AssignedReviewers user_id назначенных ревьюверов (0..2)
-	AssignedReviewers []string          `json:"assigned_reviewers"`
-	AuthorId          string            `json:"author_id"`
-	CreatedAt         *time.Time        `json:"createdAt"`
-	MergedAt          *time.Time        `json:"mergedAt"`
-	PullRequestId     string            `json:"pull_request_id"`
-	PullRequestName   string            `json:"pull_request_name"`
-	Status            PullRequestStatus `json:"status"`
+	AssignedReviewers []string   `json:"assigned_reviewers"`
+	AuthorId          string     `json:"author_id"`
+	CreatedAt         *time.Time `json:"createdAt"`
+	MergedAt          *time.Time `json:"mergedAt"`
+	PullRequestId     string     `json:"pull_request_id"`
+	PullRequestName   string     `json:"pull_request_name"`
+
+	// Repository Репозиторий PR, отсутствует у PR вне репозиториев
+	Repository *string           `json:"repository,omitempty"`
+	Status     PullRequestStatus `json:"status"`
 }
 
 // PullRequestStatus defines model for PullRequest.Status.
 type PullRequestStatus string
 
+// PullRequestDetail defines model for PullRequestDetail.
+type PullRequestDetail struct {
+	AuthorId  string     `json:"author_id"`
+	CreatedAt *time.Time `json:"createdAt"`
+
+	// History Краткая история по журналу аудита: создание, назначения и переназначения ревьюверов, отправленные ревью,
+	// нарушения SLA и merge; полный журнал с инициаторами в /pullRequest/history
+	History         []PullRequestEvent `json:"history"`
+	MergedAt        *time.Time         `json:"mergedAt"`
+	PullRequestId   string             `json:"pull_request_id"`
+	PullRequestName string             `json:"pull_request_name"`
+
+	// Repository Репозиторий PR, отсутствует у PR вне репозиториев
+	Repository *string                 `json:"repository,omitempty"`
+	Reviewers  []ReviewerAssignment    `json:"reviewers"`
+	Status     PullRequestDetailStatus `json:"status"`
+}
+
+// PullRequestDetailStatus defines model for PullRequestDetail.Status.
+type PullRequestDetailStatus string
+
+// PullRequestEvent defines model for PullRequestEvent.
+type PullRequestEvent struct {
+	At    time.Time             `json:"at"`
+	Event PullRequestEventEvent `json:"event"`
+
+	// OldReviewerId Снятый ревьювер (для REVIEWER_REASSIGNED)
+	OldReviewerId *string `json:"old_reviewer_id,omitempty"`
+
+	// Reason Причина переназначения или нарушения SLA, значения как у AuditEntry.reason
+	Reason *string `json:"reason,omitempty"`
+
+	// UserId user_id участника события: автор PR, назначенный ревьювер, новый ревьювер при переназначении,
+	// ревьювер, отправивший ревью или нарушивший SLA
+	UserId *string `json:"user_id,omitempty"`
+}
+
+// PullRequestEventEvent defines model for PullRequestEvent.Event.
+type PullRequestEventEvent string
+
 // PullRequestShort defines model for PullRequestShort.
 type PullRequestShort struct {
-	AuthorId        string                 `json:"author_id"`
-	PullRequestId   string                 `json:"pull_request_id"`
-	PullRequestName string                 `json:"pull_request_name"`
-	Status          PullRequestShortStatus `json:"status"`
+	AuthorId        string     `json:"author_id"`
+	CreatedAt       *time.Time `json:"createdAt"`
+	PullRequestId   string     `json:"pull_request_id"`
+	PullRequestName string     `json:"pull_request_name"`
+
+	// Repository Репозиторий PR, отсутствует у PR вне репозиториев
+	Repository *string                `json:"repository,omitempty"`
+	Status     PullRequestShortStatus `json:"status"`
 }
 
 // PullRequestShortStatus defines model for PullRequestShort.Status.
 type PullRequestShortStatus string
 
+// PullRequestSort defines model for PullRequestSort.
+type PullRequestSort string
+
+// PullRequestStatusFilter defines model for PullRequestStatusFilter.
+type PullRequestStatusFilter string
+
+// Reassignment defines model for Reassignment.
+type Reassignment struct {
+	NewReviewerId string `json:"new_reviewer_id"`
+	OldReviewerId string `json:"old_reviewer_id"`
+	PullRequestId string `json:"pull_request_id"`
+
+	// Repository Репозиторий PR, отсутствует у PR вне репозиториев
+	Repository *string `json:"repository,omitempty"`
+}
+
+// ReassignmentTrendPoint defines model for ReassignmentTrendPoint.
+type ReassignmentTrendPoint struct {
+	PullRequestsCreated int     `json:"pull_requests_created"`
+	Reassignments       int     `json:"reassignments"`
+	ReassignmentsPerPr  float64 `json:"reassignments_per_pr"`
+
+	// WeekStart Понедельник недели в UTC (YYYY-MM-DD)
+	WeekStart string `json:"week_start"`
+}
+
+// Repository defines model for Repository.
+type Repository struct {
+	Name string `json:"name"`
+
+	// OwnerTeam Команда-владелец репозитория
+	OwnerTeam string `json:"owner_team"`
+
+	// ReviewerPolicy Переопределения политики назначения ревьюверов; не заданное поле берётся по умолчанию (author_team, 2 ревьювера)
+	ReviewerPolicy *ReviewerPolicy `json:"reviewer_policy,omitempty"`
+}
+
+// ReviewEvent Данные (data) события потока /events/stream; тип события совпадает с полем event SSE.
+// reviewer.assigned - reviewer_id назначен ревьювером (replaces - кого он заменил),
+// reviewer.reassigned_away - reviewer_id снят с ревью (replaced_by - замена),
+// pull_request.merged - PR автора или ревьюверов смёржен,
+// sla.reminder - reviewer_id не ответил на PR до deadline по SLA команды (политика remind).
+type ReviewEvent struct {
+	AssignedReviewers *[]string `json:"assigned_reviewers,omitempty"`
+	At                time.Time `json:"at"`
+	AuthorId          string    `json:"author_id"`
+
+	// Deadline Срок первого ответа по SLA (для sla.reminder)
+	Deadline *time.Time `json:"deadline,omitempty"`
+
+	// Id Идентификатор для Last-Event-ID
+	Id              string `json:"id"`
+	PullRequestId   string `json:"pull_request_id"`
+	PullRequestName string `json:"pull_request_name"`
+
+	// Reason Причина переназначения, как в журнале аудита
+	Reason     *string `json:"reason,omitempty"`
+	ReplacedBy *string `json:"replaced_by,omitempty"`
+	Replaces   *string `json:"replaces,omitempty"`
+	Repository *string `json:"repository,omitempty"`
+	ReviewerId *string `json:"reviewer_id,omitempty"`
+
+	// TeamName Команда, из которой назначаются ревьюверы PR
+	TeamName *string         `json:"team_name,omitempty"`
+	Type     ReviewEventType `json:"type"`
+}
+
+// ReviewEventType defines model for ReviewEventType.
+type ReviewEventType string
+
+// ReviewerAssignment defines model for ReviewerAssignment.
+type ReviewerAssignment struct {
+	AssignedAt time.Time `json:"assigned_at"`
+
+	// RespondedAt Момент первого ответа ревьювера
+	RespondedAt *time.Time `json:"responded_at"`
+	UserId      string     `json:"user_id"`
+	Username    string     `json:"username"`
+}
+
+// ReviewerPolicy Переопределения политики назначения ревьюверов; не заданное поле берётся по умолчанию (author_team, 2 ревьювера)
+type ReviewerPolicy struct {
+	// ReviewerSource author_team - основная команда автора PR, owner_team - команда-владелец репозитория
+	ReviewerSource *ReviewerSource `json:"reviewer_source,omitempty"`
+	ReviewersCount *int            `json:"reviewers_count,omitempty"`
+}
+
+// ReviewerSource author_team - основная команда автора PR, owner_team - команда-владелец репозитория
+type ReviewerSource string
+
+// SlaAction remind - отправить напоминание, reassign - переназначить ревьювера
+type SlaAction string
+
 // Team defines model for Team.
 type Team struct {
-	Members  []TeamMember `json:"members"`
-	TeamName string       `json:"team_name"`
+	Members []TeamMember `json:"members"`
+
+	// Sla SLA первого ответа ревьювера (только в ответах, задаётся через /team/setSla)
+	Sla      *TeamSla `json:"sla,omitempty"`
+	TeamName string   `json:"team_name"`
 }
 
 // TeamMember defines model for TeamMember.
 type TeamMember struct {
-	IsActive bool   `json:"is_active"`
-	UserId   string `json:"user_id"`
-	Username string `json:"username"`
+	IsActive bool `json:"is_active"`
+
+	// IsPrimary Команда является основной для пользователя (только в ответах)
+	IsPrimary *bool `json:"is_primary,omitempty"`
+
+	// Role Роль в команде, observer не назначается ревьювером
+	Role     *TeamMemberRole `json:"role,omitempty"`
+	UserId   string          `json:"user_id"`
+	Username string          `json:"username"`
+}
+
+// TeamMemberRole Роль в команде, observer не назначается ревьювером
+type TeamMemberRole string
+
+// TeamMemberUpdate defines model for TeamMemberUpdate.
+type TeamMemberUpdate struct {
+	ChangedFields []TeamMemberUpdateChangedFields `json:"changed_fields"`
+	UserId        string                          `json:"user_id"`
+}
+
+// TeamMemberUpdateChangedFields defines model for TeamMemberUpdate.ChangedFields.
+type TeamMemberUpdateChangedFields string
+
+// TeamMembersChange defines model for TeamMembersChange.
+type TeamMembersChange struct {
+	// NotReassigned pull_request_id открытых PR, для которых не нашлось замены ревьюверу
+	NotReassigned []string       `json:"not_reassigned"`
+	Reassignments []Reassignment `json:"reassignments"`
+	Team          Team           `json:"team"`
+}
+
+// TeamSla SLA первого ответа ревьювера (только в ответах, задаётся через /team/setSla)
+type TeamSla struct {
+	// Action remind - отправить напоминание, reassign - переназначить ревьювера
+	Action SlaAction `json:"action"`
+
+	// FirstReviewHours Срок первого ответа в часах, 0 - SLA отключен
+	FirstReviewHours int `json:"first_review_hours"`
+}
+
+// TeamSyncDiff defines model for TeamSyncDiff.
+type TeamSyncDiff struct {
+	// Attached user_id пользователей, добавленных в команду (включая созданных)
+	Attached []string `json:"attached"`
+
+	// Created user_id созданных пользователей
+	Created []string `json:"created"`
+
+	// Detached user_id пользователей, исключённых из команды (только при prune=true)
+	Detached      []string `json:"detached"`
+	NotReassigned []string `json:"not_reassigned"`
+
+	// Reassignments Переназначения открытых ревью исключённых пользователей
+	Reassignments []Reassignment     `json:"reassignments"`
+	TeamCreated   bool               `json:"team_created"`
+	Updated       []TeamMemberUpdate `json:"updated"`
+}
+
+// TeamSyncResult defines model for TeamSyncResult.
+type TeamSyncResult struct {
+	Diff TeamSyncDiff `json:"diff"`
+	Team Team         `json:"team"`
 }
 
 // User defines model for User.
@@ -92,80 +749,570 @@ type User struct {
 	Username string `json:"username"`
 }
 
+// UserActivityChange defines model for UserActivityChange.
+type UserActivityChange struct {
+	// ChangesetId Набор изменений для отката через /admin/changesets/{changeset_id}/revert (только при reassign_reviews)
+	ChangesetId *string `json:"changeset_id,omitempty"`
+
+	// NotReassigned Открытые PR, для которых не нашлось замены; пользователь остаётся в них ревьювером
+	NotReassigned []string `json:"not_reassigned"`
+
+	// Reassignments Переназначенные ревью (только при reassign_reviews)
+	Reassignments []Reassignment `json:"reassignments"`
+	User          User           `json:"user"`
+}
+
+// AuthorIdFilterQuery defines model for AuthorIdFilterQuery.
+type AuthorIdFilterQuery = string
+
+// ChangesetIdPath defines model for ChangesetIdPath.
+type ChangesetIdPath = string
+
+// CreatedFromQuery defines model for CreatedFromQuery.
+type CreatedFromQuery = time.Time
+
+// CreatedToQuery defines model for CreatedToQuery.
+type CreatedToQuery = time.Time
+
+// CursorQuery defines model for CursorQuery.
+type CursorQuery = string
+
+// ExportFormatQuery defines model for ExportFormatQuery.
+type ExportFormatQuery = string
+
+// FromQuery defines model for FromQuery.
+type FromQuery = time.Time
+
+// LimitQuery defines model for LimitQuery.
+type LimitQuery = int
+
+// PullRequestIdQuery defines model for PullRequestIdQuery.
+type PullRequestIdQuery = string
+
+// RepositoryQuery defines model for RepositoryQuery.
+type RepositoryQuery = string
+
+// SortQuery defines model for SortQuery.
+type SortQuery = PullRequestSort
+
+// StatusFilterQuery defines model for StatusFilterQuery.
+type StatusFilterQuery = PullRequestStatusFilter
+
+// TeamNameFilterQuery defines model for TeamNameFilterQuery.
+type TeamNameFilterQuery = string
+
 // TeamNameQuery defines model for TeamNameQuery.
 type TeamNameQuery = string
 
+// ToQuery defines model for ToQuery.
+type ToQuery = time.Time
+
 // UserIdQuery defines model for UserIdQuery.
 type UserIdQuery = string
 
-// PostPullRequestCreateJSONBody defines parameters for PostPullRequestCreate.
-type PostPullRequestCreateJSONBody struct {
-	AuthorId        string `json:"author_id"`
-	PullRequestId   string `json:"pull_request_id"`
-	PullRequestName string `json:"pull_request_name"`
+// GetAdminAuditParams defines parameters for GetAdminAudit.
+type GetAdminAuditParams struct {
+	Action        *AuditAction `form:"action,omitempty" json:"action,omitempty"`
+	Actor         *string      `form:"actor,omitempty" json:"actor,omitempty"`
+	PullRequestId *string      `form:"pull_request_id,omitempty" json:"pull_request_id,omitempty"`
+
+	// Repository Репозиторий PR, учитывается вместе с pull_request_id
+	Repository *string `form:"repository,omitempty" json:"repository,omitempty"`
+	UserId     *string `form:"user_id,omitempty" json:"user_id,omitempty"`
+	TeamName   *string `form:"team_name,omitempty" json:"team_name,omitempty"`
+
+	// From Начало диапазона (включительно)
+	From *FromQuery `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Конец диапазона (не включительно)
+	To *ToQuery `form:"to,omitempty" json:"to,omitempty"`
+
+	// Limit Размер страницы
+	Limit *LimitQuery `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor Курсор следующей страницы из поля next_cursor предыдущего ответа
+	Cursor *CursorQuery `form:"cursor,omitempty" json:"cursor,omitempty"`
 }
 
-// PostPullRequestMergeJSONBody defines parameters for PostPullRequestMerge.
-type PostPullRequestMergeJSONBody struct {
-	PullRequestId string `json:"pull_request_id"`
+// GetAdminExportAssignmentsParams defines parameters for GetAdminExportAssignments.
+type GetAdminExportAssignmentsParams struct {
+	// Format Формат выгрузки csv или ndjson, имеет приоритет над заголовком Accept; по умолчанию csv, неизвестный формат - 406
+	Format *ExportFormatQuery `form:"format,omitempty" json:"format,omitempty"`
+
+	// Status Фильтр по статусу PR
+	Status *StatusFilterQuery `form:"status,omitempty" json:"status,omitempty"`
+
+	// AuthorId Фильтр по автору PR
+	AuthorId *AuthorIdFilterQuery `form:"author_id,omitempty" json:"author_id,omitempty"`
+
+	// ReviewerId Фильтр по ревьюверу
+	ReviewerId *string `form:"reviewer_id,omitempty" json:"reviewer_id,omitempty"`
+
+	// TeamName Фильтр по команде автора PR
+	TeamName *TeamNameFilterQuery `form:"team_name,omitempty" json:"team_name,omitempty"`
+
+	// From Начало диапазона (включительно)
+	From *FromQuery `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Конец диапазона (не включительно)
+	To *ToQuery `form:"to,omitempty" json:"to,omitempty"`
 }
 
-// PostPullRequestReassignJSONBody defines parameters for PostPullRequestReassign.
-type PostPullRequestReassignJSONBody struct {
-	OldUserId     string `json:"old_user_id"`
-	PullRequestId string `json:"pull_request_id"`
+// GetAdminExportAuditParams defines parameters for GetAdminExportAudit.
+type GetAdminExportAuditParams struct {
+	// Format Формат выгрузки csv или ndjson, имеет приоритет над заголовком Accept; по умолчанию csv, неизвестный формат - 406
+	Format        *ExportFormatQuery `form:"format,omitempty" json:"format,omitempty"`
+	Action        *AuditAction       `form:"action,omitempty" json:"action,omitempty"`
+	Actor         *string            `form:"actor,omitempty" json:"actor,omitempty"`
+	PullRequestId *string            `form:"pull_request_id,omitempty" json:"pull_request_id,omitempty"`
+
+	// Repository Репозиторий PR, учитывается вместе с pull_request_id
+	Repository *string `form:"repository,omitempty" json:"repository,omitempty"`
+	UserId     *string `form:"user_id,omitempty" json:"user_id,omitempty"`
+	TeamName   *string `form:"team_name,omitempty" json:"team_name,omitempty"`
+
+	// From Начало диапазона (включительно)
+	From *FromQuery `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Конец диапазона (не включительно)
+	To *ToQuery `form:"to,omitempty" json:"to,omitempty"`
 }
 
-// GetTeamGetParams defines parameters for GetTeamGet.
-type GetTeamGetParams struct {
-	// TeamName Уникальное имя команды
-	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+// GetAdminExportPullRequestsParams defines parameters for GetAdminExportPullRequests.
+type GetAdminExportPullRequestsParams struct {
+	// Format Формат выгрузки csv или ndjson, имеет приоритет над заголовком Accept; по умолчанию csv, неизвестный формат - 406
+	Format *ExportFormatQuery `form:"format,omitempty" json:"format,omitempty"`
+
+	// Status Фильтр по статусу PR
+	Status *StatusFilterQuery `form:"status,omitempty" json:"status,omitempty"`
+
+	// AuthorId Фильтр по автору PR
+	AuthorId *AuthorIdFilterQuery `form:"author_id,omitempty" json:"author_id,omitempty"`
+
+	// TeamName Фильтр по команде автора PR
+	TeamName *TeamNameFilterQuery `form:"team_name,omitempty" json:"team_name,omitempty"`
+
+	// From Начало диапазона (включительно)
+	From *FromQuery `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Конец диапазона (не включительно)
+	To *ToQuery `form:"to,omitempty" json:"to,omitempty"`
 }
 
-// GetUsersGetReviewParams defines parameters for GetUsersGetReview.
-type GetUsersGetReviewParams struct {
-	// UserId Идентификатор пользователя
-	UserId UserIdQuery `form:"user_id" json:"user_id"`
+// GetAdminExportStatsParams defines parameters for GetAdminExportStats.
+type GetAdminExportStatsParams struct {
+	// Format Формат выгрузки csv или ndjson, имеет приоритет над заголовком Accept; по умолчанию csv, неизвестный формат - 406
+	Format *ExportFormatQuery `form:"format,omitempty" json:"format,omitempty"`
+
+	// From Начало диапазона (включительно)
+	From *FromQuery `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Конец диапазона (не включительно)
+	To *ToQuery `form:"to,omitempty" json:"to,omitempty"`
 }
 
-// PostUsersSetIsActiveJSONBody defines parameters for PostUsersSetIsActive.
-type PostUsersSetIsActiveJSONBody struct {
-	IsActive bool   `json:"is_active"`
-	UserId   string `json:"user_id"`
+// PostAdminImportParams defines parameters for PostAdminImport.
+type PostAdminImportParams struct {
+	// Format Формат файла, по умолчанию по Content-Type (text/csv - csv, иначе yaml)
+	Format *PostAdminImportParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+
+	// DryRun Только показать изменения, ничего не сохраняя
+	DryRun *bool `form:"dry_run,omitempty" json:"dry_run,omitempty"`
 }
 
-// PostPullRequestCreateJSONRequestBody defines body for PostPullRequestCreate for application/json ContentType.
-type PostPullRequestCreateJSONRequestBody PostPullRequestCreateJSONBody
+// PostAdminImportParamsFormat defines parameters for PostAdminImport.
+type PostAdminImportParamsFormat string
 
-// PostPullRequestMergeJSONRequestBody defines body for PostPullRequestMerge for application/json ContentType.
-type PostPullRequestMergeJSONRequestBody PostPullRequestMergeJSONBody
+// GetAdminStatsParams defines parameters for GetAdminStats.
+type GetAdminStatsParams struct {
+	// From Начало диапазона (включительно)
+	From *FromQuery `form:"from,omitempty" json:"from,omitempty"`
 
-// PostPullRequestReassignJSONRequestBody defines body for PostPullRequestReassign for application/json ContentType.
-type PostPullRequestReassignJSONRequestBody PostPullRequestReassignJSONBody
+	// To Конец диапазона (не включительно)
+	To *ToQuery `form:"to,omitempty" json:"to,omitempty"`
+}
 
-// PostTeamAddJSONRequestBody defines body for PostTeamAdd for application/json ContentType.
-type PostTeamAddJSONRequestBody = Team
+// PostAdminTeamDeactivateJSONBody defines parameters for PostAdminTeamDeactivate.
+type PostAdminTeamDeactivateJSONBody struct {
+	// DryRun Предпросмотр без сохранения изменений
+	DryRun      *bool  `json:"dry_run,omitempty"`
+	NewTeamName string `json:"new_team_name"`
+	OldTeamName string `json:"old_team_name"`
+}
+
+// GetEventsStreamParams defines parameters for GetEventsStream.
+type GetEventsStreamParams struct {
+	UserId      *string `form:"user_id,omitempty" json:"user_id,omitempty"`
+	TeamName    *string `form:"team_name,omitempty" json:"team_name,omitempty"`
+	LastEventID *string `json:"Last-Event-ID,omitempty"`
+}
+
+// PostPullRequestCreateJSONBody defines parameters for PostPullRequestCreate.
+type PostPullRequestCreateJSONBody struct {
+	AuthorId        string `json:"author_id"`
+	PullRequestId   string `json:"pull_request_id"`
+	PullRequestName string `json:"pull_request_name"`
+
+	// Repository Репозиторий PR; pull_request_id уникален в пределах репозитория
+	Repository *string `json:"repository,omitempty"`
+}
+
+// GetPullRequestGetParams defines parameters for GetPullRequestGet.
+type GetPullRequestGetParams struct {
+	// PullRequestId Идентификатор PR
+	PullRequestId PullRequestIdQuery `form:"pull_request_id" json:"pull_request_id"`
+
+	// Repository Репозиторий PR; можно не указывать, если pull_request_id однозначен
+	Repository *RepositoryQuery `form:"repository,omitempty" json:"repository,omitempty"`
+}
+
+// GetPullRequestHistoryParams defines parameters for GetPullRequestHistory.
+type GetPullRequestHistoryParams struct {
+	// PullRequestId Идентификатор PR
+	PullRequestId PullRequestIdQuery `form:"pull_request_id" json:"pull_request_id"`
+
+	// Repository Репозиторий PR; можно не указывать, если pull_request_id однозначен
+	Repository *RepositoryQuery `form:"repository,omitempty" json:"repository,omitempty"`
+
+	// Limit Размер страницы
+	Limit *LimitQuery `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor Курсор следующей страницы из поля next_cursor предыдущего ответа
+	Cursor *CursorQuery `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// GetPullRequestListParams defines parameters for GetPullRequestList.
+type GetPullRequestListParams struct {
+	// Limit Размер страницы
+	Limit *LimitQuery `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor Курсор следующей страницы из поля next_cursor предыдущего ответа
+	Cursor *CursorQuery `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Status Фильтр по статусу PR
+	Status *StatusFilterQuery `form:"status,omitempty" json:"status,omitempty"`
+
+	// AuthorId Фильтр по автору PR
+	AuthorId *AuthorIdFilterQuery `form:"author_id,omitempty" json:"author_id,omitempty"`
+
+	// TeamName Фильтр по команде автора PR
+	TeamName *TeamNameFilterQuery `form:"team_name,omitempty" json:"team_name,omitempty"`
+
+	// CreatedFrom PR созданы не раньше указанного момента
+	CreatedFrom *CreatedFromQuery `form:"created_from,omitempty" json:"created_from,omitempty"`
+
+	// CreatedTo PR созданы раньше указанного момента
+	CreatedTo *CreatedToQuery `form:"created_to,omitempty" json:"created_to,omitempty"`
+
+	// Sort Порядок сортировки
+	Sort *SortQuery `form:"sort,omitempty" json:"sort,omitempty"`
+}
+
+// PostPullRequestMergeJSONBody defines parameters for PostPullRequestMerge.
+type PostPullRequestMergeJSONBody struct {
+	PullRequestId string `json:"pull_request_id"`
+
+	// Repository Репозиторий PR; можно не указывать, если pull_request_id однозначен
+	Repository *string `json:"repository,omitempty"`
+}
+
+// PostPullRequestReassignJSONBody defines parameters for PostPullRequestReassign.
+type PostPullRequestReassignJSONBody struct {
+	OldUserId     string `json:"old_user_id"`
+	PullRequestId string `json:"pull_request_id"`
+
+	// Repository Репозиторий PR; можно не указывать, если pull_request_id однозначен
+	Repository *string `json:"repository,omitempty"`
+}
+
+// PostPullRequestReviewJSONBody defines parameters for PostPullRequestReview.
+type PostPullRequestReviewJSONBody struct {
+	PullRequestId string `json:"pull_request_id"`
+
+	// Repository Репозиторий PR; можно не указывать, если pull_request_id однозначен
+	Repository *string `json:"repository,omitempty"`
+	UserId     string  `json:"user_id"`
+}
+
+// GetPullRequestSearchParams defines parameters for GetPullRequestSearch.
+type GetPullRequestSearchParams struct {
+	// Q Поисковый запрос по названию PR
+	Q string `form:"q" json:"q"`
+
+	// TeamName Фильтр по команде автора PR
+	TeamName *TeamNameFilterQuery `form:"team_name,omitempty" json:"team_name,omitempty"`
+
+	// Status Фильтр по статусу PR
+	Status *StatusFilterQuery `form:"status,omitempty" json:"status,omitempty"`
+
+	// Limit Размер страницы
+	Limit *LimitQuery `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetRepositoryGetParams defines parameters for GetRepositoryGet.
+type GetRepositoryGetParams struct {
+	Name string `form:"name" json:"name"`
+}
+
+// PostRepositorySetPolicyJSONBody defines parameters for PostRepositorySetPolicy.
+type PostRepositorySetPolicyJSONBody struct {
+	Name string `json:"name"`
+
+	// ReviewerPolicy Переопределения политики назначения ревьюверов; не заданное поле берётся по умолчанию (author_team, 2 ревьювера)
+	ReviewerPolicy ReviewerPolicy `json:"reviewer_policy"`
+}
+
+// PostTeamAddParams defines parameters for PostTeamAdd.
+type PostTeamAddParams struct {
+	// DryRun Только показать изменения, ничего не сохраняя
+	DryRun *bool `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+
+	// Prune Исключить из команды участников, не перечисленных в запросе
+	Prune *bool `form:"prune,omitempty" json:"prune,omitempty"`
+}
+
+// PostTeamAddMembersJSONBody defines parameters for PostTeamAddMembers.
+type PostTeamAddMembersJSONBody struct {
+	// Role Роль в команде, observer не назначается ревьювером
+	Role     *TeamMemberRole `json:"role,omitempty"`
+	TeamName string          `json:"team_name"`
+	UserIds  []string        `json:"user_ids"`
+}
+
+// PostTeamDeleteJSONBody defines parameters for PostTeamDelete.
+type PostTeamDeleteJSONBody struct {
+	TeamName string `json:"team_name"`
+}
+
+// GetTeamGetParams defines parameters for GetTeamGet.
+type GetTeamGetParams struct {
+	// TeamName Уникальное имя команды
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+}
+
+// PostTeamMoveMemberJSONBody defines parameters for PostTeamMoveMember.
+type PostTeamMoveMemberJSONBody struct {
+	// FromTeamName Команда, из которой переводится пользователь; по умолчанию основная
+	FromTeamName *string `json:"from_team_name,omitempty"`
+	ToTeamName   string  `json:"to_team_name"`
+	UserId       string  `json:"user_id"`
+}
+
+// PostTeamRemoveMembersJSONBody defines parameters for PostTeamRemoveMembers.
+type PostTeamRemoveMembersJSONBody struct {
+	TeamName string   `json:"team_name"`
+	UserIds  []string `json:"user_ids"`
+}
+
+// PostTeamRenameJSONBody defines parameters for PostTeamRename.
+type PostTeamRenameJSONBody struct {
+	NewTeamName string `json:"new_team_name"`
+	TeamName    string `json:"team_name"`
+}
+
+// PostTeamSetSlaJSONBody defines parameters for PostTeamSetSla.
+type PostTeamSetSlaJSONBody struct {
+	// Action remind - отправить напоминание, reassign - переназначить ревьювера
+	Action           SlaAction `json:"action"`
+	FirstReviewHours int       `json:"first_review_hours"`
+	TeamName         string    `json:"team_name"`
+}
+
+// GetUsersGetReviewParams defines parameters for GetUsersGetReview.
+type GetUsersGetReviewParams struct {
+	// UserId Идентификатор пользователя
+	UserId UserIdQuery `form:"user_id" json:"user_id"`
+
+	// Limit Размер страницы
+	Limit *LimitQuery `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor Курсор следующей страницы из поля next_cursor предыдущего ответа
+	Cursor *CursorQuery `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Status Фильтр по статусу PR
+	Status *StatusFilterQuery `form:"status,omitempty" json:"status,omitempty"`
+
+	// AuthorId Фильтр по автору PR
+	AuthorId *AuthorIdFilterQuery `form:"author_id,omitempty" json:"author_id,omitempty"`
+
+	// TeamName Фильтр по команде автора PR
+	TeamName *TeamNameFilterQuery `form:"team_name,omitempty" json:"team_name,omitempty"`
+
+	// CreatedFrom PR созданы не раньше указанного момента
+	CreatedFrom *CreatedFromQuery `form:"created_from,omitempty" json:"created_from,omitempty"`
+
+	// CreatedTo PR созданы раньше указанного момента
+	CreatedTo *CreatedToQuery `form:"created_to,omitempty" json:"created_to,omitempty"`
+
+	// Sort Порядок сортировки
+	Sort *SortQuery `form:"sort,omitempty" json:"sort,omitempty"`
+}
+
+// PostUsersSetIsActiveJSONBody defines parameters for PostUsersSetIsActive.
+type PostUsersSetIsActiveJSONBody struct {
+	IsActive bool `json:"is_active"`
+
+	// ReassignReviews При деактивации переназначить открытые ревью пользователя по тем же правилам, что /pullRequest/reassign:
+	// замена из основной команды автора, если пользователь в ней состоит, иначе из основной команды пользователя
+	ReassignReviews *bool  `json:"reassign_reviews,omitempty"`
+	UserId          string `json:"user_id"`
+}
+
+// PostAdminOrganizationsJSONRequestBody defines body for PostAdminOrganizations for application/json ContentType.
+type PostAdminOrganizationsJSONRequestBody = Organization
+
+// PostAdminTeamDeactivateJSONRequestBody defines body for PostAdminTeamDeactivate for application/json ContentType.
+type PostAdminTeamDeactivateJSONRequestBody PostAdminTeamDeactivateJSONBody
+
+// PostGraphqlJSONRequestBody defines body for PostGraphql for application/json ContentType.
+type PostGraphqlJSONRequestBody = GraphQLRequest
+
+// PostPullRequestCreateJSONRequestBody defines body for PostPullRequestCreate for application/json ContentType.
+type PostPullRequestCreateJSONRequestBody PostPullRequestCreateJSONBody
+
+// PostPullRequestMergeJSONRequestBody defines body for PostPullRequestMerge for application/json ContentType.
+type PostPullRequestMergeJSONRequestBody PostPullRequestMergeJSONBody
+
+// PostPullRequestReassignJSONRequestBody defines body for PostPullRequestReassign for application/json ContentType.
+type PostPullRequestReassignJSONRequestBody PostPullRequestReassignJSONBody
+
+// PostPullRequestReviewJSONRequestBody defines body for PostPullRequestReview for application/json ContentType.
+type PostPullRequestReviewJSONRequestBody PostPullRequestReviewJSONBody
+
+// PostRepositoryAddJSONRequestBody defines body for PostRepositoryAdd for application/json ContentType.
+type PostRepositoryAddJSONRequestBody = Repository
+
+// PostRepositorySetPolicyJSONRequestBody defines body for PostRepositorySetPolicy for application/json ContentType.
+type PostRepositorySetPolicyJSONRequestBody PostRepositorySetPolicyJSONBody
+
+// PostTeamAddJSONRequestBody defines body for PostTeamAdd for application/json ContentType.
+type PostTeamAddJSONRequestBody = Team
+
+// PostTeamAddMembersJSONRequestBody defines body for PostTeamAddMembers for application/json ContentType.
+type PostTeamAddMembersJSONRequestBody PostTeamAddMembersJSONBody
+
+// PostTeamDeleteJSONRequestBody defines body for PostTeamDelete for application/json ContentType.
+type PostTeamDeleteJSONRequestBody PostTeamDeleteJSONBody
+
+// PostTeamMoveMemberJSONRequestBody defines body for PostTeamMoveMember for application/json ContentType.
+type PostTeamMoveMemberJSONRequestBody PostTeamMoveMemberJSONBody
+
+// PostTeamRemoveMembersJSONRequestBody defines body for PostTeamRemoveMembers for application/json ContentType.
+type PostTeamRemoveMembersJSONRequestBody PostTeamRemoveMembersJSONBody
+
+// PostTeamRenameJSONRequestBody defines body for PostTeamRename for application/json ContentType.
+type PostTeamRenameJSONRequestBody PostTeamRenameJSONBody
+
+// PostTeamSetSlaJSONRequestBody defines body for PostTeamSetSla for application/json ContentType.
+type PostTeamSetSlaJSONRequestBody PostTeamSetSlaJSONBody
 
 // PostUsersSetIsActiveJSONRequestBody defines body for PostUsersSetIsActive for application/json ContentType.
 type PostUsersSetIsActiveJSONRequestBody PostUsersSetIsActiveJSONBody
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
-	// Создать PR и автоматически назначить до 2 ревьюверов из команды автора
+	// Журнал аудита с фильтрами и курсорной пагинацией
+	// (GET /admin/audit)
+	GetAdminAudit(w http.ResponseWriter, r *http.Request, params GetAdminAuditParams)
+	// Набор изменений массовой операции
+	// (GET /admin/changesets/{changeset_id})
+	GetAdminChangesetsChangesetId(w http.ResponseWriter, r *http.Request, changesetId ChangesetIdPath)
+	// Откатить набор изменений
+	// (POST /admin/changesets/{changeset_id}/revert)
+	PostAdminChangesetsChangesetIdRevert(w http.ResponseWriter, r *http.Request, changesetId ChangesetIdPath)
+	// Выгрузка назначений ревьюверов в CSV или NDJSON
+	// (GET /admin/export/assignments)
+	GetAdminExportAssignments(w http.ResponseWriter, r *http.Request, params GetAdminExportAssignmentsParams)
+	// Выгрузка журнала аудита в CSV или NDJSON
+	// (GET /admin/export/audit)
+	GetAdminExportAudit(w http.ResponseWriter, r *http.Request, params GetAdminExportAuditParams)
+	// Выгрузка PR в CSV или NDJSON
+	// (GET /admin/export/pullRequests)
+	GetAdminExportPullRequests(w http.ResponseWriter, r *http.Request, params GetAdminExportPullRequestsParams)
+	// Выгрузка статистики в длинном формате metric, dimension, key, week_start, value
+	// (GET /admin/export/stats)
+	GetAdminExportStats(w http.ResponseWriter, r *http.Request, params GetAdminExportStatsParams)
+	// Массовый импорт команд и пользователей из YAML или CSV
+	// (POST /admin/import)
+	PostAdminImport(w http.ResponseWriter, r *http.Request, params PostAdminImportParams)
+	// Состояние фоновой задачи
+	// (GET /admin/jobs/{job_id})
+	GetAdminJobsJobId(w http.ResponseWriter, r *http.Request, jobId string)
+	// Список организаций
+	// (GET /admin/organizations)
+	GetAdminOrganizations(w http.ResponseWriter, r *http.Request)
+	// Создать организацию
+	// (POST /admin/organizations)
+	PostAdminOrganizations(w http.ResponseWriter, r *http.Request)
+	// Статистика назначений, нарушений SLA и метрики по времени организации запроса
+	// (GET /admin/stats)
+	GetAdminStats(w http.ResponseWriter, r *http.Request, params GetAdminStatsParams)
+	// Запустить фоновую задачу массовой деактивации участников команды с переназначением их открытых ревью на участников новой команды
+	// (POST /admin/team/deactivate)
+	PostAdminTeamDeactivate(w http.ResponseWriter, r *http.Request)
+	// Поток событий о назначениях пользователя или команды (Server-Sent Events)
+	// (GET /events/stream)
+	GetEventsStream(w http.ResponseWriter, r *http.Request, params GetEventsStreamParams)
+	// Запрос GraphQL к командам, пользователям, PR и статистике назначений
+	// (POST /graphql)
+	PostGraphql(w http.ResponseWriter, r *http.Request)
+	// Создать PR и назначить ревьюверов по политике репозитория (по умолчанию до 2 из команды автора)
 	// (POST /pullRequest/create)
 	PostPullRequestCreate(w http.ResponseWriter, r *http.Request)
+	// Получить PR с ревьюверами, временными метками и историей
+	// (GET /pullRequest/get)
+	GetPullRequestGet(w http.ResponseWriter, r *http.Request, params GetPullRequestGetParams)
+	// Журнал аудита PR (создание, назначения, переназначения, merge) в хронологическом порядке
+	// (GET /pullRequest/history)
+	GetPullRequestHistory(w http.ResponseWriter, r *http.Request, params GetPullRequestHistoryParams)
+	// Получить список PR с фильтрами и курсорной пагинацией
+	// (GET /pullRequest/list)
+	GetPullRequestList(w http.ResponseWriter, r *http.Request, params GetPullRequestListParams)
 	// Пометить PR как MERGED (идемпотентная операция)
 	// (POST /pullRequest/merge)
 	PostPullRequestMerge(w http.ResponseWriter, r *http.Request)
 	// Переназначить конкретного ревьювера на другого из его команды
 	// (POST /pullRequest/reassign)
 	PostPullRequestReassign(w http.ResponseWriter, r *http.Request)
+	// Отметить ответ ревьювера по PR (останавливает отсчёт SLA для назначения)
+	// (POST /pullRequest/review)
+	PostPullRequestReview(w http.ResponseWriter, r *http.Request)
+	// Полнотекстовый поиск PR по названию
+	// (GET /pullRequest/search)
+	GetPullRequestSearch(w http.ResponseWriter, r *http.Request, params GetPullRequestSearchParams)
+	// Создать репозиторий с командой-владельцем и политикой назначения ревьюверов
+	// (POST /repository/add)
+	PostRepositoryAdd(w http.ResponseWriter, r *http.Request)
+	// Получить репозиторий
+	// (GET /repository/get)
+	GetRepositoryGet(w http.ResponseWriter, r *http.Request, params GetRepositoryGetParams)
+	// Заменить переопределения политики назначения ревьюверов; не переданные поля возвращаются к значениям по умолчанию
+	// (POST /repository/setPolicy)
+	PostRepositorySetPolicy(w http.ResponseWriter, r *http.Request)
 	// Создать команду с участниками (создаёт/обновляет пользователей)
 	// (POST /team/add)
-	PostTeamAdd(w http.ResponseWriter, r *http.Request)
+	PostTeamAdd(w http.ResponseWriter, r *http.Request, params PostTeamAddParams)
+	// Добавить существующих пользователей в команду
+	// (POST /team/addMembers)
+	PostTeamAddMembers(w http.ResponseWriter, r *http.Request)
+	// Удалить пустую команду
+	// (POST /team/delete)
+	PostTeamDelete(w http.ResponseWriter, r *http.Request)
 	// Получить команду с участниками
 	// (GET /team/get)
 	GetTeamGet(w http.ResponseWriter, r *http.Request, params GetTeamGetParams)
+	// Перевести пользователя в другую команду с переназначением его открытых ревью внутри старой команды
+	// (POST /team/moveMember)
+	PostTeamMoveMember(w http.ResponseWriter, r *http.Request)
+	// Исключить пользователей из команды с переназначением их открытых ревью внутри команды
+	// (POST /team/removeMembers)
+	PostTeamRemoveMembers(w http.ResponseWriter, r *http.Request)
+	// Переименовать команду
+	// (POST /team/rename)
+	PostTeamRename(w http.ResponseWriter, r *http.Request)
+	// Задать SLA первого ответа ревьювера для PR авторов команды
+	// (POST /team/setSla)
+	PostTeamSetSla(w http.ResponseWriter, r *http.Request)
 	// Получить PR'ы, где пользователь назначен ревьювером
 	// (GET /users/getReview)
 	GetUsersGetReview(w http.ResponseWriter, r *http.Request, params GetUsersGetReviewParams)
@@ -174,66 +1321,1217 @@ type ServerInterface interface {
 	PostUsersSetIsActive(w http.ResponseWriter, r *http.Request)
 }
 
-// Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
+// Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
+
+type Unimplemented struct{}
+
+// Журнал аудита с фильтрами и курсорной пагинацией
+// (GET /admin/audit)
+func (_ Unimplemented) GetAdminAudit(w http.ResponseWriter, r *http.Request, params GetAdminAuditParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Набор изменений массовой операции
+// (GET /admin/changesets/{changeset_id})
+func (_ Unimplemented) GetAdminChangesetsChangesetId(w http.ResponseWriter, r *http.Request, changesetId ChangesetIdPath) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Откатить набор изменений
+// (POST /admin/changesets/{changeset_id}/revert)
+func (_ Unimplemented) PostAdminChangesetsChangesetIdRevert(w http.ResponseWriter, r *http.Request, changesetId ChangesetIdPath) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Выгрузка назначений ревьюверов в CSV или NDJSON
+// (GET /admin/export/assignments)
+func (_ Unimplemented) GetAdminExportAssignments(w http.ResponseWriter, r *http.Request, params GetAdminExportAssignmentsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Выгрузка журнала аудита в CSV или NDJSON
+// (GET /admin/export/audit)
+func (_ Unimplemented) GetAdminExportAudit(w http.ResponseWriter, r *http.Request, params GetAdminExportAuditParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Выгрузка PR в CSV или NDJSON
+// (GET /admin/export/pullRequests)
+func (_ Unimplemented) GetAdminExportPullRequests(w http.ResponseWriter, r *http.Request, params GetAdminExportPullRequestsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Выгрузка статистики в длинном формате metric, dimension, key, week_start, value
+// (GET /admin/export/stats)
+func (_ Unimplemented) GetAdminExportStats(w http.ResponseWriter, r *http.Request, params GetAdminExportStatsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Массовый импорт команд и пользователей из YAML или CSV
+// (POST /admin/import)
+func (_ Unimplemented) PostAdminImport(w http.ResponseWriter, r *http.Request, params PostAdminImportParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Состояние фоновой задачи
+// (GET /admin/jobs/{job_id})
+func (_ Unimplemented) GetAdminJobsJobId(w http.ResponseWriter, r *http.Request, jobId string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Список организаций
+// (GET /admin/organizations)
+func (_ Unimplemented) GetAdminOrganizations(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Создать организацию
+// (POST /admin/organizations)
+func (_ Unimplemented) PostAdminOrganizations(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Статистика назначений, нарушений SLA и метрики по времени организации запроса
+// (GET /admin/stats)
+func (_ Unimplemented) GetAdminStats(w http.ResponseWriter, r *http.Request, params GetAdminStatsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Запустить фоновую задачу массовой деактивации участников команды с переназначением их открытых ревью на участников новой команды
+// (POST /admin/team/deactivate)
+func (_ Unimplemented) PostAdminTeamDeactivate(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Поток событий о назначениях пользователя или команды (Server-Sent Events)
+// (GET /events/stream)
+func (_ Unimplemented) GetEventsStream(w http.ResponseWriter, r *http.Request, params GetEventsStreamParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Запрос GraphQL к командам, пользователям, PR и статистике назначений
+// (POST /graphql)
+func (_ Unimplemented) PostGraphql(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Создать PR и назначить ревьюверов по политике репозитория (по умолчанию до 2 из команды автора)
+// (POST /pullRequest/create)
+func (_ Unimplemented) PostPullRequestCreate(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить PR с ревьюверами, временными метками и историей
+// (GET /pullRequest/get)
+func (_ Unimplemented) GetPullRequestGet(w http.ResponseWriter, r *http.Request, params GetPullRequestGetParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Журнал аудита PR (создание, назначения, переназначения, merge) в хронологическом порядке
+// (GET /pullRequest/history)
+func (_ Unimplemented) GetPullRequestHistory(w http.ResponseWriter, r *http.Request, params GetPullRequestHistoryParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить список PR с фильтрами и курсорной пагинацией
+// (GET /pullRequest/list)
+func (_ Unimplemented) GetPullRequestList(w http.ResponseWriter, r *http.Request, params GetPullRequestListParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Пометить PR как MERGED (идемпотентная операция)
+// (POST /pullRequest/merge)
+func (_ Unimplemented) PostPullRequestMerge(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Переназначить конкретного ревьювера на другого из его команды
+// (POST /pullRequest/reassign)
+func (_ Unimplemented) PostPullRequestReassign(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Отметить ответ ревьювера по PR (останавливает отсчёт SLA для назначения)
+// (POST /pullRequest/review)
+func (_ Unimplemented) PostPullRequestReview(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Полнотекстовый поиск PR по названию
+// (GET /pullRequest/search)
+func (_ Unimplemented) GetPullRequestSearch(w http.ResponseWriter, r *http.Request, params GetPullRequestSearchParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Создать репозиторий с командой-владельцем и политикой назначения ревьюверов
+// (POST /repository/add)
+func (_ Unimplemented) PostRepositoryAdd(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить репозиторий
+// (GET /repository/get)
+func (_ Unimplemented) GetRepositoryGet(w http.ResponseWriter, r *http.Request, params GetRepositoryGetParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Заменить переопределения политики назначения ревьюверов; не переданные поля возвращаются к значениям по умолчанию
+// (POST /repository/setPolicy)
+func (_ Unimplemented) PostRepositorySetPolicy(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Создать команду с участниками (создаёт/обновляет пользователей)
+// (POST /team/add)
+func (_ Unimplemented) PostTeamAdd(w http.ResponseWriter, r *http.Request, params PostTeamAddParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Добавить существующих пользователей в команду
+// (POST /team/addMembers)
+func (_ Unimplemented) PostTeamAddMembers(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Удалить пустую команду
+// (POST /team/delete)
+func (_ Unimplemented) PostTeamDelete(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить команду с участниками
+// (GET /team/get)
+func (_ Unimplemented) GetTeamGet(w http.ResponseWriter, r *http.Request, params GetTeamGetParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Перевести пользователя в другую команду с переназначением его открытых ревью внутри старой команды
+// (POST /team/moveMember)
+func (_ Unimplemented) PostTeamMoveMember(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Исключить пользователей из команды с переназначением их открытых ревью внутри команды
+// (POST /team/removeMembers)
+func (_ Unimplemented) PostTeamRemoveMembers(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Переименовать команду
+// (POST /team/rename)
+func (_ Unimplemented) PostTeamRename(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Задать SLA первого ответа ревьювера для PR авторов команды
+// (POST /team/setSla)
+func (_ Unimplemented) PostTeamSetSla(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить PR'ы, где пользователь назначен ревьювером
+// (GET /users/getReview)
+func (_ Unimplemented) GetUsersGetReview(w http.ResponseWriter, r *http.Request, params GetUsersGetReviewParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Установить флаг активности пользователя
+// (POST /users/setIsActive)
+func (_ Unimplemented) PostUsersSetIsActive(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// GetAdminAudit operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminAudit(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAdminAuditParams
+
+	// ------------- Optional query parameter "action" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "action", r.URL.Query(), &params.Action)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "action", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "actor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "actor", r.URL.Query(), &params.Actor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "actor", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "pull_request_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pull_request_id", r.URL.Query(), &params.PullRequestId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pull_request_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "repository" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "repository", r.URL.Query(), &params.Repository)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "repository", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "user_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "user_id", r.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAdminAudit(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAdminChangesetsChangesetId operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminChangesetsChangesetId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "changeset_id" -------------
+	var changesetId ChangesetIdPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "changeset_id", chi.URLParam(r, "changeset_id"), &changesetId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "changeset_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAdminChangesetsChangesetId(w, r, changesetId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostAdminChangesetsChangesetIdRevert operation middleware
+func (siw *ServerInterfaceWrapper) PostAdminChangesetsChangesetIdRevert(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "changeset_id" -------------
+	var changesetId ChangesetIdPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "changeset_id", chi.URLParam(r, "changeset_id"), &changesetId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "changeset_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAdminChangesetsChangesetIdRevert(w, r, changesetId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAdminExportAssignments operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminExportAssignments(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAdminExportAssignmentsParams
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "author_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "author_id", r.URL.Query(), &params.AuthorId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "author_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "reviewer_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "reviewer_id", r.URL.Query(), &params.ReviewerId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "reviewer_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAdminExportAssignments(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAdminExportAudit operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminExportAudit(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAdminExportAuditParams
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "action" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "action", r.URL.Query(), &params.Action)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "action", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "actor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "actor", r.URL.Query(), &params.Actor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "actor", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "pull_request_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pull_request_id", r.URL.Query(), &params.PullRequestId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pull_request_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "repository" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "repository", r.URL.Query(), &params.Repository)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "repository", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "user_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "user_id", r.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAdminExportAudit(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAdminExportPullRequests operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminExportPullRequests(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAdminExportPullRequestsParams
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "author_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "author_id", r.URL.Query(), &params.AuthorId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "author_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAdminExportPullRequests(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAdminExportStats operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminExportStats(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAdminExportStatsParams
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAdminExportStats(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostAdminImport operation middleware
+func (siw *ServerInterfaceWrapper) PostAdminImport(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostAdminImportParams
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "dry_run" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dry_run", r.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dry_run", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAdminImport(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAdminJobsJobId operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminJobsJobId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "job_id" -------------
+	var jobId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "job_id", chi.URLParam(r, "job_id"), &jobId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "job_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAdminJobsJobId(w, r, jobId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAdminOrganizations operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminOrganizations(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAdminOrganizations(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostAdminOrganizations operation middleware
+func (siw *ServerInterfaceWrapper) PostAdminOrganizations(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAdminOrganizations(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAdminStats operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminStats(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAdminStatsParams
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAdminStats(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostAdminTeamDeactivate operation middleware
+func (siw *ServerInterfaceWrapper) PostAdminTeamDeactivate(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAdminTeamDeactivate(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetEventsStream operation middleware
+func (siw *ServerInterfaceWrapper) GetEventsStream(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetEventsStreamParams
+
+	// ------------- Optional query parameter "user_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "user_id", r.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Last-Event-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Last-Event-ID")]; found {
+		var LastEventID string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Last-Event-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Last-Event-ID", valueList[0], &LastEventID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Last-Event-ID", Err: err})
+			return
+		}
+
+		params.LastEventID = &LastEventID
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetEventsStream(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostGraphql operation middleware
+func (siw *ServerInterfaceWrapper) PostGraphql(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostGraphql(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostPullRequestCreate operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestCreate(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPullRequestCreate(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetPullRequestGet operation middleware
+func (siw *ServerInterfaceWrapper) GetPullRequestGet(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPullRequestGetParams
+
+	// ------------- Required query parameter "pull_request_id" -------------
+
+	if paramValue := r.URL.Query().Get("pull_request_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "pull_request_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "pull_request_id", r.URL.Query(), &params.PullRequestId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pull_request_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "repository" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "repository", r.URL.Query(), &params.Repository)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "repository", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPullRequestGet(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetPullRequestHistory operation middleware
+func (siw *ServerInterfaceWrapper) GetPullRequestHistory(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPullRequestHistoryParams
+
+	// ------------- Required query parameter "pull_request_id" -------------
+
+	if paramValue := r.URL.Query().Get("pull_request_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "pull_request_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "pull_request_id", r.URL.Query(), &params.PullRequestId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pull_request_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "repository" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "repository", r.URL.Query(), &params.Repository)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "repository", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPullRequestHistory(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetPullRequestList operation middleware
+func (siw *ServerInterfaceWrapper) GetPullRequestList(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPullRequestListParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "author_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "author_id", r.URL.Query(), &params.AuthorId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "author_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "created_from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "created_from", r.URL.Query(), &params.CreatedFrom)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "created_from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "created_to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "created_to", r.URL.Query(), &params.CreatedTo)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "created_to", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPullRequestList(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostPullRequestMerge operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestMerge(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPullRequestMerge(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostPullRequestReassign operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestReassign(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPullRequestReassign(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostPullRequestReview operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestReview(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPullRequestReview(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetPullRequestSearch operation middleware
+func (siw *ServerInterfaceWrapper) GetPullRequestSearch(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPullRequestSearchParams
+
+	// ------------- Required query parameter "q" -------------
+
+	if paramValue := r.URL.Query().Get("q"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "q"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "q", r.URL.Query(), &params.Q)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "q", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPullRequestSearch(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostRepositoryAdd operation middleware
+func (siw *ServerInterfaceWrapper) PostRepositoryAdd(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostRepositoryAdd(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetRepositoryGet operation middleware
+func (siw *ServerInterfaceWrapper) GetRepositoryGet(w http.ResponseWriter, r *http.Request) {
 
-type Unimplemented struct{}
+	var err error
 
-// Создать PR и автоматически назначить до 2 ревьюверов из команды автора
-// (POST /pullRequest/create)
-func (_ Unimplemented) PostPullRequestCreate(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetRepositoryGetParams
 
-// Пометить PR как MERGED (идемпотентная операция)
-// (POST /pullRequest/merge)
-func (_ Unimplemented) PostPullRequestMerge(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	// ------------- Required query parameter "name" -------------
 
-// Переназначить конкретного ревьювера на другого из его команды
-// (POST /pullRequest/reassign)
-func (_ Unimplemented) PostPullRequestReassign(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	if paramValue := r.URL.Query().Get("name"); paramValue != "" {
 
-// Создать команду с участниками (создаёт/обновляет пользователей)
-// (POST /team/add)
-func (_ Unimplemented) PostTeamAdd(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "name"})
+		return
+	}
 
-// Получить команду с участниками
-// (GET /team/get)
-func (_ Unimplemented) GetTeamGet(w http.ResponseWriter, r *http.Request, params GetTeamGetParams) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	err = runtime.BindQueryParameter("form", true, true, "name", r.URL.Query(), &params.Name)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
 
-// Получить PR'ы, где пользователь назначен ревьювером
-// (GET /users/getReview)
-func (_ Unimplemented) GetUsersGetReview(w http.ResponseWriter, r *http.Request, params GetUsersGetReviewParams) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetRepositoryGet(w, r, params)
+	}))
 
-// Установить флаг активности пользователя
-// (POST /users/setIsActive)
-func (_ Unimplemented) PostUsersSetIsActive(w http.ResponseWriter, r *http.Request) {
-	w.WriteHeader(http.StatusNotImplemented)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+	handler.ServeHTTP(w, r)
 }
 
-type MiddlewareFunc func(http.Handler) http.Handler
-
-// PostPullRequestCreate operation middleware
-func (siw *ServerInterfaceWrapper) PostPullRequestCreate(w http.ResponseWriter, r *http.Request) {
+// PostRepositorySetPolicy operation middleware
+func (siw *ServerInterfaceWrapper) PostRepositorySetPolicy(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostPullRequestCreate(w, r)
+		siw.Handler.PostRepositorySetPolicy(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -243,11 +2541,32 @@ func (siw *ServerInterfaceWrapper) PostPullRequestCreate(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
-// PostPullRequestMerge operation middleware
-func (siw *ServerInterfaceWrapper) PostPullRequestMerge(w http.ResponseWriter, r *http.Request) {
+// PostTeamAdd operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamAdd(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostTeamAddParams
+
+	// ------------- Optional query parameter "dry_run" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dry_run", r.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dry_run", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "prune" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "prune", r.URL.Query(), &params.Prune)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "prune", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostPullRequestMerge(w, r)
+		siw.Handler.PostTeamAdd(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -257,11 +2576,11 @@ func (siw *ServerInterfaceWrapper) PostPullRequestMerge(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// PostPullRequestReassign operation middleware
-func (siw *ServerInterfaceWrapper) PostPullRequestReassign(w http.ResponseWriter, r *http.Request) {
+// PostTeamAddMembers operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamAddMembers(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostPullRequestReassign(w, r)
+		siw.Handler.PostTeamAddMembers(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -271,11 +2590,11 @@ func (siw *ServerInterfaceWrapper) PostPullRequestReassign(w http.ResponseWriter
 	handler.ServeHTTP(w, r)
 }
 
-// PostTeamAdd operation middleware
-func (siw *ServerInterfaceWrapper) PostTeamAdd(w http.ResponseWriter, r *http.Request) {
+// PostTeamDelete operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamDelete(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostTeamAdd(w, r)
+		siw.Handler.PostTeamDelete(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -319,6 +2638,62 @@ func (siw *ServerInterfaceWrapper) GetTeamGet(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
+// PostTeamMoveMember operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamMoveMember(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTeamMoveMember(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostTeamRemoveMembers operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamRemoveMembers(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTeamRemoveMembers(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostTeamRename operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamRename(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTeamRename(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostTeamSetSla operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamSetSla(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTeamSetSla(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // GetUsersGetReview operation middleware
 func (siw *ServerInterfaceWrapper) GetUsersGetReview(w http.ResponseWriter, r *http.Request) {
 
@@ -342,6 +2717,70 @@ func (siw *ServerInterfaceWrapper) GetUsersGetReview(w http.ResponseWriter, r *h
 		return
 	}
 
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "author_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "author_id", r.URL.Query(), &params.AuthorId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "author_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "team_name", r.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "created_from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "created_from", r.URL.Query(), &params.CreatedFrom)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "created_from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "created_to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "created_to", r.URL.Query(), &params.CreatedTo)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "created_to", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.GetUsersGetReview(w, r, params)
 	}))
@@ -480,21 +2919,108 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 		ErrorHandlerFunc:   options.ErrorHandlerFunc,
 	}
 
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/audit", wrapper.GetAdminAudit)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/changesets/{changeset_id}", wrapper.GetAdminChangesetsChangesetId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/changesets/{changeset_id}/revert", wrapper.PostAdminChangesetsChangesetIdRevert)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/export/assignments", wrapper.GetAdminExportAssignments)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/export/audit", wrapper.GetAdminExportAudit)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/export/pullRequests", wrapper.GetAdminExportPullRequests)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/export/stats", wrapper.GetAdminExportStats)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/import", wrapper.PostAdminImport)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/jobs/{job_id}", wrapper.GetAdminJobsJobId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/organizations", wrapper.GetAdminOrganizations)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/organizations", wrapper.PostAdminOrganizations)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/stats", wrapper.GetAdminStats)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/team/deactivate", wrapper.PostAdminTeamDeactivate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/events/stream", wrapper.GetEventsStream)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/graphql", wrapper.PostGraphql)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/pullRequest/create", wrapper.PostPullRequestCreate)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/pullRequest/get", wrapper.GetPullRequestGet)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/pullRequest/history", wrapper.GetPullRequestHistory)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/pullRequest/list", wrapper.GetPullRequestList)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/pullRequest/merge", wrapper.PostPullRequestMerge)
 	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/pullRequest/reassign", wrapper.PostPullRequestReassign)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pullRequest/review", wrapper.PostPullRequestReview)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/pullRequest/search", wrapper.GetPullRequestSearch)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/repository/add", wrapper.PostRepositoryAdd)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/repository/get", wrapper.GetRepositoryGet)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/repository/setPolicy", wrapper.PostRepositorySetPolicy)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/team/add", wrapper.PostTeamAdd)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/addMembers", wrapper.PostTeamAddMembers)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/delete", wrapper.PostTeamDelete)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/team/get", wrapper.GetTeamGet)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/moveMember", wrapper.PostTeamMoveMember)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/removeMembers", wrapper.PostTeamRemoveMembers)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/rename", wrapper.PostTeamRename)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/setSla", wrapper.PostTeamSetSla)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/users/getReview", wrapper.GetUsersGetReview)
 	})
@@ -504,3 +3030,313 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 
 	return r
 }
+
+// Base64 encoded, gzipped, json marshaled Swagger object
+var swaggerSpec = []string{
+
+	"H4sIAAAAAAAC/+y9fXPbxrko/lV28PvNHGkuqDfbyYk85w9Goh2llsSSdNo08lAQCUlMSIABQNuqxzOW",
+	"VCfptRvfdHpuO5nTpD3n3Ln/yrJp05IlfwXgG915nt0FdoEFCEqy4yie6TQWCOw+u/vs8/5yR2vYna5t",
+	"mZbnarN3tK7hGB3TMx38q9jzNm1noXml1fZM59c909mCx03TbTitrteyLW1W8//bH/iHwcNgJ7hH/Ff+",
+	"MfH3/P1gxz8O7gW7pFzRdK0FL36J3+uaZXRMbVYzcPB6q6npmtvYNDsGjO1tdeFH13Na1oZ2966uzW0a",
+	"1obpmt5Cs2x4m/ASDteFP8LRGvwtOqBjftlrOWZTm/WcnjlkAsc0PLN5xbE7KUssV0iw7R/7z/2n/p5/",
+	"FDwg/pHfJ8E9/Oth8A38sesf+Hv+c3jkH/nH/hPYiZf+sf/S7/tHwY6/l7IRDTp/fd2xO9JerNtOx/C0",
+	"Wa1peGbBa3VMTU+Hv2bnhv6MAffsE4Hdc1w7Fam+D3aDewB2cA+gP/T7/tNgN/g2+KPf91+QYBvQDUEe",
+	"BF/BgQz854h9/mHwiFjmba/ewAmI/yq4h18/wBHge1zhcbDj7/v9rPXhAEPQs3S7azveFVxz+g05Du75",
+	"L/29YIf4+8ED/wlcDf+5f+APSMO9CdAf+gNiNT93bUuHP2Hv+8EOhX6A3w+CHfroyN/znxI8sSewYP/Y",
+	"3/cP4MBIsdEwu95leg+DXTzGw+BrtlHfwmQ6Ii/sFy5/O9gBnIA9/YMAZoFcnHovZV/YAWfvS8Z18v/u",
+	"7yFMh3AOT/2Bv+e/QhQ8hrWRMVzOYfBt8DVdNJAXQM3xNIBOeHOutTqt1EP7B0L00u8H9xLolgJHG8aT",
+	"AGma60av7WmzM1O61jFutzq9jjY7PQV/tSz2Vwhay/LMDdNB2Mq9drtiftkzXW+hmQbj3/yn7I4Ogj/4",
+	"A7jIlPCmk91ur92uO3Tg0Wllxezabsuzna30bevjNXyOR4d4678g5cplSlOewTky8skIT/DA3we4g4c6",
+	"AYTEqxADk/jH/lP41H8OKBJ8DctOWaETwjgERau2k3r6PyL7euQ/9Y/9A0IJEW7zPXbbBimzu7Yjo8D/",
+	"75jr2qz2/01GnHaS/upOCocMwFCoPMPruSOyXLzGsIe7wXYW03Vx8BPBJ4CFcNZMo7NkdMwRIaWECq/S",
+	"U78vyAr+XjrYnml06vjv7BPlMKVB819wgRHrGEUBCAb+y+CRBFfwIAcco1ybVN7sf480rx98pSaEcE9G",
+	"pYYnY8fXXdM5CaFhPPchAo33GCF8lAJczzWdUcnOXf4jFUqbnZYF2Ih/dR27azpey8S/DNdtbVgdwOF6",
+	"13TqXQefNpstWIjRLktvhzvTsrz3LmpJMqzHtiFOlMb8pyhulCtU/kBBI0b8gkekQCKSNBkbY5wUVnpT",
+	"UxdMioCH/gBoG17nff+YjrgfPAy+RWaNxCcC1F773Gx4AGd84bDNZ7p0dm7Z0KJkIhBoJP2KJbxULcHu",
+	"mla969SNDTML8mGAwo0K7sEJ4BUCuPznQF0oiSRjba8+3dTJdLN+oamTC836+02dvN+sT19s6mTDM+Ef",
+	"Q04FZceD4F7wINgJHgT3KeFKrMgxo2Ope45pNVF58cyOO4zwVoRPa/Bl2W5ZOCibxXAcY4tOcrNl3jKd",
+	"urfp2L2NzW7PQwy4ZZpfZO1j/v1NrEuNGYlz3os28UgU+ED6BGoCbD4b+bep+OU/Q2Q6ythqt23U1xzT",
+	"aGya9AIApT7TCxCS/uFXAIT74JvwAlSvFVUgAyWue3a9YzobJsJMNeIsqLNQZr7nGPBN2XQapuW12qar",
+	"3c0177C9Ov2sd0Vy/5maWukq6p12sqnLyNjXIXdFpkDK23tDsZ3FXrPlFRsUS+5opgUi/WdauVKfq5SK",
+	"tdK8pmuV0icLpd+UKvVitbpwdUl+Viklntar1z9cXKjRj8uV+mKpchX/fb0Kg8zVFj4p1qIH8yXxUa1U",
+	"XKwvFqvV2PPqtWL9w0qpOPcR/jn3UXHpaqlaqtUrpU9KFXjnRkIuYMsrWZ6zpeC24aqzMETcIOBUDY/i",
+	"eELCQN0KZCAuWQCReMFuFvBRquPH1d49AqJLAdixYdnWVsfuuYIiEXsfpHkqVb1C0tKnRpFxTbF2w5Mo",
+	"RYb8pGutZk6qYpm36iEe0q8Sg9nt5tB34nqc6h1AYdtSbPaPKJl8zXlkfKPHOobVM9o66ZidNdOpO2bH",
+	"vmk2w7/ZX0gS3S2roZOO4br1pgkYcRPpgU6QL4SP4P3oKuskstc55k3T8cym8ggEXS6/qqkjgwZFCP9/",
+	"x98PdtFygpoRiATMepf8vO/vq8BwPcfwzA0VEP+klgEUe59QNAULz2Om0ii44phjWE27w8gSki2dsGf2",
+	"LcuMPYqIlvhU/sNwNkwPnyl3MVJbVFjCRXKlEqwQ7UFNP4AdxiUyeyEoBNv+HmxzsB08iuGU3ydjCeGQ",
+	"WZ1iG6QTf88/AEUDkZGq23vh6ynqxsNxpVIjMh1UOhjJ4mQI73gqVb9mbySJnml5DvtnLklOIKAK6U0w",
+	"VKo1R3EFfGoVwKGVXHGMP/iPGYqCqou7CGfxmFLZAbVy+UehwIImwO1gmxHMF8Q/ZtRyDwn0gBTo+aYf",
+	"VD/loIAgM5qMcwYPEgjAVJwEq1HukI7+i7bpmcp1C1AHj+i0OAeTztDUSACR/ZcE1Wu0NJNgGyjIN/7A",
+	"f4xC3otxhFrYNMGUhboA3V2wYUV4uGbbbdNApsct5aNwFIF0ooAko1zyjscwK4UlfNGymqKckiDcGiMI",
+	"0rMbupq5hPKaYvf/OuSMCfzvVWhmO0CcQSIsYCO1I4yqMaXoSaYz6hmEH61tqTddJKwKyw63c+3pJNgF",
+	"DYia29GAMpCp6IuMKxU8CE0NifPKSfjw3COyF94aCTdVSBc/6EziM2db6+1Ww0uSzdOIK12HCvNNUgD+",
+	"HeyCQsikOEET10nIKql0Ae8LpOYReo9UJgk+ZLCNr+0AAShX9BXLaDum0dyq0w2gAw6C7eA+NUcrGRiO",
+	"Qk8uYRDBBzA0lY4EMKNzP/KP0d3wMNW0tmLxFVGCCpeIEq44odbpj3+iJC7YFagVSMjfMUP8DuPjyL5X",
+	"LE0PyUPXqVu2V1+3e4g+4UmIyhRbBWBXbLs4LRGHEBeu3Xh7RT5BKsq+XgxrM+9FBelI8lY0RK6dRd3C",
+	"kSjLo7fMVapRz2X6KQprlDFH9wY9Buh9QTvGdvCQIQxHl+Br+Cd1f2zjGI9gVL/Pb4wgPYALE956mJdi",
+	"J6mGkmyfigs6puvZjhmpU4o9i2lbpBDSASor5aEgOonpdXCn0fTo7+N1/GPwXSTDxkjI2bC4GFpGqKXa",
+	"ROXOiJilQmeVrUelNICA9RV3F1AS9RT+IzovEJ0GIANQtujvBfd1gvY+QL6nxH8Mn/jP/D3/BYprT6g5",
+	"HBjqE3TZx66S3bM8tQGze2mqvmn3HNnw17R7a22B61u9zhp7/4NR3/9ghPfj54Rwi0CKAIiDq46k5Di2",
+	"UzHdrm25KISYtw3g7PhP+I1uTRO+Wlqu1a8sX18C60/HdF00tAMe2D2nYRLL9ggl0nfvxjc3HCq+500z",
+	"TW3kuE5FYzT9+k8IitPgU92/TD6q1coF0XFJmIwD3/jP8LUn3GP4FHRp9DwF26JoJPCqhaVPitcW5uuV",
+	"0q+vl6o1TQ+fzF2vVJcrwoNrC4sL4gu/vl6qfCr8XVm+VhL+pBZc/tfCYnm5UqtfWbhW4ua20m8XqrUq",
+	"2OOWitdrHy1XFn5Xmhc+qS3/qrSk6dqV5cqHC/Pz+G/xOHAQ8QHa9cQHZfnPxVLto+V5fFS8dm35N9Js",
+	"V5Yri8UaHyWErSz/u7j44cLV68vXqzEbI44ZWSSXlutzxaX5hflirUT/LH5SXLhW/PBaqc5tmFVxCaXF",
+	"cu1TNg61RZYWPyzB7n+8/KG0iMgEqX4aGibFhwtL9XJl+WqlVK2ivbS8XF2oLVc+lcYQHodLXq5cLS4t",
+	"/K5YW1hekl6WfghfX5gvLZaXa6WluU9jc4q//Kr0ab1Sul6lxttirURRi9pml361tPybpXqpUlmuKEWd",
+	"pukZrbaKiP4Qap8D5jZnoU3+S8qQQFMCqW8v1Axi6D+el6tcaZntJtIRFQMNCcUwMQhpQfT+jWE+AEpS",
+	"VDRNAEgmaOvwgzarUSuk+9n0jYnInRwCqnV6rofUzDG7puGRWy1vs2URb9MkTAHRdM3pwZhaz2p92TO1",
+	"BMFjUykMp7tUND/gJ/KtTqX8UHUN7hElgInTT99aDp4ycCpyLrH4hVfIFfepAEbG+CbrpNXUCSinYJ69",
+	"rRO6Vp3Ylmmv62RiYmK47kj3gcGTdbq6dtUxupu/vlZSswrztmdabsu2MpyPNA5AXjKgFSkwHkAkdica",
+	"+UNrDeg41MRwFOz6hyDKwR+gTm+rvHBtu2F4HKzwvsTZXLvXsdSyRbtlmapflJ633HdLp9GtAkjDxL0c",
+	"J8MCeZLrg3/jJiylmYi/TAkL+Qv4UzDMEKU9wmYKY5Lu+334jcC2OJbRnjS63ckNeOnLttFtMRo0wZ6o",
+	"LslNw2kZa21zKNpkUxu6gMzNiWQoeXeahmcMm9zqtdsAZQowOqV2+Y3G0l1SHX1igoUOhKFCeJ5KzzS6",
+	"3XbLbObRF6myd59J2tR8N7ZutF2TmedI09mqOz2LB61Gdw9keJTo/wBCOxgkxpXWUDaAgGnCj459K/8+",
+	"sVXbtyqmC2GWikvm9jodw9nKN1KVvRxHHw6xHu5kNDAD+Ub6oYTgpej/zTqS2RFV21AmT7XxJgMYULdl",
+	"5h7wnMpBb9y/F38rYbrcU9tK+Rq5QM4si5qu9bpN9i/D88CVjw+tyHLUsm4a7VaKQci+pWSFx3Jw7jEK",
+	"SyHuRYLRp8XFa4mFRxEpeyT4E+BqFO4+rnTc5naiDTEX2bcicyzbs3TcqUbYq9DIUgJ2mPix2eq6daPZ",
+	"NJvq12BBbp0fUsYr/PSUr8DCh4xCX8kYJbZFMmBxKOJTxsdXrT8kwKqd/theU8fsczMudx09pThzWWkP",
+	"E6hm8Chyg+4T/zv/L5KNNtJu0Z0JYx6Eplr2Dg9OoKL+brBNL6IgzAASC0BR4kx160O0mnAQQK8GQn5M",
+	"vYDowdrhjrrgfhz7Vd43KblGKZVmOBMjIAehgh/5zOCaHtCgQL/PWYvSiX1KH1oS7jBwjZkyMz2awf0k",
+	"GQQn1liMKRYIZmmALesAbaf+4+CBf4j/yZhC0taGkn6BgSoCOyCkhauHL9Esfo8UIrRDdOZot0ejFV4x",
+	"i42AuIOkjZj9pseQPfiWY9oO81cc+MeCkROjqZOMPuRf2bEp9KLtsbt4wL3HHKMAFCn6HX2560arbSqV",
+	"rvWW1XI3R0SjNF+q2fXqiGBmFnYlsUYXLF2haR5kpwPRZxha6rk/CXjWo5EQZbi3V8VwwZSdze3AZD7k",
+	"jZ7XsDtKA/HfOGKECQGcHkYeKurRY48wvBo2kqLTI8zhgh/iVvhgVxej5RHT+2B651upIoF+P6+l5GN7",
+	"bZkuS7XXXcfecEw3zyhl/iqGFmFGxvCPaAIG8lPK6UZA4QyPcJgREsm48unG8UFYqUxg5QshoEDMxyyA",
+	"n8KO+S4npJ6zCp6zowlk5HS/aHW7oaNZij/m+o7S38u9xsj4ntC0tuM4wtGkodC1alpNACdysOMeWna9",
+	"YVjNFuyRpnOAlPc0n0/9LfCmxtAvmYIWP7PohFIwpCxctliggWM3TNdNlWhtz2inRGsk4s+/YaGj+zLP",
+	"SUQ/UW/7C0KNtVRyU6RCaPpQyReh04VFpKy/GlINBTr1LIv+y+01GqZJBWDGElV4tOxsGFbr9waP5o3d",
+	"uTQS77Z7G6OlCgGKPKFZlP5ztmuRUJgM7P1tQQQNtrfRNlodYjsbVNg4oBs9FOMQVJaDpNrRsmOvtc3O",
+	"fA57/B6zcISpsn6fVK7Mkff/dep9em8QT74LFQD/JUOp43Tz5QH8h9nQpMDkwxVrleb0zhI0PVBr5WSX",
+	"Avw/IF94dYIg+j6NOdv8PWksASTC8mG+QRGUYueArIKtdXWCxn9EVnfmPUy4qKjzghsWIu8hKPOuZ1gN",
+	"+GoSrHzwwuSG6UXcZvbi1EVd81peGx2TtkeusG85V12ze97sWtuwvkga51PcjmwPMEg8vhHSzmuprhjF",
+	"qP8JdPyZ39d5MASztMI25hw1vz0r2xcT7Wu6X0LyDalDmTnlUBBHeiCKPeiD3zXYSfps1ZPQB4lx/gwK",
+	"rP8EhVvhhHWCdPSVaEpEPgsUlBLlSJ1FmZE5m7IvPf7KFyUIOvixkgpE2a5pqYXZkSRc5leFOoMCqYqC",
+	"HJuamJgZTf2LKmeo3mayVjFdOEyxVoueKWfjdCPkEU2kd1K5zFuTARBnuMtldOMzv/mN0YWe5Pp1uSgK",
+	"R1gF6g1B3/mQmsWQ+HVjzmbLTTmq71kI+gFVywbMfkYzZKki+AxqfuDdOQRFcC/YhZxooDWzUgUTOCVd",
+	"kWzKzBapEhiYKBRXkHFt7kY95DfW7wuv6ytWPLUveASpfTAl3pfLxH8lBr4Iq0HNYBBPcMKwMgyBmuxG",
+	"RzfJtnDFEmlCziT90s2U6Od3N1oV2x3R8Zzxd/SLYmag+U9JKcRYPn4Vh5AKijJJSjGCeczkQ/AFn1Hm",
+	"YyxjMXX/lDp/PE1LiOhMaPDcU6SALCUrLV9eXRYl4t5TJVHRSfJ9MJMdALpHGUUTDBB9hKSuVLvkHqWx",
+	"j9HwNggezQq1OegFzJ3AheZK9VYzc3n61vgDfcVSjCmS6IG/j4KiOL5iQ6PXqteKK9ZQgZHicWpOmFgM",
+	"ZVPtZn/d7PWdWHVmxHLYCbMDDgs4CTbMOmyXYMlL/iI8MfABwCH+E99TUbK0ijsj7JCuSYHir82Iep7N",
+	"j/E9UmFLSo2OzNSnIZ7yRE7dkFeEIjc54tShzEHd9QzHSwnaDitzYIz+gKXKh8U6/H1yvTZHxj799NNP",
+	"C4uLhfn54QGEwpzx5ekpO5OyRvURiOiV024Z5VgPy9oroDqwxzYAijSpK/xkiZf1rt1uNbbyypZl+nZ8",
+	"GxkdE0BXbwcMEkp0iWC9KDt3DKLaxmMcP8r9AWlgEtmhO+l6jml0LnMbTewT/HMfq1Y9pVYaVHaoJtT3",
+	"XxIchVSrpYkVi+/JhJBPJ+bMJNLlVLWDIMK12zYapsvjQmk5R/9ISNUBYWBcF2aMfCx145axFZtYdOFE",
+	"c4YzQf6nlEvo78HgIvpOiBmKUlGzFLcRGn/AwxR8xz1M+orlto0Jx+y0rKbpxGCM8rZo1cqBf8jTCam3",
+	"qWkaTQhHpdo0qqZSeBcZY8cywK/hjOlU49Tmm8feNYKRagQFIltu4utSFl+4Ryt6vGKBMceJ2p7RbnAp",
+	"X9xjIGCjxADk93XQua4ZrlfAC1lYmNdes0R3aqVE51qGvy/ZL/y+ZI1JKdTBb0qaIIB3NoeUkE5KU/Zn",
+	"hCxs7h2Q8q7FndiLolniwQUPpNpTSWP3cPKOiFCD15UeeWawRif5qHLtEHZQYzByGTJBiQXjQZxWxuae",
+	"CDOAxYuUIocmDCbpdvXR8vLB59IMv4od+n9ExYszSUOyOIumn1AzS4//pL+lXNsYGkTZIuE3urRD6ccc",
+	"ig4pGZkYAchKIVNxhhsWZKYwILkNp5cZT2K+cSEjhXJ/yOGEi/Od6LpRFCUekyrhzCiOZTzBnkKaQLMX",
+	"84pXVfq2aP6rh5mjYX3eS0J13ik9V25HbILEIQhLBEkiFtSViPySq6LqJBL8SCH28ghCKr/9AjBpQmWE",
+	"vdW2EZU3kxdFLz8pxKwz1BOJSVEIKDIebrbnxIUU+NWUWRH9WnkzI+LV4eHTdCwl3DUm4Mt4w2KDc9t8",
+	"YZRFk2tQCVtv28gzQLVtDIsgV4RAcyLPQVbdfQG8xFJbrhCcmIy/bLn1rtPi0eVZbJMEjwDHxOhQEX9Z",
+	"7ZLD4FFqxQoyJseG7kt0OLivzhNx7LaZ/3wq8PbrpcTRjmafRcVWutH/QbcAly9VQdaJveaazk3TYRRV",
+	"kkjCXU+ppBoGd+Lcmq61TQOtGmzM1NtBgb2O4Xgj5abwGZVbw87thj5cR8idNBGdRgyq7HNwaYmJ5Nqg",
+	"IEkk5SSPKll9PFHwNTWGlx9g8A0viJFd7yrYHcn5nzARnUl1JG4NGXbVlIRKYdmJbXDaMVXbRnLzUXMd",
+	"SWobRl70KH6PyyIY6IcZFoTGJ7mmV20b46rKYzmKXEZMEmPMHddjenNUGOIkqqtcHWOKFKhaj9jIgpxp",
+	"Hfwh4oqc0puALqyLl3pOW1ZjvrW+rvITsnyu9GAYJU+A6oB4hY79x7LbneYQSqaLXaklxF7wSA4HwI9G",
+	"C6ERrLBqqJMTZCxkpKmb5ql2bBBs850Ivotg43qtaO6RLwV1vHWdnmX+Gygxo21YkmKegmSl6CdKN2mc",
+	"8koeP/Ve5Dqn01JLhSFfEF2EZLcRxUzGjofle0sg6MNSLUOcOzml3rIaaVmsTUYZhorBnIqcAcPBOVXA",
+	"XndPIAufNLfz1BKlKOdnS5ewLmAxN1veVppYc6o8PUVqnsgkDWi7MBnO4E7eEWe7O0lLJaqJDscvxnVc",
+	"ZVDFMJnM/0GkBH7/FDLY5dQysmG+mRgtjaTofpr4/frpYDwWLf8mnwm1470kssbAW6fC+JNQnLsY67xu",
+	"K8N4wgRYml2zDbGC/jOahsHbY/WRLwg7pEo8eMHC5oX097SatTrimtLhPNAlS7lkJ4eYQSn7dRBmKrL4",
+	"R+qFoQeIAzxFRvXMxwKNvOXRi5TMibBRGNr1Yfw0dwSoHbty7xtIR91nRSBFs+BecH9ixfL/XSyfjlIQ",
+	"Kc7NlapVWsuqXi3NVUo1XQkZT+aIahTFDIGxRFH/OcvrWLWdjVUpswMlRNiqYBd8jCvW2CptSshyQmbJ",
+	"h6bhmM6qTj6qzlx6b3xWmhon9p/LQ0Jrs2kiVupiLdFYHcSosmf4lS78m4+JwK5YPOwoWWZ+VU5fWdVZ",
+	"tU3JYxpVFNymI+oMPql02ARkkcOkijNYsdLOQN70tOr5cTDJGGTLjOs0YhVpEJW0wstBozG4IUg19SoL",
+	"m4FsEv/v8W5z/l76l7D4i0Rdq2uC+P9U7QDkMG/7feWQGLaAZzoIdugN5II97MyKJeFiSE76/uMEEsAR",
+	"MTxFZjhLQIxenRVeCXbTgMD9G+DkB7HKkJxugQ3+m+AhvZNwbSDHFWjIXiL6GbfpAgnLyU2sWCsWTa8g",
+	"5eVqrSBeArjpnMIMMGfpW5hOgbALTbPTtT3TamwVfmVuraLL9JjMXLoEEMC3+/yD8QnC+BUN9Iv0Vimh",
+	"/9Lt2+MrVlhOZsDQRiyhVqtdY5SXBxuCE34HgwdoYj5X/I79l/AmhgV9B78OCKPQEBbA8HOXm8wIz8R8",
+	"xip6K/olrljRmr1CBXyUW2aTHSzyB5xZho+W/ZZuRKxIPcz2FFs8PoFdk6khrqJALs7MEHUlOThyYTo2",
+	"+UHku92P6pKGdI6lOSuT+SlJ+YCklLS7LCSipU9wTEVHSiTp3Uc6uGJFR48eVnZa1Ea8T7+jhQciQ2Yk",
+	"V4owXVue+xWgQ7Tx/kBqJIANQHdo4XCZZaXefTm5DjmISMol7iKTPMX9YI1GVsfpffu/LH72mBpr5EMG",
+	"GxT0+WR4/zXnZyzlPQQJjBupEPHgkYXyOJVxeWOrY4kywXSzhCfPhxFcVBZ+ifGw32BMLHyxv2KNpXni",
+	"VoF4yOkIXFJbxWgh1g/gEInBAGpuc4GZT8kraRxiPA3KaH9k0tYPHE9YA10a4Ze8mIMVa7VieCa25yzg",
+	"/6/qRHhUMTtGC3JcV+GApR9c01slY0AIsTAZtYQgFeP3gq5A2MzgwbguIzsuFHjn/RUrWisVHWY+IGKd",
+	"R+TbFdNztgrFdc90VgkN6wmnZwKVFqU7liuEewtJ5BknVdO52WqYZKxmuh6pGe4XOrlitNtkZmrmEgjz",
+	"N03HpWLw9MTUxBTvmWZ0W9qsdmFiauKCRuvGocTPFDYDgsXh7w1aZDqs9bbQ1Ga1q6aH7fQwpFzTpfbP",
+	"n91Rt23mHTTydZCUuv/c1VPHHNZn907OZqaZQ+SOP0UuMogXPhGTeZGlJKc/TT/SO0MaJo78ac6umeoD",
+	"jFBhMmrlm+Plmp37VaH9bo63xX7Rd2/wGBCXWj5mpqZoZrDlsSgTMVv6cxYYNQLKQu8XVEQVXS2oIDeQ",
+	"Q6T24D5ePEMw5ORiACUtATz/mLE8d9UCQVpH4ogM9oB1h+7T4susk2vwgDMmLCREM9n6/JdXSM4pJ0Uu",
+	"jMCHdfg0/3+n6Mso+AnT8AQ54OFRO3BOwePTUPuuZ2wA7aJdQrUbMPMw09VQ6hgWqneFXvRJajkMf2N9",
+	"7F8rDs9F/QKUZ5xuCKRofPHnj8ZZts7Qyf6CWkziGPr3U7VHOhkaMgsqWnVtVxXW9mfRJBQ2JH+OhUhC",
+	"LTNsPSLVGWMJFENrnKX7TgjVpBV6q9TNICXNfcXy96nBNtaMguktkpdngojNg7jyRQWyuKl3tNYUurr7",
+	"RRjzCaJnfJHRj/sk7I8wQUQcSSmURo9rQOPY9/znVAyUaUzZdjOIDOsb8hORGrEIiNiohDXj0hChxWZC",
+	"LDhSrNSngfRamJouTE/Vpmdmp6Zmp6Z+p2w0BAbjaU3XejNgEgYHhjbVmFq/aFx4r3BpxrhYuNi8dKlg",
+	"TDcuFqbW31t/f33K/FdjeppXsppVtrKKmdw/U2Q/ab1LihSgWQpMIjxb6zqF6ampaZRYFGO9px5rJmOs",
+	"GXY+Qn8qadcuRLsm9aMK91/wXmldY4uuVe4T89mdjOmj2HGpwU/PFcCnIGafmarZinrHp9W7dCl7x2/c",
+	"1Udlf+wCqTgESP9gykRhgxncI9/XL5QNwqI/OD+L5qyAH+zXYiE3mW0LtQKVRrS4iPCD3PYvu6VipkRg",
+	"3oZKv5Mx32CmRFrCT4pyMt1ILIKOcAVj3XOrYGJGau6PqL9moRn/LFFvN5T7WaC4Mk5OqV+LmZunUXQh",
+	"4ABq4I+4wpPpx6Px5NsFq5m8KIklap5525tsuDez30vcmRjV1UlktNDFLDSd0PRpnQhZCTqhC2H5GL8k",
+	"PZgu9r3zsFjJRUfdGEKVuzj5+3PwAKzbwa7/PCyoHq93mNr2c676CSfCS/MfV5eXctHHXJZMRhnV9swT",
+	"0cR3RtB3RtDzQeQzjJfotbiPN/SIeWKe8Gqo1FUa69X7jsr/Iql8DGlE4+1J6brgbBQFX2Wbfdbj42s6",
+	"ZWSZEc3DiKvYcwHN0xBTsSvE8kVxCtQVSys892l1TxZ1PEFEcTR4QNYduwPjeTZNW4McA/iK25diARrB",
+	"I1KuTGh6Jo8qi+v++Ynv70Tpk4jSiSRunYQ53DoJeVUkZke2NJ2WGXwnYv9SiW+5cmIaC9iU16pQ9Yw3",
+	"RZDOg0wlMaZkdO/gXN9VTBsYoFS5hy6jo3e3ld1WFS6gAIKtsFmthJfxSvId03NaDZ00Wx3aLlMnX5hb",
+	"OonKaOnkptHumZm3vtXp2pmexP+mTdJ40yhqX4vSu7F79yHrVvOSClK8vWmivVW2SxLCRIN76jaBQkM2",
+	"jLSKRLOLU1O8jBR/K/gaD+ElrQ33NQTvsoDQQaKFoX/k92Mgc1lxgmA180P/OHXxYpMtmAEjcNmQUlvL",
+	"TH8e7d+WpKOJkwiPX+hdp6fWysDnc/RmFaCqCxnjlIsUSMO9KQUxbhmd9niKRs7qnIhaMc/rhs80XQNq",
+	"qCrAqKqRL2Sj0BPFIEw0jCfORydhSCQG6B4l+qfRNkcKoKMmNRHUYYlG7FKZLCRAGQaKXB/aza0M0oTr",
+	"Pis+EWXCgFf07msM9pB6f6qo2Y+Ja/RCuEYn4VC2ZS6vp8oFasD0kSj9jTdG66Ve23GyJDeUDPdsXCg3",
+	"O4xTSENIqxyPM5H/iCJL6CEB/aJ2lx0pvZcpt+mxGv5z2veSgTlX/SSTY3xur7mTdz6313IFRH1sr7kf",
+	"22uqICi8tNi8OLyzdFQtfiOyzHGnj1cYLQQh5sgO++sxioJu6PfWpxszxgdmYWbt/Wbh4vqUWfigcfFC",
+	"Ydq4ZFxYn2q+vzYzHeuBBaO+r93IjFCINVvT1lrtNu3eE2uyFrn2xe5qI0c0sE/RJCsU/8oOdEiJaIjG",
+	"iroPZUc5dOW+TbxT04WwMdMloSiw0MlIbHmmOM+Z32m5YwOg3WeKIM+bmuhcNoD4fbjW2zS9LupoKDWE",
+	"OiexAn8Vu4nGQwP8vTit+mcy8OoPYc/U41jnz0ziYwvpZ8O11GXp7VOy1Vgj9DggufJnRYCG5u3LU6hz",
+	"X1VdczLyyoSWsJdz5aKxBEYxl22VNi4VxLg+L0eiyNJLooKQ26bMtRXOXz4/iOnhSkqKJJ088DyynIIp",
+	"MDpaZnSUNM2u4Xjwb413M5Pip/LdLPn480h+02eEoiNClo6I+fDwB2XCpmT5ZmFTF37upPA/o3wsppzE",
+	"00LH5NsTK4Q4fk4iqVKOfJflVAa70IpVLNGuYBIs2XaHFldIjvdtBm2IeETcgpmgkeiNCR7RXC+0lId2",
+	"i+OwhfYRLx70imXpPQZ+FWyraw7SCs1Jb9MrPgOa5XnEMOupt6MqFkZTVVlxh6eZTSJDB9PLqIgptLpN",
+	"OpgITztXu3RV1gnOQ09m430T5toRU3WixWSIdGKxhV+cOTZ2H1XlJxRYqCca09AuKohzLNeH2zQV7tA0",
+	"wSPRnTBVJsSabJGGlmHLlMTWqOGuIho/bHoOaUV6akf0AkvhhFvdTzdr/jOsz3jo7ykyK2iyMs6c6LUD",
+	"PW7ytQCPF4odU+XbskfhfAIgaDNlSVQZiQ2C1sqSYWkG/oqlpFXCt4NYTa59Ff2bIP7/od05hdhaqWmb",
+	"pFsBRpUr9CBUBopMwyt4nucjxDmNvDi6an5XT5XcpKb9ssUyZxN/XsxEMJUKe6iKOU4Uukq0du+0rGum",
+	"teFtarPTeo5G75nvx0XMzD7iaplzmOw8c2aUO80OINETVjcDctnRKp8sCnDNprODVIRm74jq0PYMvJxH",
+	"TFvWdG3TNJqsFjEfZYhV+dwyL6wbzot5xPnEeUnFoFbaZLHvpK0lSq8FwZXmo8iMHEP52I+sZHZofQHO",
+	"I1hfwBCQSFlUNTAfJBnVcbwU5wPml0uTX7FqyyC4n+QCIpOAJarnEsxH0rQpwoLUoyYjekzqWZNofqCT",
+	"tN4HwJtUbV4Y8+YVO8dg6xXN7tgphtXcVyy54wsu9wlyu9CjEFJIMiZuwam7V4yLdV5CTRXYLZZgZTbP",
+	"AePCtBmOuG20Kg2BwixY0uVlsMsAwvJ4WLQF5SmUSaYvSVUwYi1WRRcyXeNLXqCFBiAXALkxBmgbCw0d",
+	"I+B9JuBREpvSNh2ki6dRsVjBr7tixeg3Ti33aBEcK0IloUiL/KNYkS/WPQk343GwG/yBlSVmauYhcnNW",
+	"RFD4BisFyeY7oRIPTvkUYX1Grzi2oO2zGlwgCqbWe+PRB/dAeo/BRLFMnDjau+f+XrTKcZ3/sE8d7wJ+",
+	"AEDyWvpk1aHVVwoKbrdi0b/xOz5dqFkj+YpqAhXLC5hfC6m8qKv3WZIuq5QmfibjgFgYOTr55HbSY4VB",
+	"wGL5TO3Kv2rS5qhulVKXXOVR3kSkOn5MhYfo63inodM419DHjaS1EFHWfIxQbEGW5opGE3XsJugEOpJF",
+	"Ohkli7EbViDC8KAcYPzbuRGKxGKLqEYzZKLxEhFjiMsCaXtK/GOZPTBlITXPX2hLGyslXcVOAoUq7Du9",
+	"FOMCR6ZPGEvecIzu5pftDI093pMJBeSkihwTIeQSqHFESXazVfRcCx7EvytXFNHkNKJJVbgNyhn+QE0M",
+	"jBPQ1rygmsE297E5Jcab30vYOwiLD3vlv4rZB7AbX9uGC02J7nHwJxreFOxS+LaTcjEVzYSZWVNM3AVW",
+	"23Bb+Np/mdwlHGNAFXQ5DEKlpqfZD4CDHDDq+pLMTE3B1TThGrlRRzFRk8IS/VcBT3597fKKZd72aLCb",
+	"O9GwmybvcPOUSFcxTem/yvBtVEU/381lULJA/Tcd2RPOHlKjXHnsCvVp6ufvklHphsq4PJI4NIXqhAPw",
+	"N/EiScI2LTWpJpLwG7PpJ4M8+0p7qkAs2ZSMWoql9mjQikg4k9guZI3M0ddPYeAS+i4OK3eh7EKoFZtN",
+	"4pqG09jMsnxlt3f8iXtaX44nMEqVkqkPUFFvMm8j2FO2qz6ZkWx6NDSgDYRVDT8/o3E+vQvaDRGq02OL",
+	"EOSD7azvZqBPd2j1ceFSqLqzJakJsH3BoXZOLEz/SygZGzMxCT1ok5cgYYAKHuT3Xwt4hCyfftKkhS7r",
+	"pd8uVGtVbGLmusYGfUpaTWK0HdNobhHzdsv13Nj5v317W66c3OvNPLU5mtyhOPYqqlU6CHmKmtyQ1EKu",
+	"tG6zsi2M2FtQFOKlfMQkc2KGtbTQLOHrq+boqffC5wvN3D7mqP33qXzNSVIYp3QsnLQYRh9OTxVmLkrR",
+	"pJstl3Kez+5oRsZ7Ju3Orc1VSsVaaV6us0SjLfN8Xil9slD6TalSL1arC1eX4gPNpA104eLspffEgRZL",
+	"lauledw6atssZnx0KoovV4WS+r6mrVZakdhsRfsQvDc3BDbC13FWjIQSgyQDd3IFS5Ur54OnlCtJ7nA+",
+	"YpviUh+l6Kw7Y7yFyCBV4sPmckfIG44oKRZzXwSJNGmzYSbmAWcSwXaSI/BQBTG8InTpYxwGj2cIvegM",
+	"tFg91CHUPaReuSj8R+ztn4jKv4WVhUUpyPKcFgvN580DQRSKCD6vn4isJYv8AeWbHhabH86hYgkjTTWT",
+	"TeBdzzE8cwPQ1zGspt2py32Ek+wnAVmlpIJtRg3btAjbBX2EPIeZIawqLHfYMaye0R66thEqD74rHq3K",
+	"6M9ZJvr8csycBbDLFexdIPV60ZU2fD3DCQ+/oiw3PnpZn9wco91yvXSH+/dRQZZYBw/ueROb7tC/X/BW",
+	"LTTfLaxOfEB/Y81ekNU9i1WJCPlklPpawCRi3kVp7nqluly5TNrAGGhsLWXYYX7wTvBQWbFF2IZrsORR",
+	"ed4pWNG5qOhCrZTNkWKH2TcjtBKo2o53Vgwc/BH1Bh4E6BW1xtZyrXFh8fcLl5asW7//3ecft2LshfH6",
+	"12keu5Gh1kjwZt5EHouArqc/0uDW7TC8dRB8hdW2j9GtQ2smRVc27F4jBDQkB0AKYvXabWOtHdaDzjTn",
+	"5s+zEm5idZNmFGfnWsnT5Mpx+ae0GCDI/wI06B179gfDNBipwxlTZ86omcMQVoS8LrfbZBHfPoXXJEsc",
+	"T7c95PBynNB/IXKzI1r/XghEhbPRo7iiZGd7Vr5DkCBG9mKczEsx9Wa8FK/ZqvXaDFC5PRn+fjLgakA4",
+	"OO+sUO+sUJlWKGpFCq1QLF6Dok8YY0irUOywaEPewVOunz6CM4FH2eYm2rxH8WnoNhgKYgblE5FyaZwT",
+	"OrR/tqRel5b/0xN+6HnXu/Ta3dN4Ym2jwVtv9C5pZ0fnY4PH8YFtdhQb/0RRHZ+m02UfpaPJM+WSiH9M",
+	"D/VPhokdnx9+E/Y3S+kTf3J+xFAbMYUKJwi72H0qCvIW7ROC+sXZu67RanBq73v4UuR9bxiWZXths3hi",
+	"WyyVmZQrdCsse86wmq0mC0WS4aI9pKIOpUc8aZFGGgyo0xv2Kgu0peX6XHFpfmG+WCtJ0Fk2oUmIhOEp",
+	"dsZscHhIyyLUIEsBZR0wEhv4Y+ahPQ4e+If07ASETmvvn7GImmhpjxbBCRRpuQT2mlMuqB7sbbZcttN3",
+	"9be+i4qU/4t9XY8jHxRGEbOEjgHWEky3RybljuSrAx7PD7ZK6CDdZ4lhanLHDBK8zTJ9jcY7sNIlaflD",
+	"Q2UTOL4RJBN8/bXokwmfyjlVL4V13hlZHnn9sshbEE8Q9U6mydmY7QN+pKiD3ztV787PJISM6Vb5RYyh",
+	"bErRm0pU64TKqipCytLto9ICRzRLj6aG4mfMKIy1D2kdCFa9QEHsR9ADmbidL+qgymXz7IqqP2KWGdWb",
+	"VY3sX0VZMfthvFy5klJw9MuR6ha+Ni/KiRw9ouvp9JFxb4/D4+11H/w9IlrMtI49TwHbBpg0C54TFs15",
+	"SNMaqUGFm+HDbMtzXjg8zefAr+6e0tuAxZp2cBiaTcrud/gdGrBUFzybJEWS0aTRbGaLflE0UrHZPINq",
+	"dLx0SMHotjRds29ZJos6iX4TgifrXbvdauBU4SPX7jkNxMzo4/zqRUW0Dr7m6nWyCJobKvleCoPkupRq",
+	"0fYcxv9LeZUF5OB7LGWlH3ylrul5HiSrtBM+acB+SpoET3PkW3zsv5A2OXgYfMXqX8QD+BOFGnhOpSoF",
+	"QKBV4S1omQpaNSQkP7pCyoh8lbSD/3n9hZrffvJwTijCP/Ll+wx17SsvRG48dU2vHHKtPJy1Gn5w5vx1",
+	"VD4afeHWG3YPZp7OcvFmJCcmJh5eR8F02D7EkTy8qPKgb94G8pNc2R8l4krL/jHvyKHI2H6p9/evVKz2",
+	"j9j9DU2zx1IeK2dCMWY1yM+qLof513027l6o+dBRs+oGQNJ1bBYWkKpIacumNlguMia7x/byL6iIHFKt",
+	"g1dH5PVgoTIBj5MVSt3OKqLhWB2aflr/BbEZIF8qNFNNCCJ0INr5JsLfqFUN4elOIFC0XFaeEZNBtoVN",
+	"Dys89IWtR0zZDx6FZlpxz0UhBirfsA49Xadnmf8GJEJRjSNmTtflg1fBsC+n6kMcM9XtWHmeCCRWRKof",
+	"fBcBw6olUnBULX72gwd81mjLECx1Rxne/yeligTYZahCl21destb3SR78/xN2PNBCGWiqJuiMsiQM6ZN",
+	"nOQzTlkKYtZr6tmj4Pwds7MWSrlu2IyDyrJStqWUUlhstxombfmc8VFKHuLwaqBZrKAWOjXfXG0RmLO6",
+	"ZTUqpgsnMVShVHDZ0IStuJ/C3aBV5vYpB3lGe3LxCw57NHLBgmZrfR1f8zyjsZlo38KydWNPm2b48g10",
+	"HteFBij4jP9ND2/2M36s4XgMGWgvEnjhLn3jbUC6NaPxhWk1RzD8jIwA8VL/WTq0RF92MRg4UaIJA4KF",
+	"fBdwLkzKvDBs1KtudCS6GmA5cXFgkR9KqlSQGipAvZ59VuaOB1dmxubJRReC+5dDi66y7GdaCa7QCXOM",
+	"5fl4USqhEPSx/2Iii4vxZZ+Chjo2/JdhtZZC30IcdVmrI13r/auWZbqnww7HS7qCCrx9V5r8TqrvWPYE",
+	"JN7KNPVHEwjDvXllitOSHKwil89YuEnfAdNWoOF5NH2OHrwFBU9kavYXWhXV3w+zG9TCe2YXtljt3N0s",
+	"atU02+aw4k+0tDm+d4qrPWrh8qzbl3qPfprLc0ZgZnNBSNfcO2eGhu+HVaQ+k4JAtVJxsQ5xc6XFcu1T",
+	"KWgOjoS4XqvdJpuGS7g09dZHyf05pk6TqD8DVmN4qNSlk0U2YdcjX0IYqZGI9KeeYZlW/RdDSG7soWXB",
+	"aRXw3PRniDMB3j9JYR8e73BWYQhvjZg9umqX5M7B/6Qu8Xis4i+GpmS7HfLqEFlo3bFvmlSYzFAC/p1F",
+	"L/Kq2UpxHcOG4y1ZjrFVTuxJigR/xBsApMvuixG0p2HwdkrbkrzRpOuO3ZHbfgyp4KuqS89twvu8XnjY",
+	"PEslk11O73gda4GjCh+VV3znFPGl/MXYmG9CnMmnE7lzm4a1YY7YYiK93HMyiUMKRkoJ536nNKiUhh9D",
+	"lKd6wiBj4/fD2HUFo87TZCNs0JneZkOsRR3StszeGgn66ZgRBXWHaycV6fWzVlJi9oaZTFPD+TUanJ5Q",
+	"5LEMvKMNp6QNZ6Awga6EStNiafHDUkXSmHqukGLEFCZirxNv0yQjhgD+RHucmaYVWV1pz5D9mKIVp70K",
+	"P9eQ/vivoa+RSHBHobGcTA0jrkJ81hl3tBvVKJRoJJeknSexx5xNk7i3yAb7fVwOowg2YO4ylrszSgG4",
+	"YfYVRcnlcD/DssstC6IL3n4S8Tfs6csiOZkvETtI/HJ1ZAUCKbTlLHrjml61bQynN1X63mkaDPAKkE5U",
+	"v2G95bgey+Kvb9o9ECtnLo5OgfjY2WdQbRvFBu/Mr5oaW1q2Or2ONjsVXuiW5ZkbpnMKMqaYSucg/9yJ",
+	"GuR/SUEs3/EQ+nPbVznYRs3pIJQEhZqGIB38UskRb5YKBAjQInR4H0faKSYeguNEkXpIswjLFbEqfbLn",
+	"ZAoxAwnYBcN1JUzZPu/VKK/Dkq+aUdb5aNZ4+HyheaIEwne1K38OtSvfdJZmfpvyuyqV3Ix0Imv0SWpZ",
+	"SvUY/yXsLqKyR74rcDm8wGW58i+IeU+onzfDFpSrsAznaEjSJY7mmt6CW2ROyiwhHT+tCm+fQlQX/KIs",
+	"JpfL7EyKdZUO04wbL4x4R9GKPjl8rsb4g7Te1UrjjVD/IK2RZKqFHv1RO9Ty84xH1bNqN7RBXPA1NjNU",
+	"lrabZd2GX0ahss+TnsKM3jy62Aw4Ddf2SUjgRHOZJHvkmzllGzBcPnl4J6BgETq8kapxeQJ875xZTwHO",
+	"CdU3SRVAMDTwILdpBqgA3v+WtzWKzT/KnVeg1jlRaH7MX74tFlOT8OFjh324+E+IQH2O2LVLd/WpSD3M",
+	"hR11KRb2nLY2q00a3RaNN6GvhymMVOe5q4cP6DjCA6mmgfBcypQSni87G4bV+j1uvvQD6+QrPOHtKoVH",
+	"H5lG29sUn9CW/Hdv3P1/AwDZVaZQUDoBAA==",
+}
+
+// GetSwagger returns the content of the embedded swagger specification file
+// or error if failed to decode
+func decodeSpec() ([]byte, error) {
+	zipped, err := base64.StdEncoding.DecodeString(strings.Join(swaggerSpec, ""))
+	if err != nil {
+		return nil, fmt.Errorf("error base64 decoding spec: %w", err)
+	}
+	zr, err := gzip.NewReader(bytes.NewReader(zipped))
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing spec: %w", err)
+	}
+	var buf bytes.Buffer
+	_, err = buf.ReadFrom(zr)
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing spec: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+var rawSpec = decodeSpecCached()
+
+// a naive cached of a decoded swagger spec
+func decodeSpecCached() func() ([]byte, error) {
+	data, err := decodeSpec()
+	return func() ([]byte, error) {
+		return data, err
+	}
+}
+
+// Constructs a synthetic filesystem for resolving external references when loading openapi specifications.
+func PathToRawSpec(pathToFile string) map[string]func() ([]byte, error) {
+	res := make(map[string]func() ([]byte, error))
+	if len(pathToFile) > 0 {
+		res[pathToFile] = rawSpec
+	}
+
+	return res
+}
+
+// GetSwagger returns the Swagger specification corresponding to the generated code
+// in this file. The external references of Swagger specification are resolved.
+// The logic of resolving external references is tightly connected to "import-mapping" feature.
+// Externally referenced files must be embedded in the corresponding golang packages.
+// Urls can be supported but this task was out of the scope.
+func GetSwagger() (swagger *openapi3.T, err error) {
+	resolvePath := PathToRawSpec("")
+
+	loader := openapi3.NewLoader()
+	loader.IsExternalRefsAllowed = true
+	loader.ReadFromURIFunc = func(loader *openapi3.Loader, url *url.URL) ([]byte, error) {
+		pathToFile := url.String()
+		pathToFile = path.Clean(pathToFile)
+		getSpec, ok := resolvePath[pathToFile]
+		if !ok {
+			err1 := fmt.Errorf("path not found: %s", pathToFile)
+			return nil, err1
+		}
+		return getSpec()
+	}
+	var specData []byte
+	specData, err = rawSpec()
+	if err != nil {
+		return
+	}
+	swagger, err = loader.LoadFromData(specData)
+	if err != nil {
+		return
+	}
+	return
+}
@@ -0,0 +1,95 @@
+// orgimport отправляет файл организации (YAML или CSV) в /api/admin/import и печатает построчный отчёт.
+// Токен доступа - флаг -token или переменная PRCTL_TOKEN, как у prctl.
+//
+//	go run ./cmd/orgimport -file org.yaml -dry-run
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	openapi "github.com/wozhdeleniye/avito-tech-internship/api"
+	"github.com/wozhdeleniye/avito-tech-internship/internal/pkg/ptr"
+	"github.com/wozhdeleniye/avito-tech-internship/pkg/client"
+)
+
+func main() {
+	defaultServer := "http://localhost:8085"
+	if port := os.Getenv("SERVER_PORT"); port != "" {
+		defaultServer = "http://localhost:" + port
+	}
+
+	file := flag.String("file", "", "путь к файлу организации (.yaml, .yml или .csv)")
+	format := flag.String("format", "", "формат файла: yaml или csv, по умолчанию по расширению")
+	server := flag.String("server", defaultServer, "адрес сервиса")
+	dryRun := flag.Bool("dry-run", false, "показать изменения без сохранения")
+	actor := flag.String("actor", "", "инициатор импорта для журнала аудита (заголовок User-id)")
+	organization := flag.String("org", "", "slug организации, в которую импортируется файл (заголовок X-Organization)")
+	token := flag.String("token", os.Getenv("PRCTL_TOKEN"), "токен доступа (Authorization: Bearer), по умолчанию $PRCTL_TOKEN")
+	flag.Parse()
+
+	if *file == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+	if *format == "" {
+		*format = "yaml"
+		if strings.EqualFold(filepath.Ext(*file), ".csv") {
+			*format = "csv"
+		}
+	}
+
+	data, err := os.ReadFile(*file)
+	if err != nil {
+		log.Fatalf("не удалось прочитать файл: %v", err)
+	}
+
+	c, err := client.New(*server, client.WithToken(*token), client.WithUserID(*actor), client.WithOrganization(*organization))
+	if err != nil {
+		log.Fatalf("некорректный адрес сервиса: %v", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	defer cancel()
+	report, err := c.ImportOrganization(ctx, data, client.ImportOptions{Format: openapi.PostAdminImportParamsFormat(*format), DryRun: *dryRun})
+	if err != nil {
+		log.Fatalf("импорт не выполнен: %v", err)
+	}
+
+	printReport(os.Stdout, report)
+	if report.Summary.Errors > 0 {
+		os.Exit(1)
+	}
+}
+
+func printReport(w io.Writer, report *openapi.ImportReport) {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "ROW\tKIND\tTEAM\tUSER\tRESULT\tDETAILS")
+	for _, row := range report.Rows {
+		details := ptr.Deref(row.Error)
+		if details == "" && row.ChangedFields != nil {
+			details = strings.Join(*row.ChangedFields, ",")
+		}
+		fmt.Fprintf(tw, "%d\t%s\t%s\t%s\t%s\t%s\n", row.Row, row.Kind, ptr.Deref(row.TeamName), ptr.Deref(row.UserId), row.Result, details)
+	}
+	_ = tw.Flush()
+
+	s := report.Summary
+	fmt.Fprintf(w, "\nteams: %d created, %d updated; users: %d created, %d updated; memberships added: %d; errors: %d\n",
+		s.TeamsCreated, s.TeamsUpdated, s.UsersCreated, s.UsersUpdated, s.MembershipsAdded, s.Errors)
+	switch {
+	case report.Applied:
+		fmt.Fprintln(w, "изменения применены")
+	case report.DryRun && s.Errors == 0:
+		fmt.Fprintln(w, "dry run: изменения не сохранены")
+	default:
+		fmt.Fprintln(w, "файл содержит ошибки, изменения не применены")
+	}
+}
@@ -0,0 +1,188 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"time"
+
+	openapi "github.com/wozhdeleniye/avito-tech-internship/api"
+)
+
+type adminStatsResponse struct {
+	AssignmentsPerUser map[string]int64 `json:"assignments_per_user"`
+	AssignmentsPerPR   map[string]int64 `json:"assignments_per_pr"`
+	SLABreachesPerTeam map[string]int64 `json:"sla_breaches_per_team"`
+	TimeToMergePerTeam map[string]struct {
+		Count    int     `json:"count"`
+		P50Hours float64 `json:"p50_hours"`
+		P90Hours float64 `json:"p90_hours"`
+		P99Hours float64 `json:"p99_hours"`
+	} `json:"time_to_merge_per_team"`
+	OpenPRAge map[string]int `json:"open_pr_age"`
+}
+
+func adminStats(ctx context.Context, a *app, args []string) error {
+	fs := flag.NewFlagSet("admin stats", flag.ContinueOnError)
+	from := fs.String("from", "", "начало диапазона, RFC3339")
+	to := fs.String("to", "", "конец диапазона, RFC3339")
+	if _, err := parseFlags(fs, args); err != nil {
+		return err
+	}
+
+	query := url.Values{}
+	for name, v := range map[string]string{"from": *from, "to": *to} {
+		if v == "" {
+			continue
+		}
+		if _, err := time.Parse(time.RFC3339, v); err != nil {
+			return fmt.Errorf("%s must be RFC3339 date-time", name)
+		}
+		query.Set(name, v)
+	}
+
+	// в JSON выводится полный ответ, таблица показывает основные разделы
+	var raw map[string]interface{}
+	if a.cfg.Output == outputJSON {
+		if err := a.admin(ctx, http.MethodGet, "/stats", query, nil, &raw); err != nil {
+			return err
+		}
+		return a.render(raw, nil)
+	}
+
+	var stats adminStatsResponse
+	if err := a.admin(ctx, http.MethodGet, "/stats", query, nil, &stats); err != nil {
+		return err
+	}
+	return a.render(stats, func(w io.Writer) {
+		fmt.Fprintln(w, "USER_ID\tOPEN_ASSIGNMENTS")
+		for _, k := range sortedKeys(stats.AssignmentsPerUser) {
+			fmt.Fprintf(w, "%s\t%d\n", k, stats.AssignmentsPerUser[k])
+		}
+		fmt.Fprintln(w, "\nTEAM\tMERGED\tP50_H\tP90_H\tP99_H\tSLA_BREACHES")
+		teams := sortedKeys(stats.TimeToMergePerTeam)
+		for _, k := range sortedKeys(stats.SLABreachesPerTeam) {
+			if _, ok := stats.TimeToMergePerTeam[k]; !ok {
+				teams = append(teams, k)
+			}
+		}
+		for _, k := range teams {
+			p := stats.TimeToMergePerTeam[k]
+			fmt.Fprintf(w, "%s\t%d\t%.1f\t%.1f\t%.1f\t%d\n", k, p.Count, p.P50Hours, p.P90Hours, p.P99Hours, stats.SLABreachesPerTeam[k])
+		}
+		fmt.Fprintln(w, "\nOPEN_PR_AGE\tCOUNT")
+		for _, bucket := range []string{"lt_1d", "1d_3d", "3d_7d", "7d_14d", "gte_14d"} {
+			fmt.Fprintf(w, "%s\t%d\n", bucket, stats.OpenPRAge[bucket])
+		}
+	})
+}
+
+func adminMassDeactivate(ctx context.Context, a *app, args []string) error {
+	fs := flag.NewFlagSet("admin mass-deactivate", flag.ContinueOnError)
+	oldTeam := fs.String("old", "", "команда, участники которой деактивируются")
+	newTeam := fs.String("new", "", "команда, на участников которой переназначаются ревью")
+	dryRun := fs.Bool("dry-run", false, "предпросмотр без сохранения изменений")
+	wait := fs.Bool("wait", false, "дождаться завершения задачи")
+	if _, err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if *oldTeam == "" || *newTeam == "" {
+		return errors.New("usage: prctl admin mass-deactivate -old <team_name> -new <team_name> [-dry-run] [-wait]")
+	}
+
+	var job openapi.Job
+	body := map[string]interface{}{"old_team_name": *oldTeam, "new_team_name": *newTeam, "dry_run": *dryRun}
+	if err := a.admin(ctx, http.MethodPost, "/team/deactivate", nil, body, &job); err != nil {
+		return err
+	}
+	for *wait && (job.Status == openapi.JobStatusPending || job.Status == openapi.JobStatusRunning) {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(time.Second):
+		}
+		if err := a.admin(ctx, http.MethodGet, "/jobs/"+url.PathEscape(job.Id), nil, nil, &job); err != nil {
+			return err
+		}
+	}
+	return a.renderJob(&job)
+}
+
+func adminJob(ctx context.Context, a *app, args []string) error {
+	if len(args) != 1 {
+		return errors.New("usage: prctl admin job <job_id>")
+	}
+	var job openapi.Job
+	if err := a.admin(ctx, http.MethodGet, "/jobs/"+url.PathEscape(args[0]), nil, nil, &job); err != nil {
+		return err
+	}
+	return a.renderJob(&job)
+}
+
+func adminRevert(ctx context.Context, a *app, args []string) error {
+	if len(args) != 1 {
+		return errors.New("usage: prctl admin revert <changeset_id>")
+	}
+	var result openapi.ChangesetRevert
+	if err := a.admin(ctx, http.MethodPost, "/changesets/"+url.PathEscape(args[0])+"/revert", nil, nil, &result); err != nil {
+		return err
+	}
+	return a.render(result, func(w io.Writer) {
+		fmt.Fprintf(w, "changeset:\t%s\n", result.Changeset.Id)
+		fmt.Fprintf(w, "reactivated:\t%s\n", list(result.ReactivatedUsers))
+		fmt.Fprintln(w, "\nPR_ID\tREMOVED\tRESTORED")
+		for _, r := range result.RestoredReviewers {
+			fmt.Fprintf(w, "%s\t%s\t%s\n", r.PullRequestId, r.OldReviewerId, r.NewReviewerId)
+		}
+		if len(result.Conflicts) == 0 {
+			return
+		}
+		fmt.Fprintln(w, "\nCONFLICT\tPR_ID\tUSER_ID")
+		for _, c := range result.Conflicts {
+			fmt.Fprintf(w, "%s\t%s\t%s\n", c.Reason, orDash(c.PullRequestId), orDash(c.UserId))
+		}
+	})
+}
+
+func (a *app) renderJob(job *openapi.Job) error {
+	return a.render(job, func(w io.Writer) {
+		fmt.Fprintf(w, "job:\t%s\n", job.Id)
+		fmt.Fprintf(w, "status:\t%s\n", job.Status)
+		fmt.Fprintf(w, "dry run:\t%t\n", job.DryRun)
+		if job.ChangesetId != nil {
+			fmt.Fprintf(w, "changeset:\t%s\n", *job.ChangesetId)
+		}
+		fmt.Fprintf(w, "progress:\t%d/%d\n", job.Progress.Processed, job.Progress.Total)
+		if job.Error != nil {
+			fmt.Fprintf(w, "error:\t%s\n", *job.Error)
+		}
+		fmt.Fprintf(w, "deactivated:\t%s\n", list(job.Deactivated))
+		fmt.Fprintf(w, "kept active:\t%s\n", list(job.KeptActive))
+		fmt.Fprintln(w, "\nPR_ID\tOLD_REVIEWER\tNEW_REVIEWER\tOUTCOME")
+		for _, o := range job.Outcomes {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", o.PullRequestId, o.OldReviewerId, orDash(o.NewReviewerId), o.Outcome)
+		}
+	})
+}
+
+// отсутствующее значение в таблице - прочерк
+func orDash(s *string) string {
+	if s == nil {
+		return "-"
+	}
+	return *s
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,68 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+)
+
+// настройки prctl: файл конфигурации, поверх него переменные окружения PRCTL_*, поверх них флаги
+type config struct {
+	Server       string `yaml:"server"`
+	Token        string `yaml:"token"`
+	UserID       string `yaml:"user_id"`
+	Organization string `yaml:"organization"`
+	Output       string `yaml:"output"`
+}
+
+const (
+	outputTable = "table"
+	outputJSON  = "json"
+)
+
+func defaultConfigPath() string {
+	if p := os.Getenv("PRCTL_CONFIG"); p != "" {
+		return p
+	}
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "prctl", "config.yaml")
+}
+
+// отсутствующий файл по пути по умолчанию не ошибка, явно указанный - ошибка
+func loadConfig(path string, explicit bool) (config, error) {
+	cfg := config{Server: "http://localhost:8085", Output: outputTable}
+
+	if path != "" {
+		data, err := os.ReadFile(path)
+		switch {
+		case err == nil:
+			if err := yaml.Unmarshal(data, &cfg); err != nil {
+				return cfg, fmt.Errorf("config %s: %w", path, err)
+			}
+		case errors.Is(err, fs.ErrNotExist) && !explicit:
+		default:
+			return cfg, fmt.Errorf("config %s: %w", path, err)
+		}
+	}
+
+	if v := os.Getenv("PRCTL_SERVER"); v != "" {
+		cfg.Server = v
+	}
+	if v := os.Getenv("PRCTL_TOKEN"); v != "" {
+		cfg.Token = v
+	}
+	if v := os.Getenv("PRCTL_USER_ID"); v != "" {
+		cfg.UserID = v
+	}
+	if v := os.Getenv("PRCTL_ORGANIZATION"); v != "" {
+		cfg.Organization = v
+	}
+	return cfg, nil
+}
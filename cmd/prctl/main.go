@@ -0,0 +1,238 @@
+// prctl - консольный клиент сервиса назначения ревьюверов.
+//
+//	prctl [-config path] [-server url] [-token token] [-user id] [-o table|json] <группа> <команда> [флаги]
+//
+// Группы и команды:
+//
+//	team  create|get|sync
+//	user  activate|deactivate
+//	pr    create|merge|reassign|list
+//	admin stats|mass-deactivate
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	openapi "github.com/wozhdeleniye/avito-tech-internship/api"
+)
+
+type app struct {
+	cfg    config
+	http   *http.Client
+	client *openapi.ClientWithResponses
+	out    io.Writer
+}
+
+type command struct {
+	group string
+	name  string
+	usage string
+	run   func(ctx context.Context, a *app, args []string) error
+}
+
+var commands = []command{
+	{"team", "create", "<team_name> [-member user_id:username[:role]]...", teamCreate},
+	{"team", "get", "<team_name>", teamGet},
+	{"team", "sync", "-file team.yaml [-prune] [-dry-run]", teamSync},
+	{"user", "activate", "<user_id>", userActivate},
+	{"user", "deactivate", "[-reassign] <user_id>", userDeactivate},
+	{"pr", "create", "-id <pr_id> -name <name> -author <user_id>", prCreate},
+	{"pr", "merge", "<pr_id>", prMerge},
+	{"pr", "reassign", "-pr <pr_id> -old <user_id>", prReassign},
+	{"pr", "list", "[-status OPEN|MERGED] [-author user_id] [-team team_name] [-limit n] [-all]", prList},
+	{"admin", "stats", "[-from RFC3339] [-to RFC3339]", adminStats},
+	{"admin", "mass-deactivate", "-old <team_name> -new <team_name> [-dry-run] [-wait]", adminMassDeactivate},
+	{"admin", "job", "<job_id>", adminJob},
+	{"admin", "revert", "<changeset_id>", adminRevert},
+}
+
+func findCommand(group string, name string) (command, bool) {
+	for _, c := range commands {
+		if c.group == group && c.name == name {
+			return c, true
+		}
+	}
+	return command{}, false
+}
+
+func main() {
+	global := flag.NewFlagSet("prctl", flag.ExitOnError)
+	configPath := global.String("config", "", "файл конфигурации (по умолчанию $PRCTL_CONFIG или ~/.config/prctl/config.yaml)")
+	server := global.String("server", "", "адрес сервиса")
+	token := global.String("token", "", "токен доступа, передаётся в Authorization: Bearer")
+	userID := global.String("user", "", "инициатор действий для журнала аудита (заголовок User-id)")
+	organization := global.String("org", "", "slug организации (заголовок X-Organization)")
+	output := global.String("o", "", "формат вывода: table или json")
+	global.Usage = func() { usage(global) }
+	_ = global.Parse(os.Args[1:])
+
+	path, explicit := *configPath, *configPath != ""
+	if !explicit {
+		path = defaultConfigPath()
+	}
+	cfg, err := loadConfig(path, explicit)
+	if err != nil {
+		fatal(err)
+	}
+	if *server != "" {
+		cfg.Server = *server
+	}
+	if *token != "" {
+		cfg.Token = *token
+	}
+	if *userID != "" {
+		cfg.UserID = *userID
+	}
+	if *organization != "" {
+		cfg.Organization = *organization
+	}
+	if *output != "" {
+		cfg.Output = *output
+	}
+	if cfg.Output != outputTable && cfg.Output != outputJSON {
+		fatal(fmt.Errorf("output must be table or json"))
+	}
+
+	args := global.Args()
+	if len(args) < 2 {
+		usage(global)
+		os.Exit(2)
+	}
+	cmd, ok := findCommand(args[0], args[1])
+	if !ok {
+		usage(global)
+		os.Exit(2)
+	}
+
+	a, err := newApp(cfg, os.Stdout)
+	if err != nil {
+		fatal(err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	defer cancel()
+	if err := cmd.run(ctx, a, args[2:]); err != nil {
+		fatal(err)
+	}
+}
+
+func newApp(cfg config, out io.Writer) (*app, error) {
+	a := &app{cfg: cfg, http: &http.Client{Timeout: 30 * time.Second}, out: out}
+	client, err := openapi.NewClientWithResponses(strings.TrimRight(cfg.Server, "/")+"/api",
+		openapi.WithHTTPClient(a.http),
+		openapi.WithRequestEditorFn(a.authorize))
+	if err != nil {
+		return nil, err
+	}
+	a.client = client
+	return a, nil
+}
+
+func (a *app) authorize(ctx context.Context, req *http.Request) error {
+	if a.cfg.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+a.cfg.Token)
+	}
+	if a.cfg.UserID != "" {
+		req.Header.Set("User-id", a.cfg.UserID)
+	}
+	if a.cfg.Organization != "" {
+		req.Header.Set("X-Organization", a.cfg.Organization)
+	}
+	return nil
+}
+
+// вызов эндпоинтов /api/admin, которых нет в openapi.yml
+func (a *app) admin(ctx context.Context, method string, path string, query url.Values, body interface{}, out interface{}) error {
+	u := strings.TrimRight(a.cfg.Server, "/") + "/api/admin" + path
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+	var reader io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(b)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, u, reader)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	_ = a.authorize(ctx, req)
+
+	res, err := a.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	data, _ := io.ReadAll(res.Body)
+	if res.StatusCode >= 300 {
+		return apiError(res.StatusCode, data)
+	}
+	return json.Unmarshal(data, out)
+}
+
+// печатает v в JSON или таблицей; table пишет строки через табуляцию, первая строка - заголовок
+func (a *app) render(v interface{}, table func(w io.Writer)) error {
+	if a.cfg.Output == outputJSON {
+		enc := json.NewEncoder(a.out)
+		enc.SetIndent("", "  ")
+		return enc.Encode(v)
+	}
+	tw := tabwriter.NewWriter(a.out, 0, 4, 2, ' ', 0)
+	table(tw)
+	return tw.Flush()
+}
+
+// ошибка сервиса в виде "CODE: message (HTTP status)"
+func apiError(status int, body []byte) error {
+	var resp openapi.ErrorResponse
+	if err := json.Unmarshal(body, &resp); err == nil && resp.Error.Code != "" {
+		return fmt.Errorf("%s: %s (HTTP %d)", resp.Error.Code, resp.Error.Message, status)
+	}
+	return fmt.Errorf("HTTP %d: %s", status, strings.TrimSpace(string(body)))
+}
+
+func usage(global *flag.FlagSet) {
+	w := global.Output()
+	fmt.Fprintln(w, "usage: prctl [global flags] <group> <command> [flags]")
+	fmt.Fprintln(w, "\nglobal flags:")
+	global.PrintDefaults()
+	fmt.Fprintln(w, "\ncommands:")
+	for _, c := range commands {
+		fmt.Fprintf(w, "  %s %s %s\n", c.group, c.name, c.usage)
+	}
+}
+
+// разбирает флаги подкоманды; флаги и позиционные аргументы можно чередовать
+func parseFlags(fs *flag.FlagSet, args []string) ([]string, error) {
+	positional := make([]string, 0)
+	for {
+		if err := fs.Parse(args); err != nil {
+			return nil, err
+		}
+		args = fs.Args()
+		if len(args) == 0 {
+			return positional, nil
+		}
+		positional = append(positional, args[0])
+		args = args[1:]
+	}
+}
+
+func fatal(err error) {
+	fmt.Fprintln(os.Stderr, "prctl:", err)
+	os.Exit(1)
+}
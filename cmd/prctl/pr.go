@@ -0,0 +1,150 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	openapi "github.com/wozhdeleniye/avito-tech-internship/api"
+)
+
+func prCreate(ctx context.Context, a *app, args []string) error {
+	fs := flag.NewFlagSet("pr create", flag.ContinueOnError)
+	id := fs.String("id", "", "идентификатор PR")
+	name := fs.String("name", "", "название PR")
+	author := fs.String("author", "", "user_id автора")
+	if _, err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if *id == "" || *name == "" || *author == "" {
+		return errors.New("usage: prctl pr create -id <pr_id> -name <name> -author <user_id>")
+	}
+
+	res, err := a.client.PostPullRequestCreateWithResponse(ctx, openapi.PostPullRequestCreateJSONRequestBody{
+		PullRequestId:   *id,
+		PullRequestName: *name,
+		AuthorId:        *author,
+	})
+	if err != nil {
+		return err
+	}
+	if res.JSON201 == nil || res.JSON201.Pr == nil {
+		return apiError(res.StatusCode(), res.Body)
+	}
+	return a.renderPullRequest(res.JSON201.Pr)
+}
+
+func prMerge(ctx context.Context, a *app, args []string) error {
+	if len(args) != 1 {
+		return errors.New("usage: prctl pr merge <pr_id>")
+	}
+	res, err := a.client.PostPullRequestMergeWithResponse(ctx, openapi.PostPullRequestMergeJSONRequestBody{PullRequestId: args[0]})
+	if err != nil {
+		return err
+	}
+	if res.JSON200 == nil || res.JSON200.Pr == nil {
+		return apiError(res.StatusCode(), res.Body)
+	}
+	return a.renderPullRequest(res.JSON200.Pr)
+}
+
+func prReassign(ctx context.Context, a *app, args []string) error {
+	fs := flag.NewFlagSet("pr reassign", flag.ContinueOnError)
+	pr := fs.String("pr", "", "идентификатор PR")
+	old := fs.String("old", "", "user_id заменяемого ревьювера")
+	if _, err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if *pr == "" || *old == "" {
+		return errors.New("usage: prctl pr reassign -pr <pr_id> -old <user_id>")
+	}
+
+	res, err := a.client.PostPullRequestReassignWithResponse(ctx, openapi.PostPullRequestReassignJSONRequestBody{PullRequestId: *pr, OldUserId: *old})
+	if err != nil {
+		return err
+	}
+	if res.JSON200 == nil {
+		return apiError(res.StatusCode(), res.Body)
+	}
+
+	result := res.JSON200
+	return a.render(result, func(w io.Writer) {
+		printPullRequest(w, &result.Pr)
+		fmt.Fprintf(w, "replaced:\t%s -> %s\n", *old, result.ReplacedBy)
+	})
+}
+
+// список PR с фильтрами; с -all проходит по всем страницам курсора
+func prList(ctx context.Context, a *app, args []string) error {
+	fs := flag.NewFlagSet("pr list", flag.ContinueOnError)
+	status := fs.String("status", "", "OPEN или MERGED")
+	author := fs.String("author", "", "user_id автора")
+	team := fs.String("team", "", "команда автора")
+	limit := fs.Int("limit", 20, "размер страницы")
+	all := fs.Bool("all", false, "загрузить все страницы")
+	if _, err := parseFlags(fs, args); err != nil {
+		return err
+	}
+
+	params := &openapi.GetPullRequestListParams{Limit: limit}
+	if *status != "" {
+		s := openapi.PullRequestStatusFilter(strings.ToUpper(*status))
+		params.Status = &s
+	}
+	if *author != "" {
+		params.AuthorId = author
+	}
+	if *team != "" {
+		params.TeamName = team
+	}
+
+	prs := make([]openapi.PullRequestShort, 0)
+	for {
+		res, err := a.client.GetPullRequestListWithResponse(ctx, params)
+		if err != nil {
+			return err
+		}
+		if res.JSON200 == nil {
+			return apiError(res.StatusCode(), res.Body)
+		}
+		prs = append(prs, res.JSON200.PullRequests...)
+		if !*all || res.JSON200.NextCursor == nil {
+			break
+		}
+		params.Cursor = res.JSON200.NextCursor
+	}
+
+	return a.render(prs, func(w io.Writer) {
+		fmt.Fprintln(w, "PR_ID\tNAME\tAUTHOR\tSTATUS\tCREATED")
+		for _, pr := range prs {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", pr.PullRequestId, pr.PullRequestName, pr.AuthorId, pr.Status, formatTime(pr.CreatedAt))
+		}
+	})
+}
+
+func (a *app) renderPullRequest(pr *openapi.PullRequest) error {
+	return a.render(pr, func(w io.Writer) { printPullRequest(w, pr) })
+}
+
+func printPullRequest(w io.Writer, pr *openapi.PullRequest) {
+	fmt.Fprintf(w, "pr:\t%s\n", pr.PullRequestId)
+	fmt.Fprintf(w, "name:\t%s\n", pr.PullRequestName)
+	fmt.Fprintf(w, "author:\t%s\n", pr.AuthorId)
+	fmt.Fprintf(w, "status:\t%s\n", pr.Status)
+	fmt.Fprintf(w, "reviewers:\t%s\n", list(pr.AssignedReviewers))
+	fmt.Fprintf(w, "created:\t%s\n", formatTime(pr.CreatedAt))
+	if pr.MergedAt != nil {
+		fmt.Fprintf(w, "merged:\t%s\n", formatTime(pr.MergedAt))
+	}
+}
+
+func formatTime(t *time.Time) string {
+	if t == nil {
+		return "-"
+	}
+	return t.UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,150 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	openapi "github.com/wozhdeleniye/avito-tech-internship/api"
+	"gopkg.in/yaml.v3"
+)
+
+func teamCreate(ctx context.Context, a *app, args []string) error {
+	fs := flag.NewFlagSet("team create", flag.ContinueOnError)
+	members := make([]openapi.TeamMember, 0)
+	fs.Func("member", "участник в виде user_id:username[:role], флаг можно повторять", func(v string) error {
+		parts := strings.Split(v, ":")
+		if len(parts) < 2 || len(parts) > 3 || parts[0] == "" || parts[1] == "" {
+			return errors.New("member must be user_id:username[:role]")
+		}
+		m := openapi.TeamMember{UserId: parts[0], Username: parts[1], IsActive: true}
+		if len(parts) == 3 {
+			role := openapi.TeamMemberRole(parts[2])
+			m.Role = &role
+		}
+		members = append(members, m)
+		return nil
+	})
+	rest, err := parseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(rest) != 1 {
+		return errors.New("usage: prctl team create <team_name> [-member user_id:username[:role]]...")
+	}
+
+	return a.syncTeam(ctx, openapi.Team{TeamName: rest[0], Members: members}, false, false)
+}
+
+func teamGet(ctx context.Context, a *app, args []string) error {
+	if len(args) != 1 {
+		return errors.New("usage: prctl team get <team_name>")
+	}
+	res, err := a.client.GetTeamGetWithResponse(ctx, &openapi.GetTeamGetParams{TeamName: args[0]})
+	if err != nil {
+		return err
+	}
+	if res.JSON200 == nil {
+		return apiError(res.StatusCode(), res.Body)
+	}
+	return a.render(res.JSON200, func(w io.Writer) { printTeam(w, res.JSON200) })
+}
+
+// синхронизирует команду из файла YAML или JSON в формате тела /team/add
+func teamSync(ctx context.Context, a *app, args []string) error {
+	fs := flag.NewFlagSet("team sync", flag.ContinueOnError)
+	file := fs.String("file", "", "файл с командой (YAML или JSON)")
+	prune := fs.Bool("prune", false, "исключить участников, не перечисленных в файле")
+	dryRun := fs.Bool("dry-run", false, "показать изменения без сохранения")
+	if _, err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if *file == "" {
+		return errors.New("usage: prctl team sync -file team.yaml [-prune] [-dry-run]")
+	}
+
+	data, err := os.ReadFile(*file)
+	if err != nil {
+		return err
+	}
+	// YAML приводится к JSON, чтобы использовать json-теги сгенерированных типов
+	var raw interface{}
+	if err := yaml.Unmarshal(data, &raw); err != nil {
+		return fmt.Errorf("%s: %w", *file, err)
+	}
+	b, err := json.Marshal(raw)
+	if err != nil {
+		return fmt.Errorf("%s: %w", *file, err)
+	}
+	var team openapi.Team
+	if err := json.Unmarshal(b, &team); err != nil {
+		return fmt.Errorf("%s: %w", *file, err)
+	}
+
+	return a.syncTeam(ctx, team, *prune, *dryRun)
+}
+
+func (a *app) syncTeam(ctx context.Context, team openapi.Team, prune bool, dryRun bool) error {
+	res, err := a.client.PostTeamAddWithResponse(ctx, &openapi.PostTeamAddParams{Prune: &prune, DryRun: &dryRun}, team)
+	if err != nil {
+		return err
+	}
+	result := res.JSON201
+	if result == nil {
+		result = res.JSON200
+	}
+	if result == nil {
+		return apiError(res.StatusCode(), res.Body)
+	}
+
+	return a.render(result, func(w io.Writer) {
+		printTeam(w, &result.Team)
+		d := result.Diff
+		fmt.Fprintf(w, "\nteam created:\t%t\n", d.TeamCreated)
+		fmt.Fprintf(w, "users created:\t%s\n", list(d.Created))
+		updated := make([]string, 0, len(d.Updated))
+		for _, u := range d.Updated {
+			fields := make([]string, 0, len(u.ChangedFields))
+			for _, f := range u.ChangedFields {
+				fields = append(fields, string(f))
+			}
+			updated = append(updated, u.UserId+"("+strings.Join(fields, ",")+")")
+		}
+		fmt.Fprintf(w, "users updated:\t%s\n", list(updated))
+		fmt.Fprintf(w, "attached:\t%s\n", list(d.Attached))
+		fmt.Fprintf(w, "detached:\t%s\n", list(d.Detached))
+		if dryRun {
+			fmt.Fprintln(w, "dry run:\tизменения не сохранены")
+		}
+	})
+}
+
+func printTeam(w io.Writer, team *openapi.Team) {
+	fmt.Fprintf(w, "team:\t%s\n", team.TeamName)
+	if team.Sla != nil {
+		fmt.Fprintf(w, "sla:\t%dh, %s\n", team.Sla.FirstReviewHours, team.Sla.Action)
+	}
+	fmt.Fprintln(w, "\nUSER_ID\tUSERNAME\tACTIVE\tROLE\tPRIMARY")
+	for _, m := range team.Members {
+		role, primary := "", ""
+		if m.Role != nil {
+			role = string(*m.Role)
+		}
+		if m.IsPrimary != nil {
+			primary = fmt.Sprint(*m.IsPrimary)
+		}
+		fmt.Fprintf(w, "%s\t%s\t%t\t%s\t%s\n", m.UserId, m.Username, m.IsActive, role, primary)
+	}
+}
+
+func list(values []string) string {
+	if len(values) == 0 {
+		return "-"
+	}
+	return strings.Join(values, ", ")
+}
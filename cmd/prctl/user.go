@@ -0,0 +1,57 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+
+	openapi "github.com/wozhdeleniye/avito-tech-internship/api"
+)
+
+func userActivate(ctx context.Context, a *app, args []string) error {
+	if len(args) != 1 {
+		return errors.New("usage: prctl user activate <user_id>")
+	}
+	return a.setUserActive(ctx, openapi.PostUsersSetIsActiveJSONRequestBody{UserId: args[0], IsActive: true})
+}
+
+func userDeactivate(ctx context.Context, a *app, args []string) error {
+	fs := flag.NewFlagSet("user deactivate", flag.ContinueOnError)
+	reassign := fs.Bool("reassign", false, "переназначить открытые ревью пользователя")
+	rest, err := parseFlags(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(rest) != 1 {
+		return errors.New("usage: prctl user deactivate [-reassign] <user_id>")
+	}
+	return a.setUserActive(ctx, openapi.PostUsersSetIsActiveJSONRequestBody{UserId: rest[0], IsActive: false, ReassignReviews: reassign})
+}
+
+func (a *app) setUserActive(ctx context.Context, body openapi.PostUsersSetIsActiveJSONRequestBody) error {
+	res, err := a.client.PostUsersSetIsActiveWithResponse(ctx, body)
+	if err != nil {
+		return err
+	}
+	if res.JSON200 == nil {
+		return apiError(res.StatusCode(), res.Body)
+	}
+
+	change := res.JSON200
+	user := change.User
+	return a.render(change, func(w io.Writer) {
+		fmt.Fprintln(w, "USER_ID\tUSERNAME\tTEAM\tACTIVE")
+		fmt.Fprintf(w, "%s\t%s\t%s\t%t\n", user.UserId, user.Username, user.TeamName, user.IsActive)
+		if body.ReassignReviews == nil || !*body.ReassignReviews {
+			return
+		}
+		fmt.Fprintln(w, "\nPR_ID\tNEW_REVIEWER")
+		for _, r := range change.Reassignments {
+			fmt.Fprintf(w, "%s\t%s\n", r.PullRequestId, r.NewReviewerId)
+		}
+		fmt.Fprintf(w, "\nnot reassigned:\t%s\n", list(change.NotReassigned))
+		fmt.Fprintf(w, "changeset:\t%s\n", orDash(change.ChangesetId))
+	})
+}
@@ -1,14 +1,26 @@
 package main
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"log"
+	"net"
 	"net/http"
+	"os"
+	"os/signal"
 	"strings"
+	"syscall"
+	"time"
 
+	"github.com/wozhdeleniye/avito-tech-internship/internal/app/graphqlapi"
+	"github.com/wozhdeleniye/avito-tech-internship/internal/app/grpcserver"
 	"github.com/wozhdeleniye/avito-tech-internship/internal/app/router"
 	"github.com/wozhdeleniye/avito-tech-internship/internal/config"
+	"github.com/wozhdeleniye/avito-tech-internship/internal/pkg/clock"
 	"github.com/wozhdeleniye/avito-tech-internship/internal/pkg/db/database"
+	"github.com/wozhdeleniye/avito-tech-internship/internal/pkg/db/redis"
+	"github.com/wozhdeleniye/avito-tech-internship/internal/pkg/ratelimit"
 	"github.com/wozhdeleniye/avito-tech-internship/internal/repo/migrations"
 	postgresrepository "github.com/wozhdeleniye/avito-tech-internship/internal/repo/repositories/postgres_repository"
 	"github.com/wozhdeleniye/avito-tech-internship/internal/services"
@@ -41,25 +53,140 @@ func main() {
 		sqlDB.Close()
 	}()
 
-	migrator := migrations.NewGormMigrator(db)
-	migrator.Migrate()
+	if cfg.Database.ResetOnStart {
+		log.Println("DB_RESET_ON_START: все таблицы будут пересозданы")
+	}
+	migrator := migrations.NewGormMigrator(db, cfg.Database.ResetOnStart)
+	if err := migrator.Migrate(); err != nil {
+		log.Fatal("Failed to migrate database:", err)
+	}
 
 	userRepo := postgresrepository.NewUserRepository(db)
 	teamRepo := postgresrepository.NewTeamRepository(db)
 	prRepo := postgresrepository.NewPReqRepository(db)
+	auditRepo := postgresrepository.NewAuditRepository(db)
+	slaRepo := postgresrepository.NewSLARepository(db)
+	jobRepo := postgresrepository.NewJobRepository(db)
+	changesetRepo := postgresrepository.NewChangesetRepository(db)
+	repositoryRepo := postgresrepository.NewRepositoryRepository(db)
+	organizationRepo := postgresrepository.NewOrganizationRepository(db)
+	idempotencyRepo := postgresrepository.NewIdempotencyRepository(db)
+	txManager := postgresrepository.NewTxManager(db)
+
+	// SIGINT и SIGTERM останавливают фоновые задачи и сервер
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	// события о назначениях для /events/stream публикуются сервисами после фиксации изменений
+	eventBus := services.NewEventBus(cfg.Events.BufferSize)
+
+	auditService := services.NewAuditService(auditRepo, prRepo)
+	teamService := services.NewTeamService(prRepo, teamRepo, userRepo, changesetRepo, txManager, auditService, eventBus)
+	prService := services.NewPReqService(prRepo, teamRepo, userRepo, repositoryRepo, txManager, auditService, eventBus)
+
+	slaService := services.NewSLAService(slaRepo, organizationRepo, prService, auditService, txManager, clock.Real{}, services.NewEventNotifier(eventBus, prRepo))
+
+	go slaService.Run(ctx, cfg.SLA.ScanInterval)
+
+	statsService := services.NewStatsService(prRepo, auditRepo, clock.Real{})
+
+	exportService := services.NewExportService(prRepo, auditRepo, slaRepo, statsService)
+
+	importService := services.NewImportService(teamService, teamRepo, txManager)
+
+	// задачи, прерванные остановкой сервиса, продолжаются с первой необработанной пачки
+	jobService := services.NewJobService(jobRepo, organizationRepo, prRepo, teamRepo, userRepo, changesetRepo, txManager, auditService, eventBus, cfg.Jobs.BatchSize)
+
+	go jobService.Run(ctx, cfg.Jobs.PollInterval)
+
+	changesetService := services.NewChangesetService(changesetRepo, prRepo, userRepo, txManager, auditService, eventBus)
+
+	repositoryService := services.NewRepositoryService(repositoryRepo, teamRepo)
+
+	// без ACCESS_TOKEN_SECRET токены не проверяются, организация берётся из заголовка X-Organization
+	organizationService := services.NewOrganizationService(organizationRepo, cfg.JWT.AccessTokenSecret)
 
-	teamService := services.NewTeamService(prRepo, teamRepo, userRepo)
-	prService := services.NewPReqService(prRepo, teamRepo, userRepo)
+	idempotencyService := services.NewIdempotencyService(idempotencyRepo, cfg.Idempotency.TTL, cfg.Idempotency.LockTTL, clock.Real{})
 
-	r := router.NewApp(prService, teamService)
+	graphQL := graphqlapi.New(userRepo, teamRepo, prRepo, prService)
+
+	// в памяти корзины у каждой реплики свои, при нескольких репликах нужен RATE_LIMIT_BACKEND=redis
+	var rateLimiter *router.RateLimiter
+	switch cfg.RateLimit.Backend {
+	case "off":
+	case "redis":
+		redisClient, err := redis.NewRedisConnection(cfg.Redis.Host, cfg.Redis.Port, cfg.Redis.Password, cfg.Redis.DB)
+		if err != nil {
+			log.Fatal("Failed to connect to redis:", err)
+		}
+		defer redisClient.Close()
+		rateLimiter = router.NewRateLimiter(ratelimit.NewRedisStore(redisClient), cfg.RateLimit, cfg.JWT.AccessTokenSecret)
+	default:
+		rateLimiter = router.NewRateLimiter(ratelimit.NewMemoryStore(clock.Real{}), cfg.RateLimit, cfg.JWT.AccessTokenSecret)
+	}
+
+	r := router.NewApp(prService, teamService, auditService, slaService, statsService, exportService, importService, jobService, changesetService, repositoryService, organizationService, idempotencyService, eventBus, graphQL, rateLimiter)
 
 	addr := cfg.Server.Port
 	if !strings.HasPrefix(addr, ":") {
 		addr = ":" + addr
 	}
 
+	srv := &http.Server{Addr: addr, Handler: r}
+	// потоки SSE и gRPC не завершаются сами, поэтому при остановке их подписки закрываются первыми
+	srv.RegisterOnShutdown(eventBus.Close)
+
+	// gRPC API на отдельном порту вызывает те же сервисы, что и REST
+	grpcServer := grpcserver.New(&grpcserver.Server{
+		PRService:    prService,
+		TeamService:  teamService,
+		SLAService:   slaService,
+		StatsService: statsService,
+		Events:       eventBus,
+	}, organizationService)
+	if cfg.GRPC.Port != "off" {
+		grpcAddr := cfg.GRPC.Port
+		if !strings.HasPrefix(grpcAddr, ":") {
+			grpcAddr = ":" + grpcAddr
+		}
+		lis, err := net.Listen("tcp", grpcAddr)
+		if err != nil {
+			log.Fatalf("grpc listen: %v", err)
+		}
+		log.Printf("Starting gRPC server on %s", grpcAddr)
+		go func() {
+			if err := grpcServer.Serve(lis); err != nil {
+				log.Printf("grpc server stopped: %v", err)
+			}
+		}()
+	}
+
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		if err := srv.Shutdown(shutdownCtx); err != nil {
+			log.Printf("server shutdown: %v", err)
+		}
+		// GracefulStop ждёт завершения вызовов; не успевшие к сроку обрываются
+		grpcStopped := make(chan struct{})
+		go func() {
+			grpcServer.GracefulStop()
+			close(grpcStopped)
+		}()
+		select {
+		case <-grpcStopped:
+		case <-shutdownCtx.Done():
+			grpcServer.Stop()
+		}
+	}()
+
 	log.Printf("Starting server on %s", addr)
-	if err := http.ListenAndServe(addr, r); err != nil {
+	if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
 		log.Fatalf("server stopped: %v", err)
 	}
+	<-stopped
+	log.Printf("Server stopped")
 }
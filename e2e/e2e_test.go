@@ -2,14 +2,30 @@ package e2e
 
 import (
 	"bytes"
+	"context"
+	"encoding/csv"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"maps"
 	"math/rand"
 	"net/http"
 	"os"
+	"slices"
+	"strings"
 	"testing"
 	"time"
+
+	openapi "github.com/wozhdeleniye/avito-tech-internship/api"
+	"github.com/wozhdeleniye/avito-tech-internship/api/reviewerpb"
+	"github.com/wozhdeleniye/avito-tech-internship/pkg/client"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
 func baseURL() string {
@@ -20,6 +36,16 @@ func baseURL() string {
 	return "http://localhost:8085"
 }
 
+func newClient(t *testing.T) *client.Client {
+	t.Helper()
+	c, err := client.New(baseURL())
+	if err != nil {
+		t.Fatalf("не удалось создать клиент: %v", err)
+	}
+	return c
+}
+
+// запросы к /api/admin, для которых в SDK нет обёрток
 func postJSON(t *testing.T, path string, body interface{}) (*http.Response, []byte) {
 	t.Helper()
 	b, err := json.Marshal(body)
@@ -48,93 +74,102 @@ func get(t *testing.T, path string) (*http.Response, []byte) {
 	return res, data
 }
 
+// ждёт завершения фоновой задачи по ответу 202 на её постановку
+func waitJob(t *testing.T, res *http.Response, data []byte) openapi.Job {
+	t.Helper()
+	if res.StatusCode != http.StatusAccepted {
+		t.Fatalf("постановка задачи ожидала 202, получено %d: %s", res.StatusCode, string(data))
+	}
+	location := res.Header.Get("Location")
+
+	var job openapi.Job
+	deadline := time.Now().Add(10 * time.Second)
+	for {
+		if err := json.Unmarshal(data, &job); err != nil {
+			t.Fatalf("ошибка разбора задачи: %v; тело: %s", err, string(data))
+		}
+		if job.Status == openapi.JobStatusSucceeded {
+			return job
+		}
+		if job.Status == openapi.JobStatusFailed || time.Now().After(deadline) {
+			t.Fatalf("задача не завершилась успешно: %s", string(data))
+		}
+		time.Sleep(100 * time.Millisecond)
+		res, data = get(t, location)
+		if res.StatusCode != http.StatusOK {
+			t.Fatalf("GET %s ожидался 200, получено %d: %s", location, res.StatusCode, string(data))
+		}
+	}
+}
+
 func uniqueName(prefix string) string {
 	return fmt.Sprintf("%s-%d-%d", prefix, time.Now().UnixNano(), rand.Intn(1000))
 }
 
 func TestCreateTeamAndGet(t *testing.T) {
 	rand.Seed(time.Now().UnixNano())
+	ctx := context.Background()
+	c := newClient(t)
 	team := uniqueName("e2e-team")
-	member := map[string]interface{}{"user_id": team + "-u1", "username": "u1", "is_active": true}
-	body := map[string]interface{}{"team_name": team, "members": []interface{}{member}}
+	members := []openapi.TeamMember{{UserId: team + "-u1", Username: "u1", IsActive: true}}
 
-	res, data := postJSON(t, "/api/team/add", body)
-	if res.StatusCode != http.StatusCreated && res.StatusCode != http.StatusOK {
-		t.Fatalf("ожидался статус 201/200, получено %d: %s", res.StatusCode, string(data))
+	if _, err := c.SyncTeam(ctx, openapi.Team{TeamName: team, Members: members}, client.SyncOptions{}); err != nil {
+		t.Fatalf("создание команды не удалось: %v", err)
 	}
 
-	res, data = get(t, "/api/team/get?team_name="+team)
-	if res.StatusCode != http.StatusOK {
-		t.Fatalf("GET команды ожидался 200, получено %d: %s", res.StatusCode, string(data))
+	got, err := c.GetTeam(ctx, team)
+	if err != nil {
+		t.Fatalf("GET команды не удался: %v", err)
+	}
+	if got.TeamName != team || len(got.Members) != 1 {
+		t.Fatalf("неожиданная команда: %+v", got)
 	}
 }
 
 func TestCreatePRAndAssign(t *testing.T) {
 	rand.Seed(time.Now().UnixNano())
+	ctx := context.Background()
+	c := newClient(t)
 	team := uniqueName("e2e-team-pr")
-	members := []interface{}{}
-	for i := 0; i < 3; i++ {
-		members = append(members, map[string]interface{}{"user_id": fmt.Sprintf("%s-u%d", team, i), "username": fmt.Sprintf("u%d", i), "is_active": true})
-	}
-	body := map[string]interface{}{"team_name": team, "members": members}
-	res, data := postJSON(t, "/api/team/add", body)
-	if res.StatusCode != http.StatusCreated && res.StatusCode != http.StatusOK {
-		t.Fatalf("создание команды не удалось: %d %s", res.StatusCode, string(data))
-	}
+	ids := createTeam(t, team, 3)
 
-	author := fmt.Sprintf("%s-u0", team)
-	prBody := map[string]interface{}{"author_id": author, "pull_request_id": uniqueName("pr"), "pull_request_name": "e2e-pr"}
-	res, data = postJSON(t, "/api/pullRequest/create", prBody)
-	if res.StatusCode != http.StatusCreated {
-		t.Fatalf("создание PR ожидалось 201, получено %d: %s", res.StatusCode, string(data))
+	prID := uniqueName("pr")
+	pr, err := c.CreatePullRequest(ctx, prID, "e2e-pr", ids[0])
+	if err != nil {
+		t.Fatalf("создание PR не удалось: %v", err)
+	}
+	if pr.PullRequestId != prID || pr.Status != openapi.PullRequestStatusOPEN || pr.CreatedAt == nil {
+		t.Fatalf("неожиданный PR: %+v", pr)
 	}
 
-	var resp map[string]interface{}
-	if err := json.Unmarshal(data, &resp); err != nil {
-		t.Fatalf("ошибка разбора ответа при создании PR: %v; тело: %s", err, string(data))
+	if _, err := c.CreatePullRequest(ctx, prID, "e2e-pr", ids[0]); !errors.Is(err, client.ErrPRExists) {
+		t.Fatalf("повторное создание PR ожидало PR_EXISTS, получено: %v", err)
 	}
 }
 
 func createTeam(t *testing.T, team string, n int) []string {
-	members := make([]interface{}, 0, n)
+	t.Helper()
+	members := make([]openapi.TeamMember, 0, n)
 	ids := make([]string, 0, n)
 	for i := 0; i < n; i++ {
 		uid := fmt.Sprintf("%s-u%d", team, i)
-		members = append(members, map[string]interface{}{"user_id": uid, "username": fmt.Sprintf("u%d", i), "is_active": true})
+		members = append(members, openapi.TeamMember{UserId: uid, Username: fmt.Sprintf("u%d", i), IsActive: true})
 		ids = append(ids, uid)
 	}
-	body := map[string]interface{}{"team_name": team, "members": members}
-	res, data := postJSON(t, "/api/team/add", body)
-	if res.StatusCode != http.StatusCreated && res.StatusCode != http.StatusOK {
-		t.Fatalf("создание команды не удалось: %d %s", res.StatusCode, string(data))
+	if _, err := newClient(t).SyncTeam(context.Background(), openapi.Team{TeamName: team, Members: members}, client.SyncOptions{}); err != nil {
+		t.Fatalf("создание команды не удалось: %v", err)
 	}
 	return ids
 }
 
 func createPR(t *testing.T, author string) (string, []string) {
+	t.Helper()
 	prID := uniqueName("pr")
-	prBody := map[string]interface{}{"author_id": author, "pull_request_id": prID, "pull_request_name": "e2e-pr"}
-	res, data := postJSON(t, "/api/pullRequest/create", prBody)
-	if res.StatusCode != http.StatusCreated {
-		t.Fatalf("create pr expected 201 got %d: %s", res.StatusCode, string(data))
-	}
-	var body map[string]map[string]interface{}
-	if err := json.Unmarshal(data, &body); err != nil {
-		t.Fatalf("ошибка разбора ответа при создании PR: %v; тело: %s", err, string(data))
-	}
-	prObj, ok := body["pr"]
-	if !ok {
-		t.Fatalf("ответ не содержит поле 'pr': %s", string(data))
-	}
-	assigned := []string{}
-	if ar, ok := prObj["assigned_reviewers"].([]interface{}); ok {
-		for _, v := range ar {
-			if s, ok := v.(string); ok {
-				assigned = append(assigned, s)
-			}
-		}
+	pr, err := newClient(t).CreatePullRequest(context.Background(), prID, "e2e-pr", author)
+	if err != nil {
+		t.Fatalf("создание PR не удалось: %v", err)
 	}
-	return prID, assigned
+	return prID, pr.AssignedReviewers
 }
 
 func TestMergePullRequest(t *testing.T) {
@@ -143,23 +178,18 @@ func TestMergePullRequest(t *testing.T) {
 	author := ids[0]
 	prID, _ := createPR(t, author)
 
-	res, data := postJSON(t, "/api/pullRequest/merge", map[string]interface{}{"pull_request_id": prID})
-	if res.StatusCode != http.StatusOK {
-		t.Fatalf("слияние ожидалось 200, получено %d: %s", res.StatusCode, string(data))
-	}
-	var b map[string]map[string]interface{}
-	if err := json.Unmarshal(data, &b); err == nil {
-		if pr, ok := b["pr"]; ok {
-			if status, ok := pr["status"].(string); ok {
-				if status != "MERGED" {
-					t.Fatalf("ожидался статус MERGED, получен %s", status)
-				}
-			}
-		}
+	pr, err := newClient(t).MergePullRequest(context.Background(), prID)
+	if err != nil {
+		t.Fatalf("слияние не удалось: %v", err)
+	}
+	if pr.Status != openapi.PullRequestStatusMERGED {
+		t.Fatalf("ожидался статус MERGED, получен %s", pr.Status)
 	}
 }
 
 func TestReassignReviewerAndGetReview(t *testing.T) {
+	ctx := context.Background()
+	c := newClient(t)
 	team := uniqueName("e2e-reassign-team")
 	ids := createTeam(t, team, 6)
 	author := ids[0]
@@ -169,59 +199,112 @@ func TestReassignReviewerAndGetReview(t *testing.T) {
 	}
 	oldReviewer := assigned[0]
 
-	res, data := postJSON(t, "/api/pullRequest/reassign", map[string]interface{}{"pull_request_id": prID, "old_user_id": oldReviewer})
-	if res.StatusCode != http.StatusOK {
-		t.Fatalf("переназначение ожидалось 200, получено %d: %s", res.StatusCode, string(data))
+	_, replacedBy, err := c.ReassignReviewer(ctx, prID, oldReviewer)
+	if err != nil {
+		t.Fatalf("переназначение не удалось: %v", err)
 	}
-	var resp map[string]interface{}
-	if err := json.Unmarshal(data, &resp); err != nil {
-		t.Fatalf("ошибка разбора ответа переназначения: %v; тело: %s", err, string(data))
+	if replacedBy == "" || replacedBy == oldReviewer {
+		t.Fatalf("неожиданный новый ревьювер: %q", replacedBy)
 	}
-	if _, ok := resp["new_reviewer_id"]; !ok {
+
+	if _, _, err := c.ReassignReviewer(ctx, prID, oldReviewer); !errors.Is(err, client.ErrNotAssigned) {
+		t.Fatalf("повторное переназначение ожидало NOT_ASSIGNED, получено: %v", err)
 	}
 
-	checkUser := oldReviewer
-	res2, data2 := get(t, "/api/users/getReview?user_id="+checkUser)
-	if res2.StatusCode != http.StatusOK {
-		t.Fatalf("getReview ожидался 200, получено %d: %s", res2.StatusCode, string(data2))
+	for _, err := range c.UserReviews(ctx, openapi.GetUsersGetReviewParams{UserId: oldReviewer}) {
+		if err != nil {
+			t.Fatalf("getReview не удался: %v", err)
+		}
 	}
 }
 
 func TestSetUserIsActive(t *testing.T) {
+	ctx := context.Background()
+	c := newClient(t)
 	team := uniqueName("e2e-setactive-team")
 	ids := createTeam(t, team, 3)
 	user := ids[1]
 
-	res, data := postJSON(t, "/api/users/setIsActive", map[string]interface{}{"user_id": user, "is_active": false})
-	if res.StatusCode != http.StatusOK {
-		t.Fatalf("setIsActive ожидался 200, получено %d: %s", res.StatusCode, string(data))
-	}
-
-	res2, data2 := get(t, "/api/team/get?team_name="+team)
-	if res2.StatusCode != http.StatusOK {
-		t.Fatalf("GET команды ожидался 200, получено %d: %s", res2.StatusCode, string(data2))
-	}
-	var teamResp map[string]interface{}
-	if err := json.Unmarshal(data2, &teamResp); err != nil {
-		t.Fatalf("ошибка разбора команды: %v", err)
-	}
-	if members, ok := teamResp["members"].([]interface{}); ok {
-		found := false
-		for _, m := range members {
-			if mm, ok := m.(map[string]interface{}); ok {
-				if mm["user_id"] == user {
-					found = true
-					if active, ok := mm["is_active"].(bool); ok {
-						if active {
-							t.Fatalf("ожидалось, что пользователь %s будет неактивен", user)
-						}
-					}
-				}
+	updated, err := c.SetUserActive(ctx, user, false)
+	if err != nil {
+		t.Fatalf("setIsActive не удался: %v", err)
+	}
+	if updated.IsActive || updated.TeamName != team {
+		t.Fatalf("неожиданный пользователь: %+v", updated)
+	}
+
+	got, err := c.GetTeam(ctx, team)
+	if err != nil {
+		t.Fatalf("GET команды не удался: %v", err)
+	}
+	found := false
+	for _, m := range got.Members {
+		if m.UserId == user {
+			found = true
+			if m.IsActive {
+				t.Fatalf("ожидалось, что пользователь %s будет неактивен", user)
 			}
 		}
-		if !found {
-			t.Fatalf("пользователь %s не найден среди участников команды", user)
-		}
+	}
+	if !found {
+		t.Fatalf("пользователь %s не найден среди участников команды", user)
+	}
+
+	if _, err := c.SetUserActive(ctx, uniqueName("missing"), false); !errors.Is(err, client.ErrUserNotFound) {
+		t.Fatalf("ожидалась USER_NOT_FOUND, получено: %v", err)
+	}
+}
+
+// ответ /users/setIsActive - User из спецификации, без внутренних полей модели
+func TestSetUserIsActiveReturnsSpecUser(t *testing.T) {
+	team := uniqueName("e2e-setactive-shape")
+	ids := createTeam(t, team, 1)
+
+	res, data := postJSON(t, "/api/users/setIsActive", map[string]any{"user_id": ids[0], "is_active": false})
+	if res.StatusCode != http.StatusOK {
+		t.Fatalf("setIsActive не удался: %d %s", res.StatusCode, data)
+	}
+	var body struct {
+		User map[string]any `json:"user"`
+	}
+	if err := json.Unmarshal(data, &body); err != nil {
+		t.Fatalf("ответ не разобран: %v", err)
+	}
+	want := []string{"is_active", "team_name", "user_id", "username"}
+	got := slices.Sorted(maps.Keys(body.User))
+	if !slices.Equal(got, want) || body.User["team_name"] != team || body.User["is_active"] != false {
+		t.Fatalf("ожидался User с полями %v, получено %s", want, data)
+	}
+}
+
+func TestDeactivateUserReassignsReviews(t *testing.T) {
+	ctx := context.Background()
+	c := newClient(t)
+	team := uniqueName("e2e-deactivate-team")
+	ids := createTeam(t, team, 4)
+	prID, assigned := createPR(t, ids[0])
+	if len(assigned) != 2 {
+		t.Fatalf("ожидалось 2 ревьювера для PR %s, получено %v", prID, assigned)
+	}
+
+	change, err := c.DeactivateUser(ctx, assigned[0])
+	if err != nil {
+		t.Fatalf("деактивация не удалась: %v", err)
+	}
+	if change.User.IsActive || len(change.Reassignments) != 1 || len(change.NotReassigned) != 0 {
+		t.Fatalf("ожидалось одно переназначение, получено %+v", change)
+	}
+	if r := change.Reassignments[0]; r.PullRequestId != prID || r.NewReviewerId == assigned[0] || r.NewReviewerId == assigned[1] {
+		t.Fatalf("неожиданное переназначение: %+v", r)
+	}
+
+	// в команде не осталось свободных кандидатов: PR возвращается в not_reassigned
+	change, err = c.DeactivateUser(ctx, assigned[1])
+	if err != nil {
+		t.Fatalf("деактивация не удалась: %v", err)
+	}
+	if len(change.Reassignments) != 0 || len(change.NotReassigned) != 1 || change.NotReassigned[0] != prID {
+		t.Fatalf("ожидался PR %s без замены, получено %+v", prID, change)
 	}
 }
 
@@ -253,15 +336,1058 @@ func TestAdminMassDeactivateAndReassign(t *testing.T) {
 
 	req := map[string]interface{}{"old_team_name": oldTeam, "new_team_name": newTeam}
 	res, data = postJSON(t, "/api/admin/team/deactivate", req)
+	job := waitJob(t, res, data)
+	if len(job.Deactivated) != 3 || job.Progress.Processed != job.Progress.Total {
+		t.Fatalf("неожиданный результат задачи: %+v", job)
+	}
+	for _, o := range job.Outcomes {
+		if o.PullRequestId == prID && o.Outcome != openapi.JobOutcomeOutcomeReassigned {
+			t.Fatalf("ревью PR %s не переназначено: %+v", prID, o)
+		}
+	}
+
+	if res, _ := get(t, "/api/admin/jobs/"+uniqueName("missing")); res.StatusCode != http.StatusNotFound {
+		t.Fatalf("неизвестная задача ожидала 404, получено %d", res.StatusCode)
+	}
+
+	if job.ChangesetId == nil {
+		t.Fatalf("у задачи нет набора изменений: %+v", job)
+	}
+	res, data = postJSON(t, "/api/admin/changesets/"+*job.ChangesetId+"/revert", nil)
+	if res.StatusCode != http.StatusOK {
+		t.Fatalf("откат ожидался 200, получено %d: %s", res.StatusCode, string(data))
+	}
+	var revert openapi.ChangesetRevert
+	if err := json.Unmarshal(data, &revert); err != nil {
+		t.Fatalf("ошибка разбора отката: %v; тело: %s", err, string(data))
+	}
+	if len(revert.ReactivatedUsers) != 3 || len(revert.Conflicts) != 0 || len(revert.RestoredReviewers) != len(revert.Changeset.Reassignments) {
+		t.Fatalf("неожиданный результат отката: %s", string(data))
+	}
+	pr, err := newClient(t).GetPullRequest(context.Background(), prID)
+	if err != nil {
+		t.Fatalf("GET PR не удался: %v", err)
+	}
+	for _, r := range pr.Reviewers {
+		if r.UserId == author || !strings.HasPrefix(r.UserId, oldTeam) {
+			t.Fatalf("после отката в PR должны вернуться ревьюверы старой команды: %+v", pr.Reviewers)
+		}
+	}
+
+	res, data = postJSON(t, "/api/admin/changesets/"+*job.ChangesetId+"/revert", nil)
+	if res.StatusCode != http.StatusConflict || !strings.Contains(string(data), "CHANGESET_REVERTED") {
+		t.Fatalf("повторный откат ожидал 409 CHANGESET_REVERTED, получено %d: %s", res.StatusCode, string(data))
+	}
+}
+
+func TestListPullRequestsPagination(t *testing.T) {
+	ctx := context.Background()
+	c := newClient(t)
+	team := uniqueName("e2e-list-team")
+	ids := createTeam(t, team, 3)
+	author := ids[0]
+	for i := 0; i < 3; i++ {
+		createPR(t, author)
+	}
+
+	limit := 2
+	res, err := c.API().GetPullRequestListWithResponse(ctx, &openapi.GetPullRequestListParams{Limit: &limit, AuthorId: &author})
+	if err != nil || res.JSON200 == nil {
+		t.Fatalf("list ожидался 200, получено %d: %s (%v)", res.StatusCode(), string(res.Body), err)
+	}
+	if len(res.JSON200.PullRequests) != 2 || res.JSON200.NextCursor == nil {
+		t.Fatalf("ожидалась первая страница из 2 PR с курсором: %s", string(res.Body))
+	}
+
+	seen := make(map[string]bool)
+	for pr, err := range c.PullRequests(ctx, openapi.GetPullRequestListParams{Limit: &limit, AuthorId: &author}) {
+		if err != nil {
+			t.Fatalf("обход страниц не удался: %v", err)
+		}
+		if seen[pr.PullRequestId] {
+			t.Fatalf("PR %s встретился дважды", pr.PullRequestId)
+		}
+		seen[pr.PullRequestId] = true
+	}
+	if len(seen) != 3 {
+		t.Fatalf("ожидалось 3 PR на всех страницах, получено %d", len(seen))
+	}
+
+	bad := "not-a-cursor"
+	for _, err := range c.PullRequests(ctx, openapi.GetPullRequestListParams{Cursor: &bad}) {
+		if !errors.Is(err, client.ErrInvalidCursor) {
+			t.Fatalf("ожидалась INVALID_CURSOR, получено: %v", err)
+		}
+	}
+
+	// курсор выдан для сортировки по умолчанию и фильтра по автору: с другими условиями он не продолжает выдачу
+	sort := openapi.NameAsc
+	for name, params := range map[string]openapi.GetPullRequestListParams{
+		"другая сортировка": {Limit: &limit, AuthorId: &author, Sort: &sort, Cursor: res.JSON200.NextCursor},
+		"без фильтра":       {Limit: &limit, Cursor: res.JSON200.NextCursor},
+	} {
+		mismatch, err := c.API().GetPullRequestListWithResponse(ctx, &params)
+		if err != nil {
+			t.Fatalf("%s: запрос не удался: %v", name, err)
+		}
+		if mismatch.StatusCode() != http.StatusBadRequest || !strings.Contains(string(mismatch.Body), "INVALID_CURSOR") {
+			t.Fatalf("%s: ожидалась 400 INVALID_CURSOR, получено %d: %s", name, mismatch.StatusCode(), mismatch.Body)
+		}
+	}
+
+	status := openapi.MERGED
+	for _, err := range c.UserReviews(ctx, openapi.GetUsersGetReviewParams{UserId: ids[1], Status: &status}) {
+		if err != nil {
+			t.Fatalf("getReview не удался: %v", err)
+		}
+	}
+}
+
+func TestGetAndSearchPullRequest(t *testing.T) {
+	ctx := context.Background()
+	c := newClient(t)
+	team := uniqueName("e2e-get-team")
+	ids := createTeam(t, team, 3)
+	prID, assigned := createPR(t, ids[0])
+
+	pr, err := c.GetPullRequest(ctx, prID)
+	if err != nil {
+		t.Fatalf("get PR не удался: %v", err)
+	}
+	if len(pr.Reviewers) != len(assigned) {
+		t.Fatalf("ожидалось %d ревьюверов, получено: %+v", len(assigned), pr.Reviewers)
+	}
+	if len(pr.History) == 0 || pr.History[0].Event != openapi.PullRequestEventEventCREATED {
+		t.Fatalf("история должна начинаться с CREATED: %+v", pr.History)
+	}
+
+	found, err := c.SearchPullRequests(ctx, openapi.GetPullRequestSearchParams{Q: "e2e-pr", TeamName: &team})
+	if err != nil {
+		t.Fatalf("search не удался: %v", err)
+	}
+	if len(found) != 1 || found[0].PullRequestId != prID {
+		t.Fatalf("ожидался найденный PR %s: %+v", prID, found)
+	}
+
+	_, err = c.GetPullRequest(ctx, uniqueName("missing"))
+	var apiErr *client.Error
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusNotFound {
+		t.Fatalf("ожидался 404 для несуществующего PR, получено: %v", err)
+	}
+}
+
+func TestPullRequestDetailHistory(t *testing.T) {
+	ctx := context.Background()
+	c := newClient(t)
+	ids := createTeam(t, uniqueName("e2e-history"), 4)
+	prID, assigned := createPR(t, ids[0])
+	if len(assigned) != 2 {
+		t.Fatalf("ожидалось 2 ревьювера, получено %v", assigned)
+	}
+
+	if _, err := c.SubmitReview(ctx, prID, assigned[0]); err != nil {
+		t.Fatalf("отправка ревью не удалась: %v", err)
+	}
+	_, replacedBy, err := c.ReassignReviewer(ctx, prID, assigned[1])
+	if err != nil {
+		t.Fatalf("переназначение не удалось: %v", err)
+	}
+
+	pr, err := c.GetPullRequest(ctx, prID)
+	if err != nil {
+		t.Fatalf("get PR не удался: %v", err)
+	}
+	var submitted, reassigned *openapi.PullRequestEvent
+	for i, e := range pr.History {
+		switch e.Event {
+		case openapi.PullRequestEventEventREVIEWSUBMITTED:
+			submitted = &pr.History[i]
+		case openapi.PullRequestEventEventREVIEWERREASSIGNED:
+			reassigned = &pr.History[i]
+		}
+	}
+	if submitted == nil || submitted.UserId == nil || *submitted.UserId != assigned[0] {
+		t.Fatalf("в истории нет REVIEW_SUBMITTED от %s: %+v", assigned[0], pr.History)
+	}
+	if reassigned == nil || reassigned.UserId == nil || *reassigned.UserId != replacedBy ||
+		reassigned.OldReviewerId == nil || *reassigned.OldReviewerId != assigned[1] || reassigned.Reason == nil || *reassigned.Reason != "manual" {
+		t.Fatalf("в истории нет REVIEWER_REASSIGNED %s -> %s: %+v", assigned[1], replacedBy, pr.History)
+	}
+}
+
+func TestTeamManagement(t *testing.T) {
+	ctx := context.Background()
+	c := newClient(t)
+	from := uniqueName("e2e-from")
+	to := uniqueName("e2e-to")
+	fromIDs := createTeam(t, from, 4)
+	toIDs := createTeam(t, to, 2)
+	prID, assigned := createPR(t, fromIDs[0])
+	if len(assigned) == 0 {
+		t.Fatalf("нет назначенных ревьюверов для PR %s", prID)
+	}
+
+	change, err := c.MoveMember(ctx, assigned[0], to, "")
+	if err != nil {
+		t.Fatalf("moveMember не удался: %v", err)
+	}
+	if len(change.Reassignments)+len(change.NotReassigned) != 1 {
+		t.Fatalf("ожидалась обработка одного открытого ревью: %+v", change)
+	}
+
+	other := fromIDs[1]
+	if other == assigned[0] {
+		other = fromIDs[2]
+	}
+	if _, err := c.AddMembers(ctx, to, []string{other}, ""); err != nil {
+		t.Fatalf("addMembers для участника другой команды не удался: %v", err)
+	}
+
+	renamed := uniqueName("e2e-renamed")
+	if _, err := c.RenameTeam(ctx, to, renamed); err != nil {
+		t.Fatalf("rename не удался: %v", err)
+	}
+
+	if err := c.DeleteTeam(ctx, renamed); !errors.Is(err, client.ErrTeamNotEmpty) {
+		t.Fatalf("delete непустой команды ожидал TEAM_NOT_EMPTY, получено: %v", err)
+	}
+
+	members := append([]string{assigned[0], other}, toIDs...)
+	if _, err := c.RemoveMembers(ctx, renamed, members); err != nil {
+		t.Fatalf("removeMembers не удался: %v", err)
+	}
+
+	if err := c.DeleteTeam(ctx, renamed); err != nil {
+		t.Fatalf("delete пустой команды не удался: %v", err)
+	}
+	if _, err := c.GetTeam(ctx, renamed); !errors.Is(err, client.ErrTeamNotFound) {
+		t.Fatalf("удалённая команда должна отсутствовать, получено: %v", err)
+	}
+}
+
+func TestTeamAddSync(t *testing.T) {
+	ctx := context.Background()
+	c := newClient(t)
+	team := uniqueName("e2e-sync")
+	ids := createTeam(t, team, 3)
+
+	body := openapi.Team{TeamName: team, Members: []openapi.TeamMember{
+		{UserId: ids[0], Username: "renamed", IsActive: true},
+		{UserId: ids[1], Username: "u1", IsActive: false},
+		{UserId: team + "-new", Username: "new", IsActive: true},
+	}}
+
+	preview, err := c.SyncTeam(ctx, body, client.SyncOptions{Prune: true, DryRun: true})
+	if err != nil {
+		t.Fatalf("dry_run не удался: %v", err)
+	}
+	if d := preview.Diff; len(d.Created) != 1 || len(d.Updated) != 2 || len(d.Detached) != 1 {
+		t.Fatalf("неожиданный diff: %+v", d)
+	}
+
+	unchanged, err := c.GetTeam(ctx, team)
+	if err != nil {
+		t.Fatalf("GET команды не удался: %v", err)
+	}
+	if len(unchanged.Members) != 3 {
+		t.Fatalf("dry_run не должен менять команду: %+v", unchanged.Members)
+	}
+
+	if _, err := c.SyncTeam(ctx, body, client.SyncOptions{Prune: true}); err != nil {
+		t.Fatalf("синхронизация не удалась: %v", err)
+	}
+
+	again, err := c.SyncTeam(ctx, body, client.SyncOptions{})
+	if err != nil {
+		t.Fatalf("повторная синхронизация не удалась: %v", err)
+	}
+	if d := again.Diff; len(d.Created)+len(d.Updated)+len(d.Attached) != 0 {
+		t.Fatalf("повторная синхронизация должна быть пустой: %+v", d)
+	}
+}
+
+func TestMultiTeamMembership(t *testing.T) {
+	ctx := context.Background()
+	c := newClient(t)
+	feature := uniqueName("e2e-feature")
+	guild := uniqueName("e2e-guild")
+	featureIDs := createTeam(t, feature, 3)
+	guildIDs := createTeam(t, guild, 2)
+
+	if _, err := c.AddMembers(ctx, guild, []string{featureIDs[0]}, openapi.Observer); err != nil {
+		t.Fatalf("addMembers не удался: %v", err)
+	}
+	team, err := c.GetTeam(ctx, guild)
+	if err != nil {
+		t.Fatalf("GET команды не удался: %v", err)
+	}
+	found := false
+	for _, m := range team.Members {
+		if m.UserId == featureIDs[0] {
+			found = true
+			if m.Role == nil || *m.Role != openapi.Observer || (m.IsPrimary != nil && *m.IsPrimary) {
+				t.Fatalf("ожидалось неосновное членство с ролью observer: %+v", m)
+			}
+		}
+	}
+	if !found {
+		t.Fatalf("пользователь %s не добавлен в команду %s: %+v", featureIDs[0], guild, team.Members)
+	}
+
+	_, assigned := createPR(t, guildIDs[0])
+	for _, r := range assigned {
+		if r == featureIDs[0] {
+			t.Fatalf("observer не должен назначаться ревьювером: %v", assigned)
+		}
+	}
+
+	res, data := postJSON(t, "/api/admin/team/deactivate", map[string]interface{}{"old_team_name": guild, "new_team_name": feature})
+	result := waitJob(t, res, data)
+	if len(result.Deactivated) != len(guildIDs) || len(result.KeptActive) != 1 || result.KeptActive[0] != featureIDs[0] {
+		t.Fatalf("участник с другой основной командой должен остаться активным: %+v", result)
+	}
+}
+
+func TestPullRequestHistoryAndAudit(t *testing.T) {
+	ctx := context.Background()
+	c := newClient(t)
+	team := uniqueName("e2e-audit")
+	ids := createTeam(t, team, 4)
+	prID, assigned := createPR(t, ids[0])
+	if len(assigned) == 0 {
+		t.Fatalf("нет назначенных ревьюверов для PR %s", prID)
+	}
+
+	if _, _, err := c.ReassignReviewer(ctx, prID, assigned[0]); err != nil {
+		t.Fatalf("reassign не удался: %v", err)
+	}
+	if _, err := c.MergePullRequest(ctx, prID); err != nil {
+		t.Fatalf("merge не удался: %v", err)
+	}
+	if _, _, err := c.ReassignReviewer(ctx, prID, assigned[0]); !errors.Is(err, client.ErrPRMerged) {
+		t.Fatalf("reassign после merge ожидал PR_MERGED, получено: %v", err)
+	}
+
+	limit := 2
+	history := make([]openapi.AuditEntry, 0)
+	for e, err := range c.PullRequestHistory(ctx, openapi.GetPullRequestHistoryParams{PullRequestId: prID, Limit: &limit}) {
+		if err != nil {
+			t.Fatalf("history не удался: %v", err)
+		}
+		history = append(history, e)
+	}
+	if len(history) != len(assigned)+3 || history[0].Action != openapi.AuditActionPRCREATED || history[len(history)-1].Action != openapi.AuditActionPRMERGED {
+		t.Fatalf("неожиданный журнал PR: %+v", history)
+	}
+	reassigned := history[len(history)-2]
+	if reassigned.Action != openapi.AuditActionREVIEWERREASSIGNED || reassigned.OldReviewerId == nil || *reassigned.OldReviewerId != assigned[0] ||
+		reassigned.Reason == nil || *reassigned.Reason != "manual" {
+		t.Fatalf("неожиданная запись о переназначении: %+v", reassigned)
+	}
+
+	res, data := get(t, "/api/admin/audit?action=PR_CREATED&pull_request_id="+prID)
 	if res.StatusCode != http.StatusOK {
-		t.Fatalf("admin deactivate ожидался 200, получено %d: %s", res.StatusCode, string(data))
+		t.Fatalf("audit ожидался 200, получено %d: %s", res.StatusCode, string(data))
+	}
+	var audit struct {
+		Entries []interface{} `json:"entries"`
+	}
+	_ = json.Unmarshal(data, &audit)
+	if len(audit.Entries) != 1 {
+		t.Fatalf("ожидалась одна запись о создании PR: %s", string(data))
+	}
+}
+
+func TestReviewSubmissionAndSla(t *testing.T) {
+	ctx := context.Background()
+	c := newClient(t)
+	team := uniqueName("e2e-sla")
+	ids := createTeam(t, team, 3)
+
+	if _, err := c.SetTeamSLA(ctx, team, 24, openapi.Reassign); err != nil {
+		t.Fatalf("setSla не удался: %v", err)
+	}
+	if _, err := c.SetTeamSLA(ctx, team, 24, openapi.SlaAction("escalate")); !errors.Is(err, client.ErrInvalidRequest) {
+		t.Fatalf("setSla с неизвестным действием ожидал INVALID_REQUEST, получено: %v", err)
+	}
+
+	prID, assigned := createPR(t, ids[0])
+	if len(assigned) == 0 {
+		t.Fatalf("нет назначенных ревьюверов для PR %s", prID)
 	}
 
-	var resp map[string]interface{}
-	if err := json.Unmarshal(data, &resp); err != nil {
-		t.Fatalf("ошибка разбора ответа admin: %v; тело: %s", err, string(data))
+	if _, err := c.SubmitReview(ctx, prID, ids[0]); !errors.Is(err, client.ErrNotAssigned) {
+		t.Fatalf("ответ автора ожидал NOT_ASSIGNED, получено: %v", err)
 	}
-	if _, ok := resp["deactivated"]; !ok {
-		t.Fatalf("ответ admin не содержит 'deactivated': %s", string(data))
+
+	pr, err := c.SubmitReview(ctx, prID, assigned[0])
+	if err != nil {
+		t.Fatalf("review не удался: %v", err)
+	}
+	for _, r := range pr.Reviewers {
+		if (r.UserId == assigned[0]) != (r.RespondedAt != nil) {
+			t.Fatalf("responded_at должен быть только у ответившего ревьювера: %+v", pr.Reviewers)
+		}
+	}
+}
+
+func TestAdminTimeStats(t *testing.T) {
+	team := uniqueName("e2e-stats")
+	ids := createTeam(t, team, 3)
+	prID, _ := createPR(t, ids[0])
+	res, data := postJSON(t, "/api/pullRequest/merge", map[string]interface{}{"pull_request_id": prID})
+	if res.StatusCode != http.StatusOK {
+		t.Fatalf("merge ожидался 200, получено %d: %s", res.StatusCode, string(data))
+	}
+
+	from := time.Now().Add(-time.Hour).UTC().Format(time.RFC3339)
+	res, data = get(t, "/api/admin/stats?from="+from)
+	if res.StatusCode != http.StatusOK {
+		t.Fatalf("stats ожидался 200, получено %d: %s", res.StatusCode, string(data))
+	}
+	var stats struct {
+		TimeToMergePerTeam map[string]struct {
+			Count int `json:"count"`
+		} `json:"time_to_merge_per_team"`
+		OpenPRAge map[string]int `json:"open_pr_age"`
+	}
+	if err := json.Unmarshal(data, &stats); err != nil {
+		t.Fatalf("ошибка разбора статистики: %v; тело: %s", err, string(data))
+	}
+	if stats.TimeToMergePerTeam[team].Count != 1 {
+		t.Fatalf("ожидался один смерженный PR команды %s: %s", team, string(data))
+	}
+	if _, ok := stats.OpenPRAge["lt_1d"]; !ok {
+		t.Fatalf("нет распределения возраста открытых PR: %s", string(data))
+	}
+
+	res, data = get(t, "/api/admin/stats?from=yesterday")
+	if res.StatusCode != http.StatusBadRequest {
+		t.Fatalf("некорректный from ожидался 400, получено %d: %s", res.StatusCode, string(data))
+	}
+}
+
+func TestAdminExport(t *testing.T) {
+	team := uniqueName("e2e-export")
+	ids := createTeam(t, team, 3)
+	prID, reviewers := createPR(t, ids[0])
+
+	res, data := get(t, "/api/admin/export/pullRequests?team_name="+team)
+	if res.StatusCode != http.StatusOK {
+		t.Fatalf("export csv ожидался 200, получено %d: %s", res.StatusCode, string(data))
+	}
+	records, err := csv.NewReader(bytes.NewReader(data)).ReadAll()
+	if err != nil {
+		t.Fatalf("ошибка разбора csv: %v; тело: %s", err, string(data))
+	}
+	if len(records) != 2 || records[0][0] != "pull_request_id" || records[1][0] != prID {
+		t.Fatalf("ожидались заголовок и PR %s: %v", prID, records)
+	}
+
+	req, _ := http.NewRequest(http.MethodGet, baseURL()+"/api/admin/export/assignments?team_name="+team, nil)
+	req.Header.Set("Accept", "application/x-ndjson")
+	res, err = http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("GET assignments не удался: %v", err)
+	}
+	data, _ = io.ReadAll(res.Body)
+	res.Body.Close()
+	if res.StatusCode != http.StatusOK || res.Header.Get("Content-Type") != "application/x-ndjson" {
+		t.Fatalf("export ndjson ожидался 200 application/x-ndjson, получено %d %s", res.StatusCode, res.Header.Get("Content-Type"))
+	}
+	lines := bytes.Split(bytes.TrimSpace(data), []byte("\n"))
+	if len(lines) != len(reviewers) {
+		t.Fatalf("ожидалось %d назначений, получено: %s", len(reviewers), string(data))
+	}
+	for _, line := range lines {
+		var row struct {
+			PullRequestID string `json:"pull_request_id"`
+		}
+		if err := json.Unmarshal(line, &row); err != nil || row.PullRequestID != prID {
+			t.Fatalf("некорректная строка выгрузки: %s", string(line))
+		}
+	}
+
+	res, data = get(t, "/api/admin/export/stats?format=xml")
+	if res.StatusCode != http.StatusNotAcceptable {
+		t.Fatalf("неизвестный формат ожидался 406, получено %d: %s", res.StatusCode, string(data))
+	}
+}
+
+func TestAdminImport(t *testing.T) {
+	team := uniqueName("e2e-import")
+	userID := uniqueName("e2e-import-user")
+	file := fmt.Sprintf("team_name,user_id,username,is_active,role,sla_first_review_hours,sla_action\n%s,%s,Importer,true,lead,24,reassign\n", team, userID)
+
+	post := func(path string, body string) (*http.Response, []byte) {
+		res, err := http.Post(baseURL()+path, "text/csv", bytes.NewReader([]byte(body)))
+		if err != nil {
+			t.Fatalf("POST %s не удался: %v", path, err)
+		}
+		data, _ := io.ReadAll(res.Body)
+		res.Body.Close()
+		return res, data
+	}
+	type report struct {
+		Applied bool `json:"applied"`
+		Summary struct {
+			TeamsCreated int `json:"teams_created"`
+			UsersCreated int `json:"users_created"`
+			Errors       int `json:"errors"`
+		} `json:"summary"`
+	}
+
+	res, data := post("/api/admin/import?dry_run=true", file)
+	var dry report
+	if res.StatusCode != http.StatusOK || json.Unmarshal(data, &dry) != nil || dry.Applied || dry.Summary.TeamsCreated != 1 {
+		t.Fatalf("dry run ожидался 200 с diff без применения, получено %d: %s", res.StatusCode, string(data))
+	}
+	res, data = get(t, "/api/team/get?team_name="+team)
+	if res.StatusCode != http.StatusNotFound {
+		t.Fatalf("после dry run команда не должна существовать, получено %d: %s", res.StatusCode, string(data))
+	}
+
+	res, data = post("/api/admin/import", file)
+	var applied report
+	if res.StatusCode != http.StatusOK || json.Unmarshal(data, &applied) != nil || !applied.Applied || applied.Summary.UsersCreated != 1 {
+		t.Fatalf("импорт ожидался 200 с применением, получено %d: %s", res.StatusCode, string(data))
+	}
+	res, data = get(t, "/api/team/get?team_name="+team)
+	if res.StatusCode != http.StatusOK {
+		t.Fatalf("team/get ожидался 200, получено %d: %s", res.StatusCode, string(data))
+	}
+
+	res, data = post("/api/admin/import", file+team+",,,,,-1,\n")
+	var invalid report
+	if res.StatusCode != http.StatusBadRequest || json.Unmarshal(data, &invalid) != nil || invalid.Applied || invalid.Summary.Errors == 0 {
+		t.Fatalf("файл с ошибками ожидался 400 без применения, получено %d: %s", res.StatusCode, string(data))
+	}
+}
+
+func TestMalformedBodyGetsErrorResponse(t *testing.T) {
+	url := baseURL() + "/api/team/add"
+	res, err := http.Post(url, "application/json", bytes.NewReader([]byte(`{"team_name": 1}`)))
+	if err != nil {
+		t.Fatalf("POST %s не удался: %v", url, err)
+	}
+	defer res.Body.Close()
+
+	var body openapi.ErrorResponse
+	if err := json.NewDecoder(res.Body).Decode(&body); err != nil {
+		t.Fatalf("ожидался ErrorResponse в JSON: %v", err)
+	}
+	if res.StatusCode != http.StatusBadRequest || body.Error.Code != openapi.ErrorResponseErrorCodeINVALIDREQUEST {
+		t.Fatalf("ожидался 400 INVALID_REQUEST, получено %d %+v", res.StatusCode, body.Error)
+	}
+}
+
+func TestProblemDetailsOnRequest(t *testing.T) {
+	url := baseURL() + "/api/team/get?team_name=" + uniqueName("missing")
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		t.Fatalf("некорректный запрос: %v", err)
+	}
+	req.Header.Set("Accept", "application/problem+json")
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("GET %s не удался: %v", url, err)
+	}
+	defer res.Body.Close()
+
+	if ct := res.Header.Get("Content-Type"); ct != "application/problem+json" {
+		t.Fatalf("ожидался application/problem+json, получен %q", ct)
+	}
+	var problem openapi.ProblemDetails
+	if err := json.NewDecoder(res.Body).Decode(&problem); err != nil {
+		t.Fatalf("ошибка разбора problem+json: %v", err)
+	}
+	if res.StatusCode != http.StatusNotFound || problem.Status != http.StatusNotFound || problem.Code != "TEAM_NOT_FOUND" {
+		t.Fatalf("ожидался 404 TEAM_NOT_FOUND, получено %d %+v", res.StatusCode, problem)
+	}
+}
+
+func TestValidationReportsFields(t *testing.T) {
+	team := uniqueName("e2e-validation")
+	members := []openapi.TeamMember{
+		{UserId: team + "-u1", Username: "u1", IsActive: true},
+		{UserId: team + "-u1", Username: "u1 again", IsActive: true},
+	}
+	_, err := newClient(t).SyncTeam(context.Background(), openapi.Team{TeamName: team, Members: members}, client.SyncOptions{})
+
+	var apiErr *client.Error
+	if !errors.As(err, &apiErr) || apiErr.Code != "INVALID_REQUEST" {
+		t.Fatalf("ожидалась INVALID_REQUEST, получено: %v", err)
+	}
+	if len(apiErr.Details) != 1 || apiErr.Details[0].Field != "members[1].user_id" || apiErr.Details[0].Rule != "unique" {
+		t.Fatalf("ожидалась ошибка уникальности members[1].user_id, получено: %+v", apiErr.Details)
+	}
+
+	if _, err := newClient(t).CreatePullRequest(context.Background(), uniqueName("pr"), "", team+"-u1"); !errors.Is(err, client.ErrInvalidRequest) {
+		t.Fatalf("PR с пустым названием ожидал INVALID_REQUEST, получено: %v", err)
+	}
+}
+
+func TestRepositoriesScopePullRequestIDs(t *testing.T) {
+	ctx := context.Background()
+	c := newClient(t)
+	dev := uniqueName("e2e-dev")
+	owners := uniqueName("e2e-owners")
+	devIDs := createTeam(t, dev, 3)
+	ownerIDs := createTeam(t, owners, 3)
+
+	first := uniqueName("e2e-repo")
+	second := uniqueName("e2e-repo")
+	source := openapi.OwnerTeam
+	count := 1
+	if _, err := c.AddRepository(ctx, openapi.Repository{Name: first, OwnerTeam: owners}); err != nil {
+		t.Fatalf("создание репозитория не удалось: %v", err)
+	}
+	if _, err := c.AddRepository(ctx, openapi.Repository{Name: first, OwnerTeam: owners}); !errors.Is(err, client.ErrRepositoryExists) {
+		t.Fatalf("повторное создание ожидало REPOSITORY_EXISTS, получено: %v", err)
+	}
+	repo, err := c.AddRepository(ctx, openapi.Repository{Name: second, OwnerTeam: owners, ReviewerPolicy: &openapi.ReviewerPolicy{ReviewerSource: &source, ReviewersCount: &count}})
+	if err != nil || repo.ReviewerPolicy == nil || *repo.ReviewerPolicy.ReviewersCount != 1 {
+		t.Fatalf("создание репозитория с политикой не удалось: %+v, %v", repo, err)
+	}
+
+	prID := uniqueName("pr")
+	for _, name := range []string{first, second} {
+		res, err := c.API().PostPullRequestCreateWithResponse(ctx, openapi.PostPullRequestCreateJSONRequestBody{PullRequestId: prID, PullRequestName: "e2e-pr", AuthorId: devIDs[0], Repository: &name})
+		if err != nil || res.JSON201 == nil {
+			t.Fatalf("создание PR в %s не удалось: %v %s", name, err, string(res.Body))
+		}
+		reviewers := res.JSON201.Pr.AssignedReviewers
+		if name == second && (len(reviewers) != 1 || !slices.Contains(ownerIDs, reviewers[0])) {
+			t.Fatalf("ожидался один ревьювер из команды-владельца, получено %v", reviewers)
+		}
+		if name == first && (len(reviewers) != 2 || slices.Contains(ownerIDs, reviewers[0])) {
+			t.Fatalf("ожидались два ревьювера из команды автора, получено %v", reviewers)
+		}
+	}
+
+	if _, err := c.MergePullRequest(ctx, prID); !errors.Is(err, client.ErrPRAmbiguous) {
+		t.Fatalf("merge без репозитория ожидал PR_AMBIGUOUS, получено: %v", err)
+	}
+	res, err := c.API().PostPullRequestMergeWithResponse(ctx, openapi.PostPullRequestMergeJSONRequestBody{PullRequestId: prID, Repository: &second})
+	if err != nil || res.JSON200 == nil || res.JSON200.Pr.Status != openapi.PullRequestStatusMERGED {
+		t.Fatalf("merge в репозитории не удался: %v %s", err, string(res.Body))
+	}
+	detail, err := c.API().GetPullRequestGetWithResponse(ctx, &openapi.GetPullRequestGetParams{PullRequestId: prID, Repository: &first})
+	if err != nil || detail.JSON200 == nil || detail.JSON200.Pr.Status != openapi.PullRequestDetailStatusOPEN {
+		t.Fatalf("PR в другом репозитории должен остаться открытым: %v %s", err, string(detail.Body))
+	}
+}
+
+func TestOrganizationsIsolateData(t *testing.T) {
+	ctx := context.Background()
+	slug := uniqueName("e2e-org")
+	if _, err := newClient(t).CreateOrganization(ctx, slug, "e2e"); err != nil {
+		t.Fatalf("создание организации не удалось: %v", err)
+	}
+	if _, err := newClient(t).CreateOrganization(ctx, slug, "e2e"); !errors.Is(err, client.ErrOrganizationExists) {
+		t.Fatalf("повторное создание ожидало ORGANIZATION_EXISTS, получено: %v", err)
+	}
+	org, err := client.New(baseURL(), client.WithOrganization(slug))
+	if err != nil {
+		t.Fatalf("не удалось создать клиент: %v", err)
+	}
+
+	team := uniqueName("e2e-tenant")
+	ids := createTeam(t, team, 3)
+	members := []openapi.TeamMember{
+		{UserId: ids[0], Username: "tenant-author", IsActive: true},
+		{UserId: ids[1], Username: "tenant-reviewer", IsActive: true},
+	}
+	if _, err := org.SyncTeam(ctx, openapi.Team{TeamName: team, Members: members}, client.SyncOptions{}); err != nil {
+		t.Fatalf("команда с тем же именем в другой организации должна создаваться: %v", err)
+	}
+	got, err := org.GetTeam(ctx, team)
+	if err != nil || len(got.Members) != 2 {
+		t.Fatalf("ожидались 2 участника команды организации, получено %+v, %v", got, err)
+	}
+
+	prID := uniqueName("pr")
+	pr, err := org.CreatePullRequest(ctx, prID, "e2e-pr", ids[0])
+	if err != nil || len(pr.AssignedReviewers) != 1 || pr.AssignedReviewers[0] != ids[1] {
+		t.Fatalf("ожидался ревьювер из команды организации, получено %+v, %v", pr, err)
+	}
+	if _, err := newClient(t).GetPullRequest(ctx, prID); !errors.Is(err, client.ErrPRNotFound) {
+		t.Fatalf("PR организации не должен быть виден в default, получено: %v", err)
+	}
+
+	missing, err := client.New(baseURL(), client.WithOrganization(uniqueName("e2e-missing")))
+	if err != nil {
+		t.Fatalf("не удалось создать клиент: %v", err)
+	}
+	if _, err := missing.GetTeam(ctx, team); !errors.Is(err, client.ErrOrganizationNotFound) {
+		t.Fatalf("ожидалась ORGANIZATION_NOT_FOUND, получено: %v", err)
+	}
+}
+
+func TestIdempotencyKeyReplaysFirstResponse(t *testing.T) {
+	team := uniqueName("e2e-idempotency")
+	ids := createTeam(t, team, 4)
+	c := newClient(t)
+	ctx := client.WithIdempotencyKey(context.Background(), uniqueName("key"))
+
+	prID := uniqueName("pr")
+	first, err := c.CreatePullRequest(ctx, prID, "e2e-pr", ids[0])
+	if err != nil {
+		t.Fatalf("создание PR не удалось: %v", err)
+	}
+	retry, err := c.CreatePullRequest(ctx, prID, "e2e-pr", ids[0])
+	if err != nil {
+		t.Fatalf("повтор с тем же ключом должен вернуть первый ответ, получено: %v", err)
+	}
+	if !slices.Equal(first.AssignedReviewers, retry.AssignedReviewers) {
+		t.Fatalf("повтор вернул других ревьюверов: %v и %v", first.AssignedReviewers, retry.AssignedReviewers)
+	}
+
+	if _, err := c.CreatePullRequest(ctx, uniqueName("pr"), "e2e-pr", ids[0]); !errors.Is(err, client.ErrIdempotencyKeyReused) {
+		t.Fatalf("ключ с другим телом ожидал IDEMPOTENCY_KEY_REUSED, получено: %v", err)
+	}
+
+	// ключ другого клиента не видит чужой ответ: запрос выполняется и натыкается на уже созданный PR
+	other, err := client.New(baseURL(), client.WithUserID(uniqueName("e2e-other")))
+	if err != nil {
+		t.Fatalf("не удалось создать клиент: %v", err)
+	}
+	if _, err := other.CreatePullRequest(ctx, prID, "e2e-pr", ids[0]); !errors.Is(err, client.ErrPRExists) {
+		t.Fatalf("ключ другого клиента ожидал PR_EXISTS, получено: %v", err)
+	}
+}
+
+func TestRateLimitOnReassign(t *testing.T) {
+	body := map[string]string{"pull_request_id": uniqueName("pr-missing"), "old_user_id": "nobody"}
+	res, _ := postJSON(t, "/api/pullRequest/reassign", body)
+	if res.Header.Get("RateLimit-Limit") == "" {
+		t.Skip("ограничение частоты запросов выключено на сервере")
+	}
+
+	// несуществующий PR тоже расходует токен, поэтому корзина переназначений кончается за RateLimit-Limit запросов
+	for i := 0; i < 100; i++ {
+		res, data := postJSON(t, "/api/pullRequest/reassign", body)
+		if res.StatusCode != http.StatusTooManyRequests {
+			continue
+		}
+		var errResp openapi.ErrorResponse
+		if err := json.Unmarshal(data, &errResp); err != nil || errResp.Error.Code != openapi.ErrorResponseErrorCodeRATELIMITED {
+			t.Fatalf("ожидался RATE_LIMITED, получено %s", data)
+		}
+		if res.Header.Get("Retry-After") == "" || res.Header.Get("RateLimit-Remaining") != "0" {
+			t.Fatalf("429 должен нести Retry-After и RateLimit-Remaining: 0, получено %v", res.Header)
+		}
+		return
+	}
+	t.Fatal("лимит /pullRequest/reassign не сработал за 100 запросов")
+}
+
+// открывает поток событий и отдаёт их в канал до отмены ctx
+func streamEvents(ctx context.Context, t *testing.T, params openapi.GetEventsStreamParams) <-chan client.StreamEvent {
+	events := make(chan client.StreamEvent, 16)
+	go func() {
+		defer close(events)
+		err := newClient(t).StreamEvents(ctx, params, func(e client.StreamEvent) error {
+			events <- e
+			return nil
+		})
+		if err != nil && ctx.Err() == nil {
+			t.Errorf("поток событий прервался: %v", err)
+		}
+	}()
+	return events
+}
+
+func nextEvent(t *testing.T, events <-chan client.StreamEvent) client.StreamEvent {
+	t.Helper()
+	select {
+	case e, ok := <-events:
+		if !ok {
+			t.Fatal("поток событий закрылся")
+		}
+		return e
+	case <-time.After(5 * time.Second):
+		t.Fatal("событие не пришло за 5s")
+	}
+	return client.StreamEvent{}
+}
+
+func TestEventStreamForTeamAndResume(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	c := newClient(t)
+	team := uniqueName("e2e-events")
+	ids := createTeam(t, team, 5)
+
+	// несуществующий Last-Event-ID сразу даёт reset, после него подписка точно действует
+	stale := "stale-1"
+	events := streamEvents(ctx, t, openapi.GetEventsStreamParams{TeamName: &team, LastEventID: &stale})
+	if e := nextEvent(t, events); !e.Reset {
+		t.Fatalf("первым ожидалось событие reset, получено %+v", e)
+	}
+
+	prID, assigned := createPR(t, ids[0])
+	if len(assigned) != 2 {
+		t.Fatalf("ожидались 2 ревьювера, получено %v", assigned)
+	}
+	var lastID string
+	for range assigned {
+		e := nextEvent(t, events).Event
+		if e.Type != openapi.ReviewerAssigned || e.PullRequestId != prID || !slices.Contains(assigned, *e.ReviewerId) {
+			t.Fatalf("ожидалось назначение ревьювера PR %s, получено %+v", prID, e)
+		}
+		lastID = e.Id
+	}
+
+	oldReviewer := assigned[0]
+	_, newReviewer, err := c.ReassignReviewer(ctx, prID, oldReviewer)
+	if err != nil {
+		t.Fatalf("переназначение не удалось: %v", err)
+	}
+	if e := nextEvent(t, events).Event; e.Type != openapi.ReviewerAssigned || *e.ReviewerId != newReviewer || *e.Replaces != oldReviewer {
+		t.Fatalf("ожидалось назначение %s вместо %s, получено %+v", newReviewer, oldReviewer, e)
+	}
+	if e := nextEvent(t, events).Event; e.Type != openapi.ReviewerReassignedAway || *e.ReviewerId != oldReviewer || *e.ReplacedBy != newReviewer {
+		t.Fatalf("ожидалось снятие %s с ревью, получено %+v", oldReviewer, e)
+	}
+
+	if _, err := c.MergePullRequest(ctx, prID); err != nil {
+		t.Fatalf("слияние не удалось: %v", err)
+	}
+	if e := nextEvent(t, events).Event; e.Type != openapi.PullRequestMerged || e.AssignedReviewers == nil || !slices.Contains(*e.AssignedReviewers, newReviewer) {
+		t.Fatalf("ожидалось событие merge, получено %+v", e)
+	}
+
+	// переподключение с Last-Event-ID отдаёт пропущенные события этого пользователя
+	resumed := streamEvents(ctx, t, openapi.GetEventsStreamParams{UserId: &oldReviewer, LastEventID: &lastID})
+	if e := nextEvent(t, resumed); e.Reset || e.Event.Type != openapi.ReviewerReassignedAway || e.Event.PullRequestId != prID {
+		t.Fatalf("после Last-Event-ID ожидалось пропущенное снятие с ревью, получено %+v", e)
+	}
+
+	res, err := c.API().GetEventsStreamWithResponse(ctx, &openapi.GetEventsStreamParams{})
+	if err != nil || res.StatusCode() != http.StatusBadRequest {
+		t.Fatalf("поток без user_id и team_name ожидал 400, получено %v %v", res, err)
+	}
+}
+
+func grpcAddr() string {
+	if v := os.Getenv("GRPC_PORT"); v != "" {
+		return "localhost:" + v
+	}
+	return "localhost:9090"
+}
+
+// код ErrorResponse из ErrorInfo статуса gRPC
+func grpcErrorCode(err error) (codes.Code, string) {
+	st := status.Convert(err)
+	for _, d := range st.Details() {
+		if info, ok := d.(*errdetails.ErrorInfo); ok {
+			return st.Code(), info.Reason
+		}
+	}
+	return st.Code(), ""
+}
+
+func TestGRPCSharesServicesWithREST(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	conn, err := grpc.NewClient(grpcAddr(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("не удалось создать gRPC-клиент: %v", err)
+	}
+	defer conn.Close()
+	rpc := reviewerpb.NewReviewerServiceClient(conn)
+	ctx = metadata.AppendToOutgoingContext(ctx, "user-id", "e2e-grpc")
+
+	team := uniqueName("e2e-grpc")
+	members := []*reviewerpb.TeamMember{
+		{UserId: team + "-u0", Username: "u0", IsActive: true},
+		{UserId: team + "-u1", Username: "u1", IsActive: true},
+		{UserId: team + "-u2", Username: "u2", IsActive: true},
+	}
+	added, err := rpc.AddTeam(ctx, &reviewerpb.AddTeamRequest{Team: &reviewerpb.Team{TeamName: team, Members: members}})
+	if err != nil {
+		t.Fatalf("AddTeam не удался: %v", err)
+	}
+	if !added.GetDiff().GetTeamCreated() || len(added.GetTeam().GetMembers()) != 3 {
+		t.Fatalf("неожиданный ответ AddTeam: %v", added)
+	}
+
+	// команда, созданная по gRPC, видна через REST
+	if got, err := newClient(t).GetTeam(ctx, team); err != nil || len(got.Members) != 3 {
+		t.Fatalf("REST не видит команду из gRPC: %+v %v", got, err)
+	}
+
+	stream, err := rpc.StreamEvents(ctx, &reviewerpb.StreamEventsRequest{TeamName: team, LastEventId: "stale-1"})
+	if err != nil {
+		t.Fatalf("StreamEvents не удался: %v", err)
+	}
+	if msg, err := stream.Recv(); err != nil || !msg.GetResetRequired() {
+		t.Fatalf("первым ожидалось reset_required, получено %v %v", msg, err)
+	}
+
+	prID := uniqueName("pr")
+	pr, err := rpc.CreatePullRequest(ctx, &reviewerpb.CreatePullRequestRequest{PullRequestId: prID, PullRequestName: "e2e-grpc", AuthorId: members[0].UserId})
+	if err != nil {
+		t.Fatalf("CreatePullRequest не удался: %v", err)
+	}
+	if pr.GetStatus() != "OPEN" || len(pr.GetAssignedReviewers()) != 2 || pr.GetCreatedAt() == nil {
+		t.Fatalf("неожиданный PR: %v", pr)
+	}
+	for range pr.GetAssignedReviewers() {
+		msg, err := stream.Recv()
+		if err != nil || msg.GetEvent().GetType() != "reviewer.assigned" || msg.GetEvent().GetPullRequestId() != prID {
+			t.Fatalf("ожидалось назначение ревьювера PR %s, получено %v %v", prID, msg, err)
+		}
+	}
+
+	_, err = rpc.CreatePullRequest(ctx, &reviewerpb.CreatePullRequestRequest{PullRequestId: prID, PullRequestName: "e2e-grpc", AuthorId: members[0].UserId})
+	if code, reason := grpcErrorCode(err); code != codes.AlreadyExists || reason != "PR_EXISTS" {
+		t.Fatalf("повторное создание PR ожидало ALREADY_EXISTS PR_EXISTS, получено %v %s", code, reason)
+	}
+	_, err = rpc.ReassignReviewer(ctx, &reviewerpb.ReassignReviewerRequest{PullRequestId: prID, OldUserId: members[0].UserId})
+	if code, reason := grpcErrorCode(err); code != codes.FailedPrecondition || reason != "NOT_ASSIGNED" {
+		t.Fatalf("переназначение автора ожидало FAILED_PRECONDITION NOT_ASSIGNED, получено %v %s", code, reason)
+	}
+	_, err = rpc.GetTeam(ctx, &reviewerpb.GetTeamRequest{TeamName: uniqueName("missing")})
+	if code, reason := grpcErrorCode(err); code != codes.NotFound || reason != "TEAM_NOT_FOUND" {
+		t.Fatalf("несуществующая команда ожидала NOT_FOUND TEAM_NOT_FOUND, получено %v %s", code, reason)
+	}
+	_, err = rpc.GetUserReviews(ctx, &reviewerpb.GetUserReviewsRequest{UserId: "bad id"})
+	if code, reason := grpcErrorCode(err); code != codes.InvalidArgument || reason != "INVALID_REQUEST" {
+		t.Fatalf("неверный user_id ожидал INVALID_ARGUMENT INVALID_REQUEST, получено %v %s", code, reason)
+	}
+
+	reviews, err := rpc.GetUserReviews(ctx, &reviewerpb.GetUserReviewsRequest{UserId: pr.GetAssignedReviewers()[0]})
+	if err != nil || len(reviews.GetPullRequests()) != 1 || reviews.GetPullRequests()[0].GetPullRequestId() != prID {
+		t.Fatalf("GetUserReviews ожидал PR %s, получено %v %v", prID, reviews, err)
+	}
+
+	// статистика назначений считает открытые PR
+	stats, err := rpc.GetStats(ctx, &reviewerpb.GetStatsRequest{})
+	if err != nil || stats.GetAssignmentsPerPr()[prID] != 2 {
+		t.Fatalf("GetStats ожидал 2 назначения PR %s, получено %v %v", prID, stats.GetAssignmentsPerPr(), err)
+	}
+
+	merged, err := rpc.MergePullRequest(ctx, &reviewerpb.PullRequestRef{PullRequestId: prID})
+	if err != nil || merged.GetStatus() != "MERGED" || merged.GetMergedAt() == nil {
+		t.Fatalf("MergePullRequest не удался: %v %v", merged, err)
+	}
+	if msg, err := stream.Recv(); err != nil || msg.GetEvent().GetType() != "pull_request.merged" {
+		t.Fatalf("ожидалось событие merge, получено %v %v", msg, err)
+	}
+}
+
+func TestGraphQLDashboardInOneRequest(t *testing.T) {
+	team := uniqueName("e2e-graphql")
+	ids := createTeam(t, team, 4)
+	prID, reviewers := createPR(t, ids[0])
+	if len(reviewers) != 2 {
+		t.Fatalf("ожидалось 2 ревьювера, получено %v", reviewers)
+	}
+
+	type pullRequest struct {
+		ID        string
+		Status    string
+		Author    struct{ ID string }
+		Reviewers []struct{ ID string }
+	}
+	var out struct {
+		Team struct {
+			Name    string
+			Members []struct {
+				Role      string
+				IsPrimary bool
+				User      struct {
+					ID              string
+					AssignmentCount int
+					Team            struct{ Name string }
+					Reviews         []pullRequest
+				}
+			}
+		}
+		PullRequest *pullRequest
+		Missing     *struct{ Name string }
+	}
+	query := `query($team: String!, $pr: ID!) {
+		team(name: $team) {
+			name
+			members { role isPrimary user { id assignmentCount team { name } reviews { id status author { id } reviewers { id } } } }
+		}
+		pullRequest(id: $pr) { id status author { id } reviewers { id } }
+		missing: team(name: "e2e-graphql-missing") { name }
+	}`
+	if err := newClient(t).GraphQL(context.Background(), query, map[string]any{"team": team, "pr": prID}, &out); err != nil {
+		t.Fatalf("запрос GraphQL не удался: %v", err)
+	}
+
+	if out.Team.Name != team || len(out.Team.Members) != 4 {
+		t.Fatalf("неожиданная команда: %+v", out.Team)
+	}
+	if out.Missing != nil {
+		t.Fatalf("несуществующая команда должна быть null, получено %+v", out.Missing)
+	}
+	if out.PullRequest == nil || out.PullRequest.ID != prID || out.PullRequest.Author.ID != ids[0] || len(out.PullRequest.Reviewers) != 2 {
+		t.Fatalf("неожиданный PR: %+v", out.PullRequest)
+	}
+	for _, m := range out.Team.Members {
+		if m.Role != "member" || !m.IsPrimary || m.User.Team.Name != team {
+			t.Fatalf("неожиданный участник: %+v", m)
+		}
+		wantReview := slices.Contains(reviewers, m.User.ID)
+		if got := len(m.User.Reviews) == 1; got != wantReview || (m.User.AssignmentCount == 1) != wantReview {
+			t.Fatalf("ревью %s не совпадают с назначением PR: %+v", m.User.ID, m.User)
+		}
+		if wantReview {
+			r := m.User.Reviews[0]
+			if r.ID != prID || r.Status != "OPEN" || r.Author.ID != ids[0] || len(r.Reviewers) != 2 {
+				t.Fatalf("неожиданное ревью %s: %+v", m.User.ID, r)
+			}
+		}
+	}
+
+	// ошибка сервиса приходит в errors с кодом ErrorResponse, а не HTTP-статусом
+	err := newClient(t).GraphQL(context.Background(), `{ team(name: "") { name } }`, nil, nil)
+	if !errors.Is(err, client.ErrInvalidRequest) {
+		t.Fatalf("ожидался INVALID_REQUEST, получено %v", err)
+	}
+}
+
+func TestRevertSkipsUsersChangedAfterChangeset(t *testing.T) {
+	ctx := context.Background()
+	c := newClient(t)
+	team := uniqueName("e2e-revert")
+	ids := createTeam(t, team, 3)
+
+	change, err := c.DeactivateUser(ctx, ids[1])
+	if err != nil || change.ChangesetId == nil {
+		t.Fatalf("деактивация не удалась: %+v %v", change, err)
+	}
+	// после операции пользователя вручную активировали и снова деактивировали: откат не должен его включать
+	if _, err := c.SetUserActive(ctx, ids[1], true); err != nil {
+		t.Fatalf("активация не удалась: %v", err)
+	}
+	if _, err := c.SetUserActive(ctx, ids[1], false); err != nil {
+		t.Fatalf("деактивация не удалась: %v", err)
+	}
+
+	res, err := c.API().PostAdminChangesetsChangesetIdRevertWithResponse(ctx, *change.ChangesetId)
+	if err != nil || res.JSON200 == nil {
+		t.Fatalf("откат не удался: %v %s", err, res.Body)
+	}
+	revert := res.JSON200
+	if len(revert.ReactivatedUsers) != 0 || len(revert.Conflicts) != 1 ||
+		revert.Conflicts[0].Reason != openapi.UserChanged || revert.Conflicts[0].UserId == nil || *revert.Conflicts[0].UserId != ids[1] {
+		t.Fatalf("ожидался конфликт user_changed для %s, получено %+v", ids[1], revert)
+	}
+
+	got, err := c.GetTeam(ctx, team)
+	if err != nil {
+		t.Fatalf("получение команды не удалось: %v", err)
+	}
+	for _, m := range got.Members {
+		if m.UserId == ids[1] && m.IsActive {
+			t.Fatalf("откат активировал пользователя, которого после операции деактивировали вручную")
+		}
 	}
 }
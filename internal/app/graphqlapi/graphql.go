@@ -0,0 +1,82 @@
+package graphqlapi
+
+import (
+	"context"
+	_ "embed"
+	"log"
+
+	graphql "github.com/graph-gophers/graphql-go"
+	serverrors "github.com/wozhdeleniye/avito-tech-internship/internal/pkg/errors"
+	postgresrepository "github.com/wozhdeleniye/avito-tech-internship/internal/repo/repositories/postgres_repository"
+	"github.com/wozhdeleniye/avito-tech-internship/internal/services"
+)
+
+//go:embed schema.graphql
+var schemaSDL string
+
+// ограничения запроса: глубина покрывает team → members → user → reviews → reviewers → team,
+// параллельность - сколько резолверов одного уровня успевают встать в общий пакет загрузчика
+const (
+	maxDepth       = 12
+	maxQueryLength = 16 << 10
+	maxParallelism = 100
+)
+
+// схема /graphql; загрузчики создаются на каждый запрос, поэтому кэш не переживает запрос и не смешивает организации
+type API struct {
+	schema *graphql.Schema
+	repos  repositories
+}
+
+type repositories struct {
+	users *postgresrepository.UserRepository
+	teams *postgresrepository.TeamRepository
+	prs   *postgresrepository.PReqRepository
+}
+
+func New(userRepo *postgresrepository.UserRepository, teamRepo *postgresrepository.TeamRepository, prRepo *postgresrepository.PReqRepository, prService *services.PReqService) *API {
+	repos := repositories{users: userRepo, teams: teamRepo, prs: prRepo}
+	root := &queryResolver{repos: repos, prService: prService}
+	return &API{
+		schema: graphql.MustParseSchema(schemaSDL, root,
+			graphql.MaxDepth(maxDepth),
+			graphql.MaxQueryLength(maxQueryLength),
+			graphql.MaxParallelism(maxParallelism),
+		),
+		repos: repos,
+	}
+}
+
+// выполнить запрос; ошибки разбора и резолверов возвращаются в Response.Errors
+func (a *API) Exec(ctx context.Context, query string, operationName string, variables map[string]any) *graphql.Response {
+	return a.schema.Exec(withLoaders(ctx, a.repos), query, operationName, variables)
+}
+
+// ошибка резолвера: клиенту - сообщение и код ErrorResponse в extensions.code, причина (Err) - только в журнал
+type resolverError struct {
+	serr *serverrors.ServiceError
+}
+
+func (e resolverError) Error() string {
+	return e.serr.Message
+}
+
+func (e resolverError) Extensions() map[string]any {
+	ext := map[string]any{"code": string(e.serr.Code)}
+	if len(e.serr.Details) > 0 {
+		ext["details"] = e.serr.Details
+	}
+	return ext
+}
+
+func resolveError(serr *serverrors.ServiceError) error {
+	if serr.HTTPCode >= 500 || serr.Err != nil {
+		log.Printf("graphql: %v", serr)
+	}
+	return resolverError{serr: serr}
+}
+
+// ошибка репозитория внутри резолвера или загрузчика
+func unknownError(err error) error {
+	return resolveError(serverrors.ErrUnknown.Wrap(err))
+}
@@ -0,0 +1,61 @@
+package graphqlapi
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	serverrors "github.com/wozhdeleniye/avito-tech-internship/internal/pkg/errors"
+	"github.com/wozhdeleniye/avito-tech-internship/internal/repo/models"
+)
+
+// MustParseSchema сверяет схему с резолверами: поле без метода или с неподходящим типом - паника при старте сервиса
+func TestSchemaMatchesResolvers(t *testing.T) {
+	api := New(nil, nil, nil, nil)
+
+	// запрос, который не доходит до базы, проверяет и разбор, и ограничение глубины
+	resp := api.Exec(context.Background(), `{ __schema { queryType { name } } }`, "", nil)
+	if len(resp.Errors) > 0 {
+		t.Fatalf("интроспекция: %v", resp.Errors)
+	}
+
+	deep := `{ team(name: "a") { members { user { team { members { user { team { members { user { team { members { user { id } } } } } } } } } } } } }`
+	if resp := api.Exec(context.Background(), deep, "", nil); len(resp.Errors) == 0 {
+		t.Fatal("запрос глубже maxDepth должен отклоняться")
+	}
+}
+
+func TestResolverErrorHidesCause(t *testing.T) {
+	err := resolveError(serverrors.ErrUnknown.Wrap(errors.New("connection refused")))
+	if err.Error() != serverrors.ErrUnknown.Message {
+		t.Fatalf("сообщение клиенту раскрывает причину: %q", err.Error())
+	}
+
+	var ext interface{ Extensions() map[string]any }
+	if !errors.As(err, &ext) || ext.Extensions()["code"] != "UNKNOWN_ERROR" {
+		t.Fatalf("ожидался extensions.code UNKNOWN_ERROR, получено %v", ext)
+	}
+}
+
+func TestGroupByReviewerKeepsOrder(t *testing.T) {
+	alice, bob := &models.User{ID: uuid.New()}, &models.User{ID: uuid.New()}
+	first := &models.PullRequest{PullRequestCustomID: "pr-2", AssignedReviewers: []*models.User{alice, bob}}
+	second := &models.PullRequest{PullRequestCustomID: "pr-1", AssignedReviewers: []*models.User{alice}}
+
+	got := groupByReviewer([]*models.PullRequest{first, second})
+	ids := func(prs []*models.PullRequest) string {
+		out := make([]string, 0, len(prs))
+		for _, pr := range prs {
+			out = append(out, pr.PullRequestCustomID)
+		}
+		return strings.Join(out, ",")
+	}
+	if s := ids(got[alice.ID]); s != "pr-2,pr-1" {
+		t.Errorf("ревью alice: %s", s)
+	}
+	if s := ids(got[bob.ID]); s != "pr-2" {
+		t.Errorf("ревью bob: %s", s)
+	}
+}
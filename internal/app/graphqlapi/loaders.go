@@ -0,0 +1,181 @@
+package graphqlapi
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/graph-gophers/dataloader"
+	"github.com/wozhdeleniye/avito-tech-internship/internal/repo/models"
+)
+
+type loadersKey struct{}
+
+// загрузчики одного запроса: обращения резолверов одного уровня собираются в пакет и уходят в базу одним запросом
+type loaders struct {
+	// user_id → *models.User
+	users *dataloader.Loader
+	// id пользователя → *models.Team, основная команда
+	primaryTeams *dataloader.Loader
+	// id команды → map[uuid.UUID]*models.TeamMembership по id пользователя
+	memberships *dataloader.Loader
+	// "статус/id пользователя" → []*models.PullRequest, где пользователь ревьювер
+	reviews *dataloader.Loader
+	// user_id → int64, назначения на открытые PR
+	assignments *dataloader.Loader
+}
+
+func withLoaders(ctx context.Context, repos repositories) context.Context {
+	l := &loaders{
+		users:        dataloader.NewBatchedLoader(repos.loadUsers),
+		primaryTeams: dataloader.NewBatchedLoader(repos.loadPrimaryTeams),
+		memberships:  dataloader.NewBatchedLoader(repos.loadMemberships),
+		reviews:      dataloader.NewBatchedLoader(repos.loadReviews),
+		assignments:  dataloader.NewBatchedLoader(repos.loadAssignments),
+	}
+	return context.WithValue(ctx, loadersKey{}, l)
+}
+
+func loadersFrom(ctx context.Context) *loaders {
+	return ctx.Value(loadersKey{}).(*loaders)
+}
+
+// значение по ключу; отсутствующее значение - нулевое значение T
+func load[T any](ctx context.Context, l *dataloader.Loader, key string) (T, error) {
+	var value T
+	v, err := l.Load(ctx, dataloader.StringKey(key))()
+	if err != nil {
+		return value, err
+	}
+	value, _ = v.(T)
+	return value, nil
+}
+
+// результаты пакета в порядке ключей, как требует dataloader
+func results[T any](keys dataloader.Keys, values map[string]T) []*dataloader.Result {
+	res := make([]*dataloader.Result, len(keys))
+	for i, key := range keys {
+		res[i] = &dataloader.Result{Data: values[key.String()]}
+	}
+	return res
+}
+
+func failed(keys dataloader.Keys, err error) []*dataloader.Result {
+	err = unknownError(err)
+	res := make([]*dataloader.Result, len(keys))
+	for i := range keys {
+		res[i] = &dataloader.Result{Error: err}
+	}
+	return res
+}
+
+// ключи пакета - id из базы; ключ не по формату uuid не найдётся, как и отсутствующая запись
+func uuids(keys []string) []uuid.UUID {
+	ids := make([]uuid.UUID, 0, len(keys))
+	for _, k := range keys {
+		if id, err := uuid.Parse(k); err == nil {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+func (r repositories) loadUsers(ctx context.Context, keys dataloader.Keys) []*dataloader.Result {
+	users, err := r.users.GetUsersByCustomIDs(ctx, keys.Keys())
+	if err != nil {
+		return failed(keys, err)
+	}
+	byID := make(map[string]*models.User, len(users))
+	for _, u := range users {
+		byID[u.UserCustomID] = u
+	}
+	return results(keys, byID)
+}
+
+func (r repositories) loadPrimaryTeams(ctx context.Context, keys dataloader.Keys) []*dataloader.Result {
+	memberships, err := r.teams.ListPrimaryMemberships(ctx, uuids(keys.Keys()))
+	if err != nil {
+		return failed(keys, err)
+	}
+	teamIDs := make([]uuid.UUID, 0, len(memberships))
+	for _, m := range memberships {
+		teamIDs = append(teamIDs, m.TeamID)
+	}
+	teams, err := r.teams.GetTeamsByIDs(ctx, teamIDs)
+	if err != nil {
+		return failed(keys, err)
+	}
+
+	byID := make(map[uuid.UUID]*models.Team, len(teams))
+	for _, t := range teams {
+		byID[t.ID] = t
+	}
+	byUser := make(map[string]*models.Team, len(memberships))
+	for _, m := range memberships {
+		if t, ok := byID[m.TeamID]; ok {
+			byUser[m.UserID.String()] = t
+		}
+	}
+	return results(keys, byUser)
+}
+
+func (r repositories) loadMemberships(ctx context.Context, keys dataloader.Keys) []*dataloader.Result {
+	memberships, err := r.teams.ListMembershipsByTeamIDs(ctx, uuids(keys.Keys()))
+	if err != nil {
+		return failed(keys, err)
+	}
+	byTeam := make(map[string]map[uuid.UUID]*models.TeamMembership, len(keys))
+	for _, m := range memberships {
+		team := m.TeamID.String()
+		if byTeam[team] == nil {
+			byTeam[team] = make(map[uuid.UUID]*models.TeamMembership)
+		}
+		byTeam[team][m.UserID] = m
+	}
+	return results(keys, byTeam)
+}
+
+func reviewsKey(status string, userID uuid.UUID) string {
+	return status + "/" + userID.String()
+}
+
+// один запрос к базе на каждый статус из пакета
+func (r repositories) loadReviews(ctx context.Context, keys dataloader.Keys) []*dataloader.Result {
+	byStatus := make(map[string][]string)
+	for _, key := range keys.Keys() {
+		status, userID, _ := strings.Cut(key, "/")
+		byStatus[status] = append(byStatus[status], userID)
+	}
+
+	byKey := make(map[string][]*models.PullRequest, len(keys))
+	for status, userIDs := range byStatus {
+		prs, err := r.prs.ListPullRequestsByReviewerIDs(ctx, userIDs, status)
+		if err != nil {
+			return failed(keys, err)
+		}
+		for userID, reviews := range groupByReviewer(prs) {
+			byKey[reviewsKey(status, userID)] = reviews
+		}
+	}
+	return results(keys, byKey)
+}
+
+// PR по id назначенных ревьюверов с сохранением порядка prs
+func groupByReviewer(prs []*models.PullRequest) map[uuid.UUID][]*models.PullRequest {
+	byReviewer := make(map[uuid.UUID][]*models.PullRequest)
+	for _, pr := range prs {
+		for _, reviewer := range pr.AssignedReviewers {
+			byReviewer[reviewer.ID] = append(byReviewer[reviewer.ID], pr)
+		}
+	}
+	return byReviewer
+}
+
+// счётчики считаются по всей организации одним запросом, пакет лишь выбирает нужные
+func (r repositories) loadAssignments(ctx context.Context, keys dataloader.Keys) []*dataloader.Result {
+	counts, err := r.prs.CountAssignmentsPerUser(ctx)
+	if err != nil {
+		return failed(keys, err)
+	}
+	return results(keys, counts)
+}
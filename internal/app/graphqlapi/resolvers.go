@@ -0,0 +1,297 @@
+package graphqlapi
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/graph-gophers/dataloader"
+	graphql "github.com/graph-gophers/graphql-go"
+	serverrors "github.com/wozhdeleniye/avito-tech-internship/internal/pkg/errors"
+	"github.com/wozhdeleniye/avito-tech-internship/internal/pkg/validation"
+	"github.com/wozhdeleniye/avito-tech-internship/internal/repo/models"
+	"github.com/wozhdeleniye/avito-tech-internship/internal/services"
+	"gorm.io/gorm"
+)
+
+type queryResolver struct {
+	repos     repositories
+	prService *services.PReqService
+}
+
+func (q *queryResolver) Team(ctx context.Context, args struct{ Name string }) (*teamResolver, error) {
+	if serr := validation.Field("name", args.Name, validation.TeamNameRule); serr != nil {
+		return nil, resolveError(serr)
+	}
+	team, err := q.repos.teams.FindTeamByName(ctx, args.Name)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, unknownError(err)
+	}
+	return &teamResolver{team: team}, nil
+}
+
+func (q *queryResolver) User(ctx context.Context, args struct{ ID graphql.ID }) (*userResolver, error) {
+	if serr := validation.Field("id", string(args.ID), validation.IDRule); serr != nil {
+		return nil, resolveError(serr)
+	}
+	user, err := load[*models.User](ctx, loadersFrom(ctx).users, string(args.ID))
+	if err != nil || user == nil {
+		return nil, err
+	}
+	return &userResolver{user: user}, nil
+}
+
+// PR_NOT_FOUND - null, неоднозначный pull_request_id - ошибка с кодом PR_AMBIGUOUS
+func (q *queryResolver) PullRequest(ctx context.Context, args struct {
+	ID         graphql.ID
+	Repository *string
+}) (*pullRequestResolver, error) {
+	pr, serr := q.prService.FindPullRequest(ctx, args.Repository, string(args.ID))
+	if serr != nil {
+		if errors.Is(serr, serverrors.ErrPRNotFound) {
+			return nil, nil
+		}
+		return nil, resolveError(serr)
+	}
+	return &pullRequestResolver{pr: pr}, nil
+}
+
+func (q *queryResolver) AssignmentStats(ctx context.Context) (*assignmentStatsResolver, error) {
+	userCounts, serr := q.prService.CountAssignmentsPerUser(ctx)
+	if serr != nil {
+		return nil, resolveError(serr)
+	}
+	prCounts, serr := q.prService.CountAssignmentsPerPR(ctx)
+	if serr != nil {
+		return nil, resolveError(serr)
+	}
+	return &assignmentStatsResolver{perUser: userCounts, perPR: prCounts}, nil
+}
+
+type teamResolver struct {
+	team *models.Team
+}
+
+func (t *teamResolver) Name() string {
+	return t.team.TeamName
+}
+
+// роли и флаг основной команды из членств; участник без записи членства - member
+func (t *teamResolver) Members(ctx context.Context) ([]*teamMemberResolver, error) {
+	memberships, err := load[map[uuid.UUID]*models.TeamMembership](ctx, loadersFrom(ctx).memberships, t.team.ID.String())
+	if err != nil {
+		return nil, err
+	}
+	members := make([]*teamMemberResolver, 0, len(t.team.Members))
+	for _, u := range t.team.Members {
+		m := &teamMemberResolver{user: u, role: models.RoleMember}
+		if membership, ok := memberships[u.ID]; ok {
+			m.role, m.isPrimary = membership.Role, membership.IsPrimary
+		}
+		members = append(members, m)
+	}
+	return members, nil
+}
+
+func (t *teamResolver) Sla() *teamSLAResolver {
+	if t.team.SLAFirstReviewHours <= 0 {
+		return nil
+	}
+	return &teamSLAResolver{team: t.team}
+}
+
+type teamSLAResolver struct {
+	team *models.Team
+}
+
+func (s *teamSLAResolver) FirstReviewHours() int32 {
+	return int32(s.team.SLAFirstReviewHours)
+}
+
+func (s *teamSLAResolver) Action() string {
+	return s.team.SLAAction
+}
+
+type teamMemberResolver struct {
+	user      *models.User
+	role      string
+	isPrimary bool
+}
+
+func (m *teamMemberResolver) User() *userResolver {
+	return &userResolver{user: m.user}
+}
+
+func (m *teamMemberResolver) Role() string {
+	return m.role
+}
+
+func (m *teamMemberResolver) IsPrimary() bool {
+	return m.isPrimary
+}
+
+type userResolver struct {
+	user *models.User
+}
+
+func (u *userResolver) ID() graphql.ID {
+	return graphql.ID(u.user.UserCustomID)
+}
+
+func (u *userResolver) Username() string {
+	return u.user.Nickname
+}
+
+func (u *userResolver) IsActive() bool {
+	return u.user.IsActive
+}
+
+func (u *userResolver) Team(ctx context.Context) (*teamResolver, error) {
+	team, err := load[*models.Team](ctx, loadersFrom(ctx).primaryTeams, u.user.ID.String())
+	if err != nil || team == nil {
+		return nil, err
+	}
+	return &teamResolver{team: team}, nil
+}
+
+func (u *userResolver) Reviews(ctx context.Context, args struct{ Status string }) ([]*pullRequestResolver, error) {
+	prs, err := load[[]*models.PullRequest](ctx, loadersFrom(ctx).reviews, reviewsKey(args.Status, u.user.ID))
+	if err != nil {
+		return nil, err
+	}
+	reviews := make([]*pullRequestResolver, 0, len(prs))
+	for _, pr := range prs {
+		reviews = append(reviews, &pullRequestResolver{pr: pr})
+	}
+	return reviews, nil
+}
+
+func (u *userResolver) AssignmentCount(ctx context.Context) (int32, error) {
+	n, err := load[int64](ctx, loadersFrom(ctx).assignments, u.user.UserCustomID)
+	return int32(n), err
+}
+
+// автор, ревьюверы и репозиторий предзагружены репозиторием PR
+type pullRequestResolver struct {
+	pr *models.PullRequest
+}
+
+func (p *pullRequestResolver) ID() graphql.ID {
+	return graphql.ID(p.pr.PullRequestCustomID)
+}
+
+func (p *pullRequestResolver) Name() string {
+	return p.pr.PullRequestName
+}
+
+func (p *pullRequestResolver) Repository() *string {
+	if p.pr.Repository == nil {
+		return nil
+	}
+	name := p.pr.RepositoryName()
+	return &name
+}
+
+func (p *pullRequestResolver) Status() string {
+	return p.pr.Status
+}
+
+func (p *pullRequestResolver) Author() *userResolver {
+	return &userResolver{user: &p.pr.Author}
+}
+
+func (p *pullRequestResolver) Reviewers() []*userResolver {
+	reviewers := make([]*userResolver, 0, len(p.pr.AssignedReviewers))
+	for _, u := range p.pr.AssignedReviewers {
+		reviewers = append(reviewers, &userResolver{user: u})
+	}
+	return reviewers
+}
+
+func (p *pullRequestResolver) CreatedAt() graphql.Time {
+	return graphql.Time{Time: time.Unix(p.pr.CreatedAt, 0).UTC()}
+}
+
+func (p *pullRequestResolver) MergedAt() *graphql.Time {
+	if p.pr.MergedAt == nil {
+		return nil
+	}
+	return &graphql.Time{Time: time.Unix(*p.pr.MergedAt, 0).UTC()}
+}
+
+type assignmentStatsResolver struct {
+	perUser map[string]int64
+	perPR   map[string]int64
+}
+
+// пользователи загружаются одним пакетом; удалённый после подсчёта пользователь пропускается
+func (s *assignmentStatsResolver) PerUser(ctx context.Context) ([]*userAssignmentsResolver, error) {
+	l := loadersFrom(ctx)
+	ids := sortedKeys(s.perUser)
+	thunks := make([]dataloader.Thunk, len(ids))
+	for i, id := range ids {
+		// счётчик уже посчитан, user.assignmentCount не пойдёт за ним в базу ещё раз
+		l.assignments.Prime(ctx, dataloader.StringKey(id), s.perUser[id])
+		thunks[i] = l.users.Load(ctx, dataloader.StringKey(id))
+	}
+
+	resp := make([]*userAssignmentsResolver, 0, len(ids))
+	for i, id := range ids {
+		v, err := thunks[i]()
+		if err != nil {
+			return nil, err
+		}
+		if user, _ := v.(*models.User); user != nil {
+			resp = append(resp, &userAssignmentsResolver{user: user, count: s.perUser[id]})
+		}
+	}
+	return resp, nil
+}
+
+func (s *assignmentStatsResolver) PerPullRequest() []*pullRequestAssignmentsResolver {
+	resp := make([]*pullRequestAssignmentsResolver, 0, len(s.perPR))
+	for _, key := range sortedKeys(s.perPR) {
+		resp = append(resp, &pullRequestAssignmentsResolver{key: key, count: s.perPR[key]})
+	}
+	return resp
+}
+
+type userAssignmentsResolver struct {
+	user  *models.User
+	count int64
+}
+
+func (u *userAssignmentsResolver) User() *userResolver {
+	return &userResolver{user: u.user}
+}
+
+func (u *userAssignmentsResolver) Count() int32 {
+	return int32(u.count)
+}
+
+type pullRequestAssignmentsResolver struct {
+	key   string
+	count int64
+}
+
+func (p *pullRequestAssignmentsResolver) PullRequestID() graphql.ID {
+	return graphql.ID(p.key)
+}
+
+func (p *pullRequestAssignmentsResolver) Count() int32 {
+	return int32(p.count)
+}
+
+func sortedKeys(m map[string]int64) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
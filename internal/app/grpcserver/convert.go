@@ -0,0 +1,235 @@
+package grpcserver
+
+import (
+	"time"
+
+	openapi "github.com/wozhdeleniye/avito-tech-internship/api"
+	"github.com/wozhdeleniye/avito-tech-internship/api/reviewerpb"
+	"github.com/wozhdeleniye/avito-tech-internship/internal/pkg/ptr"
+	"github.com/wozhdeleniye/avito-tech-internship/internal/repo/models"
+	"github.com/wozhdeleniye/avito-tech-internship/internal/services"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// преобразования между сообщениями reviewerpb и типами openapi, которыми работают сервисы
+
+func teamFromProto(t *reviewerpb.Team) openapi.Team {
+	team := openapi.Team{TeamName: t.GetTeamName(), Members: make([]openapi.TeamMember, 0, len(t.GetMembers()))}
+	for _, m := range t.GetMembers() {
+		member := openapi.TeamMember{UserId: m.GetUserId(), Username: m.GetUsername(), IsActive: m.GetIsActive()}
+		if m.GetRole() != "" {
+			role := openapi.TeamMemberRole(m.GetRole())
+			member.Role = &role
+		}
+		team.Members = append(team.Members, member)
+	}
+	return team
+}
+
+func teamToProto(t *openapi.Team) *reviewerpb.Team {
+	team := &reviewerpb.Team{TeamName: t.TeamName, Members: make([]*reviewerpb.TeamMember, 0, len(t.Members))}
+	for _, m := range t.Members {
+		member := &reviewerpb.TeamMember{UserId: m.UserId, Username: m.Username, IsActive: m.IsActive}
+		if m.Role != nil {
+			member.Role = string(*m.Role)
+		}
+		if m.IsPrimary != nil {
+			member.IsPrimary = *m.IsPrimary
+		}
+		team.Members = append(team.Members, member)
+	}
+	if t.Sla != nil {
+		team.Sla = &reviewerpb.TeamSla{FirstReviewHours: int32(t.Sla.FirstReviewHours), Action: string(t.Sla.Action)}
+	}
+	return team
+}
+
+func teamSyncToProto(r *openapi.TeamSyncResult) *reviewerpb.AddTeamResponse {
+	d := r.Diff
+	diff := &reviewerpb.TeamSyncDiff{
+		TeamCreated:   d.TeamCreated,
+		Created:       d.Created,
+		Attached:      d.Attached,
+		Detached:      d.Detached,
+		Reassignments: reassignmentsToProto(d.Reassignments),
+		NotReassigned: d.NotReassigned,
+	}
+	for _, u := range d.Updated {
+		update := &reviewerpb.TeamMemberUpdate{UserId: u.UserId}
+		for _, f := range u.ChangedFields {
+			update.ChangedFields = append(update.ChangedFields, string(f))
+		}
+		diff.Updated = append(diff.Updated, update)
+	}
+	return &reviewerpb.AddTeamResponse{Team: teamToProto(&r.Team), Diff: diff}
+}
+
+func reassignmentsToProto(in []openapi.Reassignment) []*reviewerpb.Reassignment {
+	out := make([]*reviewerpb.Reassignment, 0, len(in))
+	for _, r := range in {
+		out = append(out, &reviewerpb.Reassignment{
+			PullRequestId: r.PullRequestId,
+			Repository:    r.Repository,
+			OldReviewerId: r.OldReviewerId,
+			NewReviewerId: r.NewReviewerId,
+		})
+	}
+	return out
+}
+
+func teamMembersChangeToProto(c *openapi.TeamMembersChange) *reviewerpb.TeamMembersChange {
+	return &reviewerpb.TeamMembersChange{
+		Team:          teamToProto(&c.Team),
+		Reassignments: reassignmentsToProto(c.Reassignments),
+		NotReassigned: c.NotReassigned,
+	}
+}
+
+func userActivityToProto(c *openapi.UserActivityChange) *reviewerpb.UserActivityChange {
+	return &reviewerpb.UserActivityChange{
+		User: &reviewerpb.User{
+			UserId:   c.User.UserId,
+			Username: c.User.Username,
+			TeamName: c.User.TeamName,
+			IsActive: c.User.IsActive,
+		},
+		Reassignments: reassignmentsToProto(c.Reassignments),
+		NotReassigned: c.NotReassigned,
+		ChangesetId:   c.ChangesetId,
+	}
+}
+
+func pullRequestToProto(pr *openapi.PullRequest) *reviewerpb.PullRequest {
+	return &reviewerpb.PullRequest{
+		PullRequestId:     pr.PullRequestId,
+		Repository:        pr.Repository,
+		PullRequestName:   pr.PullRequestName,
+		AuthorId:          pr.AuthorId,
+		Status:            string(pr.Status),
+		AssignedReviewers: pr.AssignedReviewers,
+		CreatedAt:         timestamp(pr.CreatedAt),
+		MergedAt:          timestamp(pr.MergedAt),
+	}
+}
+
+func pullRequestShortToProto(pr *openapi.PullRequestShort) *reviewerpb.PullRequestShort {
+	return &reviewerpb.PullRequestShort{
+		PullRequestId:   pr.PullRequestId,
+		Repository:      pr.Repository,
+		PullRequestName: pr.PullRequestName,
+		AuthorId:        pr.AuthorId,
+		Status:          string(pr.Status),
+		CreatedAt:       timestamp(pr.CreatedAt),
+	}
+}
+
+func pullRequestDetailToProto(pr *openapi.PullRequestDetail) *reviewerpb.PullRequestDetail {
+	resp := &reviewerpb.PullRequestDetail{
+		PullRequestId:   pr.PullRequestId,
+		Repository:      pr.Repository,
+		PullRequestName: pr.PullRequestName,
+		AuthorId:        pr.AuthorId,
+		Status:          string(pr.Status),
+		CreatedAt:       timestamp(pr.CreatedAt),
+		MergedAt:        timestamp(pr.MergedAt),
+	}
+	for _, r := range pr.Reviewers {
+		resp.Reviewers = append(resp.Reviewers, &reviewerpb.ReviewerAssignment{
+			UserId:      r.UserId,
+			Username:    r.Username,
+			AssignedAt:  timestamppb.New(r.AssignedAt),
+			RespondedAt: timestamp(r.RespondedAt),
+		})
+	}
+	for _, e := range pr.History {
+		resp.History = append(resp.History, &reviewerpb.PullRequestEvent{
+			Event:         string(e.Event),
+			At:            timestamppb.New(e.At),
+			UserId:        e.UserId,
+			OldReviewerId: e.OldReviewerId,
+			Reason:        e.Reason,
+		})
+	}
+	return resp
+}
+
+func statsToProto(userCounts, prCounts, slaBreaches map[string]int64, reviewStats *models.ReviewStats) *reviewerpb.Stats {
+	resp := &reviewerpb.Stats{
+		AssignmentsPerUser:        userCounts,
+		AssignmentsPerPr:          prCounts,
+		SlaBreachesPerTeam:        slaBreaches,
+		TimeToMergePerTeam:        durationPercentiles(reviewStats.TimeToMergePerTeam),
+		TimeToMergePerAuthor:      durationPercentiles(reviewStats.TimeToMergePerAuthor),
+		ReviewerThroughputPerWeek: make(map[string]*reviewerpb.WeeklyCounts, len(reviewStats.ReviewerThroughput)),
+		OpenPrAge:                 make(map[string]int32, len(reviewStats.OpenPRAge)),
+	}
+	for reviewer, weeks := range reviewStats.ReviewerThroughput {
+		counts := &reviewerpb.WeeklyCounts{Weeks: make(map[string]int32, len(weeks))}
+		for week, n := range weeks {
+			counts.Weeks[week] = int32(n)
+		}
+		resp.ReviewerThroughputPerWeek[reviewer] = counts
+	}
+	for bucket, n := range reviewStats.OpenPRAge {
+		resp.OpenPrAge[bucket] = int32(n)
+	}
+	for _, p := range reviewStats.ReassignmentTrend {
+		resp.ReassignmentTrend = append(resp.ReassignmentTrend, &reviewerpb.ReassignmentTrendPoint{
+			WeekStart:           p.WeekStart,
+			Reassignments:       int32(p.Reassignments),
+			PullRequestsCreated: int32(p.PullRequestsCreated),
+			ReassignmentsPerPr:  p.ReassignmentsPerPR,
+		})
+	}
+	return resp
+}
+
+func durationPercentiles(in map[string]models.DurationPercentiles) map[string]*reviewerpb.DurationPercentiles {
+	out := make(map[string]*reviewerpb.DurationPercentiles, len(in))
+	for k, p := range in {
+		out[k] = &reviewerpb.DurationPercentiles{
+			Count:    int32(p.Count),
+			P50Hours: p.P50Hours,
+			P90Hours: p.P90Hours,
+			P99Hours: p.P99Hours,
+		}
+	}
+	return out
+}
+
+func reviewEventToProto(e services.Event) *reviewerpb.ReviewEvent {
+	resp := &reviewerpb.ReviewEvent{
+		Id:              e.ID,
+		Type:            e.Type,
+		At:              timestamppb.New(e.At),
+		PullRequestId:   e.PullRequestID,
+		PullRequestName: e.PullRequestName,
+		AuthorId:        e.AuthorID,
+		Repository:      ptr.Optional(e.Repository),
+		TeamName:        ptr.Optional(e.TeamName),
+		ReviewerId:      ptr.Optional(e.ReviewerID),
+		Replaces:        ptr.Optional(e.Replaces),
+		ReplacedBy:      ptr.Optional(e.ReplacedBy),
+		Reason:          ptr.Optional(e.Reason),
+		Deadline:        timestamp(e.Deadline),
+	}
+	if e.Type == services.EventPullRequestMerged {
+		resp.AssignedReviewers = append(make([]string, 0, len(e.Reviewers)), e.Reviewers...)
+	}
+	return resp
+}
+
+func timestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
+
+func timeOf(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+	t := ts.AsTime()
+	return &t
+}
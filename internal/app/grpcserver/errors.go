@@ -0,0 +1,75 @@
+package grpcserver
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"strings"
+
+	serverrors "github.com/wozhdeleniye/avito-tech-internship/internal/pkg/errors"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// домен ErrorInfo: по нему клиент отличает коды сервиса от ошибок транспорта
+const errorDomain = "reviewer.v1"
+
+// единственное место, где ошибка сервиса превращается в статус gRPC, как WriteError для REST: код ErrorResponse
+// уходит в ErrorInfo.reason, ошибки по полям - в BadRequest. Причина (Err) клиенту не отдаётся, а пишется в журнал
+func statusError(ctx context.Context, serr *serverrors.ServiceError) error {
+	code := statusCode(serr)
+	if code == codes.Internal || serr.Err != nil {
+		method, _ := grpc.Method(ctx)
+		log.Printf("grpc %s: %s %v", method, code, serr)
+	}
+
+	st := status.New(code, serr.Message)
+	info := &errdetails.ErrorInfo{Reason: string(serr.Code), Domain: errorDomain}
+	var withDetails *status.Status
+	var err error
+	if len(serr.Details) > 0 {
+		violations := make([]*errdetails.BadRequest_FieldViolation, 0, len(serr.Details))
+		for _, d := range serr.Details {
+			violations = append(violations, &errdetails.BadRequest_FieldViolation{Field: d.Field, Description: d.Message})
+		}
+		withDetails, err = st.WithDetails(info, &errdetails.BadRequest{FieldViolations: violations})
+	} else {
+		withDetails, err = st.WithDetails(info)
+	}
+	if err != nil {
+		return st.Err()
+	}
+	return withDetails.Err()
+}
+
+// статус gRPC по HTTP-коду ошибки; "уже существует" (TEAM_EXISTS отдаётся в REST как 400) отделяется по коду ошибки
+func statusCode(serr *serverrors.ServiceError) codes.Code {
+	if strings.HasSuffix(string(serr.Code), "_EXISTS") {
+		return codes.AlreadyExists
+	}
+	switch serr.HTTPCode {
+	case http.StatusBadRequest, http.StatusNotAcceptable:
+		return codes.InvalidArgument
+	case http.StatusUnauthorized:
+		return codes.Unauthenticated
+	case http.StatusForbidden:
+		return codes.PermissionDenied
+	case http.StatusNotFound:
+		return codes.NotFound
+	case http.StatusMethodNotAllowed:
+		return codes.Unimplemented
+	case http.StatusConflict:
+		if serr.Code == serverrors.ErrIdempotencyInProgress.Code {
+			return codes.Aborted
+		}
+		return codes.FailedPrecondition
+	case http.StatusUnprocessableEntity:
+		return codes.FailedPrecondition
+	case http.StatusTooManyRequests:
+		return codes.ResourceExhausted
+	default:
+		return codes.Internal
+	}
+}
@@ -0,0 +1,64 @@
+package grpcserver
+
+import (
+	"context"
+	"testing"
+
+	openapi "github.com/wozhdeleniye/avito-tech-internship/api"
+	serverrors "github.com/wozhdeleniye/avito-tech-internship/internal/pkg/errors"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestStatusCodeCoversCatalogue(t *testing.T) {
+	want := map[*serverrors.ServiceError]codes.Code{
+		serverrors.ErrInvalidRequest:        codes.InvalidArgument,
+		serverrors.ErrInvalidFormat:         codes.InvalidArgument,
+		serverrors.ErrTeamExists:            codes.AlreadyExists,
+		serverrors.ErrPRExists:              codes.AlreadyExists,
+		serverrors.ErrInvalidToken:          codes.Unauthenticated,
+		serverrors.ErrTeamNotFound:          codes.NotFound,
+		serverrors.ErrPRMerged:              codes.FailedPrecondition,
+		serverrors.ErrIdempotencyKeyReused:  codes.FailedPrecondition,
+		serverrors.ErrIdempotencyInProgress: codes.Aborted,
+		serverrors.ErrRateLimited:           codes.ResourceExhausted,
+		serverrors.ErrUnknown:               codes.Internal,
+	}
+	for serr, code := range want {
+		if got := statusCode(serr); got != code {
+			t.Errorf("%s: ожидался %s, получен %s", serr.Code, code, got)
+		}
+	}
+	// каждая ошибка каталога, кроме UNKNOWN_ERROR, должна получить собственный статус, а не INTERNAL
+	for _, serr := range serverrors.Catalogue {
+		if serr != serverrors.ErrUnknown && statusCode(serr) == codes.Internal {
+			t.Errorf("%s отображается в INTERNAL", serr.Code)
+		}
+	}
+}
+
+func TestStatusErrorCarriesCodeAndFieldErrors(t *testing.T) {
+	serr := serverrors.ErrInvalidRequest.WithDetails([]openapi.FieldError{{Field: "user_id", Rule: "required", Message: "is required"}})
+	st := status.Convert(statusError(context.Background(), serr))
+	if st.Code() != codes.InvalidArgument || st.Message() != serr.Message {
+		t.Fatalf("неожиданный статус %s %q", st.Code(), st.Message())
+	}
+
+	var info *errdetails.ErrorInfo
+	var badRequest *errdetails.BadRequest
+	for _, d := range st.Details() {
+		switch d := d.(type) {
+		case *errdetails.ErrorInfo:
+			info = d
+		case *errdetails.BadRequest:
+			badRequest = d
+		}
+	}
+	if info == nil || info.Reason != "INVALID_REQUEST" || info.Domain != errorDomain {
+		t.Fatalf("ожидался ErrorInfo с INVALID_REQUEST, получено %v", info)
+	}
+	if badRequest == nil || len(badRequest.FieldViolations) != 1 || badRequest.FieldViolations[0].Field != "user_id" {
+		t.Fatalf("ожидалось нарушение поля user_id, получено %v", badRequest)
+	}
+}
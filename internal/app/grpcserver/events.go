@@ -0,0 +1,45 @@
+package grpcserver
+
+import (
+	"github.com/wozhdeleniye/avito-tech-internship/api/reviewerpb"
+	serverrors "github.com/wozhdeleniye/avito-tech-internship/internal/pkg/errors"
+	"github.com/wozhdeleniye/avito-tech-internship/internal/services"
+)
+
+// Поток событий о назначениях пользователя или команды; продолжение по last_event_id, как у /events/stream.
+// Поток завершается без ошибки, когда подписку закрыли остановка сервиса или переполнение буфера
+func (s *Server) StreamEvents(req *reviewerpb.StreamEventsRequest, stream reviewerpb.ReviewerService_StreamEventsServer) error {
+	ctx := stream.Context()
+	filter := services.EventFilter{UserID: req.GetUserId(), TeamName: req.GetTeamName()}
+	if filter.UserID == "" && filter.TeamName == "" {
+		return statusError(ctx, serverrors.ErrInvalidRequest.WithMessage("user_id or team_name is required"))
+	}
+
+	sub, missed, resumed := s.Events.Subscribe(ctx, filter, req.GetLastEventId())
+	defer sub.Close()
+
+	if !resumed {
+		if err := stream.Send(&reviewerpb.StreamEventsResponse{ResetRequired: true}); err != nil {
+			return err
+		}
+	}
+	for _, e := range missed {
+		if err := stream.Send(&reviewerpb.StreamEventsResponse{Event: reviewEventToProto(e)}); err != nil {
+			return err
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case e, ok := <-sub.Events():
+			if !ok {
+				return nil
+			}
+			if err := stream.Send(&reviewerpb.StreamEventsResponse{Event: reviewEventToProto(e)}); err != nil {
+				return err
+			}
+		}
+	}
+}
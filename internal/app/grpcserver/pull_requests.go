@@ -0,0 +1,118 @@
+package grpcserver
+
+import (
+	"context"
+
+	openapi "github.com/wozhdeleniye/avito-tech-internship/api"
+	"github.com/wozhdeleniye/avito-tech-internship/api/reviewerpb"
+	serverrors "github.com/wozhdeleniye/avito-tech-internship/internal/pkg/errors"
+	"github.com/wozhdeleniye/avito-tech-internship/internal/pkg/validation"
+)
+
+// Создать PR и назначить ревьюверов по политике репозитория
+func (s *Server) CreatePullRequest(ctx context.Context, req *reviewerpb.CreatePullRequestRequest) (*reviewerpb.PullRequest, error) {
+	body := openapi.PostPullRequestCreateJSONBody{
+		PullRequestId:   req.GetPullRequestId(),
+		PullRequestName: req.GetPullRequestName(),
+		AuthorId:        req.GetAuthorId(),
+		Repository:      req.Repository,
+	}
+	if serr := validation.Struct(body); serr != nil {
+		return nil, statusError(ctx, serr)
+	}
+
+	pr, serr := s.PRService.CreatePullRequest(ctx, body)
+	if serr != nil {
+		return nil, statusError(ctx, serr)
+	}
+	if pr == nil {
+		return nil, statusError(ctx, serverrors.ErrUserNotFound.WithMessage("author or team not found"))
+	}
+	return pullRequestToProto(pr), nil
+}
+
+// Получить PR с ревьюверами, временными метками и историей
+func (s *Server) GetPullRequest(ctx context.Context, req *reviewerpb.PullRequestRef) (*reviewerpb.PullRequestDetail, error) {
+	body := openapi.PostPullRequestMergeJSONBody{PullRequestId: req.GetPullRequestId(), Repository: req.Repository}
+	if serr := validation.Struct(body); serr != nil {
+		return nil, statusError(ctx, serr)
+	}
+
+	pr, serr := s.PRService.GetPullRequest(ctx, body.Repository, body.PullRequestId)
+	if serr != nil {
+		return nil, statusError(ctx, serr)
+	}
+	return pullRequestDetailToProto(pr), nil
+}
+
+// Пометить PR как MERGED (идемпотентная операция)
+func (s *Server) MergePullRequest(ctx context.Context, req *reviewerpb.PullRequestRef) (*reviewerpb.PullRequest, error) {
+	body := openapi.PostPullRequestMergeJSONBody{PullRequestId: req.GetPullRequestId(), Repository: req.Repository}
+	if serr := validation.Struct(body); serr != nil {
+		return nil, statusError(ctx, serr)
+	}
+
+	pr, serr := s.PRService.MarkPullReqAsMerged(ctx, body.Repository, body.PullRequestId)
+	if serr != nil {
+		return nil, statusError(ctx, serr)
+	}
+	if pr == nil {
+		return nil, statusError(ctx, serverrors.ErrPRNotFound)
+	}
+	return pullRequestToProto(pr), nil
+}
+
+// Переназначить конкретного ревьювера на другого из его команды
+func (s *Server) ReassignReviewer(ctx context.Context, req *reviewerpb.ReassignReviewerRequest) (*reviewerpb.ReassignReviewerResponse, error) {
+	body := openapi.PostPullRequestReassignJSONBody{PullRequestId: req.GetPullRequestId(), Repository: req.Repository, OldUserId: req.GetOldUserId()}
+	if serr := validation.Struct(body); serr != nil {
+		return nil, statusError(ctx, serr)
+	}
+
+	resp, serr := s.PRService.ReassignReviewer(ctx, body.Repository, body.PullRequestId, body.OldUserId)
+	if serr != nil {
+		return nil, statusError(ctx, serr)
+	}
+	if resp == nil {
+		return nil, statusError(ctx, serverrors.ErrPRNotFound.WithMessage("pull request or user not found"))
+	}
+	return &reviewerpb.ReassignReviewerResponse{Pr: pullRequestToProto(&resp.PullRequest), ReplacedBy: resp.NewReviewerID}, nil
+}
+
+// Отметить ответ ревьювера по PR
+func (s *Server) SubmitReview(ctx context.Context, req *reviewerpb.SubmitReviewRequest) (*reviewerpb.PullRequestDetail, error) {
+	body := openapi.PostPullRequestReviewJSONBody{PullRequestId: req.GetPullRequestId(), Repository: req.Repository, UserId: req.GetUserId()}
+	if serr := validation.Struct(body); serr != nil {
+		return nil, statusError(ctx, serr)
+	}
+
+	pr, serr := s.PRService.SubmitReview(ctx, body.Repository, body.PullRequestId, body.UserId)
+	if serr != nil {
+		return nil, statusError(ctx, serr)
+	}
+	return pullRequestDetailToProto(pr), nil
+}
+
+// Статистика назначений, нарушений SLA и метрики по времени организации запроса
+func (s *Server) GetStats(ctx context.Context, req *reviewerpb.GetStatsRequest) (*reviewerpb.Stats, error) {
+	userCounts, serr := s.PRService.CountAssignmentsPerUser(ctx)
+	if serr != nil {
+		return nil, statusError(ctx, serr)
+	}
+
+	prCounts, serr := s.PRService.CountAssignmentsPerPR(ctx)
+	if serr != nil {
+		return nil, statusError(ctx, serr)
+	}
+
+	slaBreaches, serr := s.SLAService.CountBreachesPerTeam(ctx)
+	if serr != nil {
+		return nil, statusError(ctx, serr)
+	}
+
+	reviewStats, serr := s.StatsService.ReviewStats(ctx, timeOf(req.GetFrom()), timeOf(req.GetTo()))
+	if serr != nil {
+		return nil, statusError(ctx, serr)
+	}
+	return statsToProto(userCounts, prCounts, slaBreaches, reviewStats), nil
+}
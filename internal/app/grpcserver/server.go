@@ -0,0 +1,108 @@
+package grpcserver
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+	"strings"
+
+	"github.com/wozhdeleniye/avito-tech-internship/api/reviewerpb"
+	serverrors "github.com/wozhdeleniye/avito-tech-internship/internal/pkg/errors"
+	"github.com/wozhdeleniye/avito-tech-internship/internal/services"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/reflection"
+)
+
+// реализация reviewerpb.ReviewerServiceServer поверх тех же сервисов, что и REST API
+type Server struct {
+	reviewerpb.UnimplementedReviewerServiceServer
+
+	PRService    *services.PReqService
+	TeamService  *services.TeamService
+	SLAService   *services.SLAService
+	StatsService *services.StatsService
+	Events       *services.EventBus
+}
+
+// gRPC-сервер с ReviewerService и server reflection. Организация и инициатор запроса берутся из метаданных
+// так же, как в REST из заголовков: authorization, x-organization и user-id
+func New(srv *Server, organizationService *services.OrganizationService) *grpc.Server {
+	s := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(recoverUnary, requestContextUnary(organizationService)),
+		grpc.ChainStreamInterceptor(recoverStream, requestContextStream(organizationService)),
+	)
+	reviewerpb.RegisterReviewerServiceServer(s, srv)
+	reflection.Register(s)
+	return s
+}
+
+// паника в обработчике отдаётся клиенту как INTERNAL, а не обрывом соединения
+func recoverUnary(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+	defer func() {
+		if rec := recover(); rec != nil {
+			err = statusError(ctx, serverrors.ErrUnknown.Wrap(fmt.Errorf("panic: %v\n%s", rec, debug.Stack())))
+		}
+	}()
+	return handler(ctx, req)
+}
+
+func recoverStream(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+	defer func() {
+		if rec := recover(); rec != nil {
+			err = statusError(ss.Context(), serverrors.ErrUnknown.Wrap(fmt.Errorf("panic: %v\n%s", rec, debug.Stack())))
+		}
+	}()
+	return handler(srv, ss)
+}
+
+func requestContextUnary(organizationService *services.OrganizationService) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, serr := requestContext(ctx, organizationService)
+		if serr != nil {
+			return nil, statusError(ctx, serr)
+		}
+		return handler(ctx, req)
+	}
+}
+
+func requestContextStream(organizationService *services.OrganizationService) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, serr := requestContext(ss.Context(), organizationService)
+		if serr != nil {
+			return statusError(ctx, serr)
+		}
+		return handler(srv, &serverStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// организация из токена доступа или x-organization и инициатор из user-id, как в OrganizationMiddleware и ActorMiddleware
+func requestContext(ctx context.Context, organizationService *services.OrganizationService) (context.Context, *serverrors.ServiceError) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if actor := first(md, "user-id"); actor != "" {
+		ctx = services.WithActor(ctx, actor)
+	}
+	token, _ := strings.CutPrefix(first(md, "authorization"), "Bearer ")
+	org, serr := organizationService.ResolveOrganization(ctx, token, first(md, "x-organization"))
+	if serr != nil {
+		return ctx, serr
+	}
+	return services.WithOrganization(ctx, org), nil
+}
+
+func first(md metadata.MD, key string) string {
+	if values := md.Get(key); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+// поток с контекстом, дополненным организацией и инициатором
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,193 @@
+package grpcserver
+
+import (
+	"context"
+
+	openapi "github.com/wozhdeleniye/avito-tech-internship/api"
+	"github.com/wozhdeleniye/avito-tech-internship/api/reviewerpb"
+	serverrors "github.com/wozhdeleniye/avito-tech-internship/internal/pkg/errors"
+	"github.com/wozhdeleniye/avito-tech-internship/internal/pkg/validation"
+	"github.com/wozhdeleniye/avito-tech-internship/internal/repo/models"
+)
+
+// Создать команду с участниками или синхронизировать состав существующей
+func (s *Server) AddTeam(ctx context.Context, req *reviewerpb.AddTeamRequest) (*reviewerpb.AddTeamResponse, error) {
+	team := teamFromProto(req.GetTeam())
+	if serr := validation.Struct(team); serr != nil {
+		return nil, statusError(ctx, serr)
+	}
+
+	result, serr := s.TeamService.SyncTeam(ctx, team, req.GetPrune(), req.GetDryRun())
+	if serr != nil {
+		return nil, statusError(ctx, serr)
+	}
+	return teamSyncToProto(result), nil
+}
+
+// Получить команду с участниками
+func (s *Server) GetTeam(ctx context.Context, req *reviewerpb.GetTeamRequest) (*reviewerpb.Team, error) {
+	if serr := validation.Field("team_name", req.GetTeamName(), validation.TeamNameRule); serr != nil {
+		return nil, statusError(ctx, serr)
+	}
+
+	team, serr := s.TeamService.GetTeamQuery(ctx, req.GetTeamName())
+	if serr != nil {
+		return nil, statusError(ctx, serr)
+	}
+	if team == nil {
+		return nil, statusError(ctx, serverrors.ErrTeamNotFound)
+	}
+	return teamToProto(team), nil
+}
+
+// Добавить существующих пользователей в команду
+func (s *Server) AddTeamMembers(ctx context.Context, req *reviewerpb.AddTeamMembersRequest) (*reviewerpb.Team, error) {
+	body := openapi.PostTeamAddMembersJSONBody{TeamName: req.GetTeamName(), UserIds: req.GetUserIds()}
+	if req.Role != nil {
+		role := openapi.TeamMemberRole(req.GetRole())
+		body.Role = &role
+	}
+	if serr := validation.Struct(body); serr != nil {
+		return nil, statusError(ctx, serr)
+	}
+
+	team, serr := s.TeamService.AddMembers(ctx, body.TeamName, body.UserIds, body.Role)
+	if serr != nil {
+		return nil, statusError(ctx, serr)
+	}
+	return teamToProto(team), nil
+}
+
+// Исключить пользователей из команды с переназначением их открытых ревью внутри команды
+func (s *Server) RemoveTeamMembers(ctx context.Context, req *reviewerpb.RemoveTeamMembersRequest) (*reviewerpb.TeamMembersChange, error) {
+	body := openapi.PostTeamRemoveMembersJSONBody{TeamName: req.GetTeamName(), UserIds: req.GetUserIds()}
+	if serr := validation.Struct(body); serr != nil {
+		return nil, statusError(ctx, serr)
+	}
+
+	change, serr := s.TeamService.RemoveMembers(ctx, body.TeamName, body.UserIds)
+	if serr != nil {
+		return nil, statusError(ctx, serr)
+	}
+	return teamMembersChangeToProto(change), nil
+}
+
+// Перевести пользователя в другую команду с переназначением его открытых ревью внутри старой команды
+func (s *Server) MoveTeamMember(ctx context.Context, req *reviewerpb.MoveTeamMemberRequest) (*reviewerpb.TeamMembersChange, error) {
+	body := openapi.PostTeamMoveMemberJSONBody{UserId: req.GetUserId(), ToTeamName: req.GetToTeamName(), FromTeamName: req.FromTeamName}
+	if serr := validation.Struct(body); serr != nil {
+		return nil, statusError(ctx, serr)
+	}
+
+	change, serr := s.TeamService.MoveMember(ctx, body.UserId, body.ToTeamName, body.FromTeamName)
+	if serr != nil {
+		return nil, statusError(ctx, serr)
+	}
+	return teamMembersChangeToProto(change), nil
+}
+
+// Переименовать команду
+func (s *Server) RenameTeam(ctx context.Context, req *reviewerpb.RenameTeamRequest) (*reviewerpb.Team, error) {
+	body := openapi.PostTeamRenameJSONBody{TeamName: req.GetTeamName(), NewTeamName: req.GetNewTeamName()}
+	if serr := validation.Struct(body); serr != nil {
+		return nil, statusError(ctx, serr)
+	}
+
+	team, serr := s.TeamService.RenameTeam(ctx, body.TeamName, body.NewTeamName)
+	if serr != nil {
+		return nil, statusError(ctx, serr)
+	}
+	return teamToProto(team), nil
+}
+
+// Задать SLA первого ответа ревьювера для команды
+func (s *Server) SetTeamSla(ctx context.Context, req *reviewerpb.SetTeamSlaRequest) (*reviewerpb.Team, error) {
+	body := openapi.PostTeamSetSlaJSONBody{TeamName: req.GetTeamName(), FirstReviewHours: int(req.GetFirstReviewHours()), Action: openapi.SlaAction(req.GetAction())}
+	if serr := validation.Struct(body); serr != nil {
+		return nil, statusError(ctx, serr)
+	}
+
+	team, serr := s.TeamService.SetSLA(ctx, body.TeamName, body.FirstReviewHours, body.Action)
+	if serr != nil {
+		return nil, statusError(ctx, serr)
+	}
+	return teamToProto(team), nil
+}
+
+// Удалить пустую команду
+func (s *Server) DeleteTeam(ctx context.Context, req *reviewerpb.DeleteTeamRequest) (*reviewerpb.DeleteTeamResponse, error) {
+	body := openapi.PostTeamDeleteJSONBody{TeamName: req.GetTeamName()}
+	if serr := validation.Struct(body); serr != nil {
+		return nil, statusError(ctx, serr)
+	}
+
+	if serr := s.TeamService.DeleteTeam(ctx, body.TeamName); serr != nil {
+		return nil, statusError(ctx, serr)
+	}
+	return &reviewerpb.DeleteTeamResponse{TeamName: body.TeamName}, nil
+}
+
+// Установить флаг активности пользователя
+func (s *Server) SetUserActive(ctx context.Context, req *reviewerpb.SetUserActiveRequest) (*reviewerpb.UserActivityChange, error) {
+	body := openapi.PostUsersSetIsActiveJSONBody{UserId: req.GetUserId(), IsActive: req.GetIsActive()}
+	if serr := validation.Struct(body); serr != nil {
+		return nil, statusError(ctx, serr)
+	}
+
+	change, serr := s.TeamService.SetUserActive(ctx, body.UserId, body.IsActive, req.GetReassignReviews())
+	if serr != nil {
+		return nil, statusError(ctx, serr)
+	}
+	if change == nil {
+		return nil, statusError(ctx, serverrors.ErrUserNotFound)
+	}
+	return userActivityToProto(change), nil
+}
+
+// PR, где пользователь назначен ревьювером
+func (s *Server) GetUserReviews(ctx context.Context, req *reviewerpb.GetUserReviewsRequest) (*reviewerpb.GetUserReviewsResponse, error) {
+	if serr := validation.Field("user_id", req.GetUserId(), validation.IDRule); serr != nil {
+		return nil, statusError(ctx, serr)
+	}
+	if serr := validation.Field("status", req.GetStatus(), "omitempty,oneof=OPEN MERGED"); serr != nil {
+		return nil, statusError(ctx, serr)
+	}
+	if serr := validation.Field("sort", req.GetSort(), "omitempty,oneof=created_at_desc created_at_asc name_asc name_desc"); serr != nil {
+		return nil, statusError(ctx, serr)
+	}
+
+	params := openapi.GetUsersGetReviewParams{
+		UserId:      req.GetUserId(),
+		Cursor:      req.Cursor,
+		AuthorId:    req.AuthorId,
+		TeamName:    req.TeamName,
+		CreatedFrom: timeOf(req.GetCreatedFrom()),
+		CreatedTo:   timeOf(req.GetCreatedTo()),
+	}
+	if req.Limit != nil {
+		limit := int(req.GetLimit())
+		params.Limit = &limit
+	}
+	if req.Status != nil {
+		status := openapi.PullRequestStatusFilter(req.GetStatus())
+		params.Status = &status
+	}
+	if req.Sort != nil {
+		sort := openapi.PullRequestSort(req.GetSort())
+		params.Sort = &sort
+	}
+
+	search, serr := s.PRService.GetPullReqsByReviever(ctx, params)
+	if serr != nil {
+		return nil, statusError(ctx, serr)
+	}
+	if search == nil {
+		search = &models.PullRequestSearch{Author: params.UserId}
+	}
+
+	resp := &reviewerpb.GetUserReviewsResponse{UserId: search.Author, NextCursor: search.NextCursor}
+	for _, pr := range search.PullRequest {
+		resp.PullRequests = append(resp.PullRequests, pullRequestShortToProto(pr))
+	}
+	return resp, nil
+}
@@ -2,47 +2,81 @@ package handlers
 
 import (
 	"encoding/json"
+	"io"
+	"mime"
 	"net/http"
+	"strings"
+	"time"
 
 	openapi "github.com/wozhdeleniye/avito-tech-internship/api"
+	serviceerrors "github.com/wozhdeleniye/avito-tech-internship/internal/pkg/errors"
+	"github.com/wozhdeleniye/avito-tech-internship/internal/pkg/ptr"
+	"github.com/wozhdeleniye/avito-tech-internship/internal/repo/models"
 	"github.com/wozhdeleniye/avito-tech-internship/internal/services"
 )
 
 type AdminAPI struct {
-	PRService   *services.PReqService
-	TeamService *services.TeamService
+	PRService           *services.PReqService
+	TeamService         *services.TeamService
+	AuditService        *services.AuditService
+	SLAService          *services.SLAService
+	StatsService        *services.StatsService
+	ExportService       *services.ExportService
+	ImportService       *services.ImportService
+	JobService          *services.JobService
+	ChangesetService    *services.ChangesetService
+	OrganizationService *services.OrganizationService
 }
 
+// максимальный размер файла импорта
+const maxImportSize = 10 << 20
+
 // GET /admin/stats
-// Статистика по количеству назначений ревьюером на пользователя и количество ревьюеров на PR
-func (h AdminAPI) GetAdminStats(w http.ResponseWriter, r *http.Request) {
+// Статистика по количеству назначений ревьюером на пользователя, количеству ревьюеров на PR и нарушениям SLA по командам,
+// а также метрики по времени (время до merge, пропускная способность ревьюверов, возраст открытых PR, тренд переназначений)
+// за диапазон from, to (RFC3339)
+func (h AdminAPI) GetAdminStats(w http.ResponseWriter, r *http.Request, params openapi.GetAdminStatsParams) {
 	userCounts, serr := h.PRService.CountAssignmentsPerUser(r.Context())
 	if serr != nil {
-		w.Header().Set("Content-Type", "application/json")
-		status := serr.HTTPCode
-		if status == 0 {
-			status = http.StatusInternalServerError
-		}
-		w.WriteHeader(status)
-		_ = json.NewEncoder(w).Encode(openapi.ErrorResponse{Error: ErrorConstructor(serr.Code, serr.Message)})
+		WriteError(w, r, serr)
 		return
 	}
 
 	prCounts, serr := h.PRService.CountAssignmentsPerPR(r.Context())
 	if serr != nil {
-		w.Header().Set("Content-Type", "application/json")
-		status := serr.HTTPCode
-		if status == 0 {
-			status = http.StatusInternalServerError
-		}
-		w.WriteHeader(status)
-		_ = json.NewEncoder(w).Encode(openapi.ErrorResponse{Error: ErrorConstructor(serr.Code, serr.Message)})
+		WriteError(w, r, serr)
 		return
 	}
 
-	resp := map[string]interface{}{
-		"assignments_per_user": userCounts,
-		"assignments_per_pr":   prCounts,
+	slaBreaches, serr := h.SLAService.CountBreachesPerTeam(r.Context())
+	if serr != nil {
+		WriteError(w, r, serr)
+		return
+	}
+
+	reviewStats, serr := h.StatsService.ReviewStats(r.Context(), params.From, params.To)
+	if serr != nil {
+		WriteError(w, r, serr)
+		return
+	}
+
+	resp := openapi.AdminStats{
+		AssignmentsPerUser:        userCounts,
+		AssignmentsPerPr:          prCounts,
+		SlaBreachesPerTeam:        slaBreaches,
+		TimeToMergePerTeam:        durationPercentiles(reviewStats.TimeToMergePerTeam),
+		TimeToMergePerAuthor:      durationPercentiles(reviewStats.TimeToMergePerAuthor),
+		ReviewerThroughputPerWeek: reviewStats.ReviewerThroughput,
+		OpenPrAge:                 reviewStats.OpenPRAge,
+		ReassignmentTrend:         make([]openapi.ReassignmentTrendPoint, 0, len(reviewStats.ReassignmentTrend)),
+	}
+	for _, p := range reviewStats.ReassignmentTrend {
+		resp.ReassignmentTrend = append(resp.ReassignmentTrend, openapi.ReassignmentTrendPoint{
+			WeekStart:           p.WeekStart,
+			Reassignments:       p.Reassignments,
+			PullRequestsCreated: p.PullRequestsCreated,
+			ReassignmentsPerPr:  p.ReassignmentsPerPR,
+		})
 	}
 
 	w.Header().Set("Content-Type", "application/json")
@@ -51,30 +85,92 @@ func (h AdminAPI) GetAdminStats(w http.ResponseWriter, r *http.Request) {
 }
 
 // POST /admin/team/deactivate
-// Деактивирует всех участников выбранной команды и переназначает все PR на участников новой команды
+// Ставит в очередь задачу массовой деактивации участников, для которых выбранная команда основная,
+// с переназначением их PR на участников новой команды; dry_run - предпросмотр без сохранения
 func (h AdminAPI) PostAdminTeamDeactivate(w http.ResponseWriter, r *http.Request) {
-	var req struct {
-		OldTeamName string `json:"old_team_name"`
-		NewTeamName string `json:"new_team_name"`
+	var req openapi.PostAdminTeamDeactivateJSONBody
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+	job, serr := h.JobService.SubmitMassDeactivation(r.Context(), req.OldTeamName, req.NewTeamName, req.DryRun != nil && *req.DryRun)
+	if serr != nil {
+		WriteError(w, r, serr)
+		return
 	}
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		http.Error(w, "invalid request body", http.StatusBadRequest)
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Location", "/api/admin/jobs/"+job.Id)
+	w.WriteHeader(http.StatusAccepted)
+	_ = json.NewEncoder(w).Encode(job)
+}
+
+// GET /admin/organizations
+// Все организации сервиса для служебного токена, иначе только организация запроса
+func (h AdminAPI) GetAdminOrganizations(w http.ResponseWriter, r *http.Request) {
+	orgs, serr := h.OrganizationService.ListOrganizations(r.Context(), bearerToken(r))
+	if serr != nil {
+		WriteError(w, r, serr)
 		return
 	}
-	if req.OldTeamName == "" || req.NewTeamName == "" {
-		http.Error(w, "old_team_name and new_team_name are required", http.StatusBadRequest)
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(map[string][]openapi.Organization{"organizations": orgs})
+}
+
+// POST /admin/organizations
+// Создать организацию (только служебным токеном); её данные доступны по заголовку X-Organization или claim org токена
+func (h AdminAPI) PostAdminOrganizations(w http.ResponseWriter, r *http.Request) {
+	var req openapi.Organization
+	if !decodeJSON(w, r, &req) {
 		return
 	}
 
-	result, serr := h.TeamService.MassDeactivateTeam(r.Context(), req.OldTeamName, req.NewTeamName)
+	org, serr := h.OrganizationService.CreateOrganization(r.Context(), bearerToken(r), req)
 	if serr != nil {
-		w.Header().Set("Content-Type", "application/json")
-		status := serr.HTTPCode
-		if status == 0 {
-			status = http.StatusInternalServerError
-		}
-		w.WriteHeader(status)
-		_ = json.NewEncoder(w).Encode(openapi.ErrorResponse{Error: ErrorConstructor(serr.Code, serr.Message)})
+		WriteError(w, r, serr)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	_ = json.NewEncoder(w).Encode(map[string]*openapi.Organization{"organization": org})
+}
+
+// GET /admin/jobs/{job_id}
+// Статус, прогресс и исходы по PR фоновой задачи
+func (h AdminAPI) GetAdminJobsJobId(w http.ResponseWriter, r *http.Request, jobID string) {
+	job, serr := h.JobService.GetJob(r.Context(), jobID)
+	if serr != nil {
+		WriteError(w, r, serr)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(job)
+}
+
+// GET /admin/changesets/{changeset_id}
+// Деактивированные пользователи и замены ревьюверов массовой операции
+func (h AdminAPI) GetAdminChangesetsChangesetId(w http.ResponseWriter, r *http.Request, changesetID openapi.ChangesetIdPath) {
+	changeset, serr := h.ChangesetService.GetChangeset(r.Context(), changesetID)
+	if serr != nil {
+		WriteError(w, r, serr)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(changeset)
+}
+
+// POST /admin/changesets/{changeset_id}/revert
+// Откат набора изменений: активирует пользователей и возвращает исходных ревьюверов в открытые PR, остальное - в conflicts
+func (h AdminAPI) PostAdminChangesetsChangesetIdRevert(w http.ResponseWriter, r *http.Request, changesetID openapi.ChangesetIdPath) {
+	result, serr := h.ChangesetService.RevertChangeset(r.Context(), changesetID)
+	if serr != nil {
+		WriteError(w, r, serr)
 		return
 	}
 
@@ -82,3 +178,206 @@ func (h AdminAPI) PostAdminTeamDeactivate(w http.ResponseWriter, r *http.Request
 	w.WriteHeader(http.StatusOK)
 	_ = json.NewEncoder(w).Encode(result)
 }
+
+// GET /admin/audit
+// Журнал аудита с фильтрами action, actor, pull_request_id, user_id, team_name, from, to (RFC3339) и курсорной пагинацией
+func (h AdminAPI) GetAdminAudit(w http.ResponseWriter, r *http.Request, params openapi.GetAdminAuditParams) {
+	filter := auditFilter(params.Action, params.Actor, params.PullRequestId, params.Repository, params.UserId, params.TeamName, params.From, params.To)
+
+	log, serr := h.AuditService.ListAudit(r.Context(), filter, params.Limit, params.Cursor)
+	if serr != nil {
+		WriteError(w, r, serr)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(log)
+}
+
+// GET /admin/export/pullRequests
+// Выгрузка PR в CSV или NDJSON (параметр format или заголовок Accept) с фильтрами status, author_id, team_name, from, to по времени создания
+func (h AdminAPI) GetAdminExportPullRequests(w http.ResponseWriter, r *http.Request, params openapi.GetAdminExportPullRequestsParams) {
+	format, ok := exportFormat(r)
+	if !ok {
+		WriteError(w, r, serviceerrors.ErrInvalidFormat)
+		return
+	}
+	filter := exportPullRequestFilter(params.Status, params.AuthorId, nil, params.TeamName, params.From, params.To)
+
+	ew := newExportWriter(w, r, format, "pull_requests", models.PullRequestExportHeader)
+	ew.Finish(h.ExportService.ExportPullRequests(r.Context(), filter, ew.Write))
+}
+
+// GET /admin/export/assignments
+// Выгрузка назначений ревьюверов с теми же фильтрами по PR и дополнительным reviewer_id
+func (h AdminAPI) GetAdminExportAssignments(w http.ResponseWriter, r *http.Request, params openapi.GetAdminExportAssignmentsParams) {
+	format, ok := exportFormat(r)
+	if !ok {
+		WriteError(w, r, serviceerrors.ErrInvalidFormat)
+		return
+	}
+	filter := exportPullRequestFilter(params.Status, params.AuthorId, params.ReviewerId, params.TeamName, params.From, params.To)
+
+	ew := newExportWriter(w, r, format, "assignments", models.AssignmentExportHeader)
+	ew.Finish(h.ExportService.ExportAssignments(r.Context(), filter, ew.Write))
+}
+
+// GET /admin/export/audit
+// Выгрузка журнала аудита целиком, фильтры как у /admin/audit
+func (h AdminAPI) GetAdminExportAudit(w http.ResponseWriter, r *http.Request, params openapi.GetAdminExportAuditParams) {
+	format, ok := exportFormat(r)
+	if !ok {
+		WriteError(w, r, serviceerrors.ErrInvalidFormat)
+		return
+	}
+	filter := auditFilter(params.Action, params.Actor, params.PullRequestId, params.Repository, params.UserId, params.TeamName, params.From, params.To)
+
+	ew := newExportWriter(w, r, format, "audit", models.AuditExportHeader)
+	ew.Finish(h.ExportService.ExportAudit(r.Context(), filter, ew.Write))
+}
+
+// GET /admin/export/stats
+// Агрегаты /admin/stats в длинном формате metric, dimension, key, week_start, value
+func (h AdminAPI) GetAdminExportStats(w http.ResponseWriter, r *http.Request, params openapi.GetAdminExportStatsParams) {
+	format, ok := exportFormat(r)
+	if !ok {
+		WriteError(w, r, serviceerrors.ErrInvalidFormat)
+		return
+	}
+
+	ew := newExportWriter(w, r, format, "stats", models.StatExportHeader)
+	ew.Finish(h.ExportService.ExportStats(r.Context(), params.From, params.To, ew.Write))
+}
+
+// POST /admin/import
+// Массовый импорт команд и пользователей из YAML или CSV (параметр format или Content-Type). Файл проверяется целиком
+// и применяется в одной транзакции; dry_run=true возвращает построчный отчёт без сохранения. При ошибках в строках
+// ответ 400 с тем же отчётом, изменения не применяются
+func (h AdminAPI) PostAdminImport(w http.ResponseWriter, r *http.Request, params openapi.PostAdminImportParams) {
+	format := importFormat(r.Header.Get("Content-Type"))
+	if params.Format != nil {
+		format = string(*params.Format)
+	}
+	dryRun := params.DryRun != nil && *params.DryRun
+
+	data, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxImportSize))
+	if err != nil {
+		WriteInvalidRequest(w, r, "import file is too large or unreadable")
+		return
+	}
+
+	report, serr := h.ImportService.Import(r.Context(), data, format, dryRun)
+	if serr != nil {
+		WriteError(w, r, serr)
+		return
+	}
+
+	status := http.StatusOK
+	if report.Summary.Errors > 0 {
+		status = http.StatusBadRequest
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(importReport(report))
+}
+
+// формат файла импорта по Content-Type, по умолчанию yaml
+func importFormat(contentType string) string {
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	if mediaType == "text/csv" {
+		return services.OrgFileCSV
+	}
+	return services.OrgFileYAML
+}
+
+func auditFilter(action *openapi.AuditAction, actor, prID, repository, userID, teamName *string, from, to *time.Time) models.AuditFilter {
+	filter := models.AuditFilter{
+		Actor:               ptr.Deref(actor),
+		PullRequestCustomID: ptr.Deref(prID),
+		Repository:          repository,
+		UserCustomID:        ptr.Deref(userID),
+		TeamName:            ptr.Deref(teamName),
+		From:                unixPtr(from),
+		To:                  unixPtr(to),
+	}
+	if action != nil {
+		filter.Action = string(*action)
+	}
+	return filter
+}
+
+// фильтр выгрузки PR и назначений; from и to ограничивают время создания PR
+func exportPullRequestFilter(status *openapi.StatusFilterQuery, authorID, reviewerID, teamName *string, from, to *time.Time) models.PullRequestFilter {
+	filter := models.PullRequestFilter{
+		AuthorCustomID:   ptr.Deref(authorID),
+		ReviewerCustomID: ptr.Deref(reviewerID),
+		TeamName:         ptr.Deref(teamName),
+		CreatedFrom:      unixPtr(from),
+		CreatedTo:        unixPtr(to),
+	}
+	if status != nil {
+		filter.Status = string(*status)
+	}
+	return filter
+}
+
+func durationPercentiles(in map[string]models.DurationPercentiles) map[string]openapi.DurationPercentiles {
+	out := make(map[string]openapi.DurationPercentiles, len(in))
+	for k, p := range in {
+		out[k] = openapi.DurationPercentiles{
+			Count:    p.Count,
+			P50Hours: p.P50Hours,
+			P90Hours: p.P90Hours,
+			P99Hours: p.P99Hours,
+		}
+	}
+	return out
+}
+
+func importReport(report *models.ImportReport) openapi.ImportReport {
+	s := report.Summary
+	resp := openapi.ImportReport{
+		DryRun:  report.DryRun,
+		Applied: report.Applied,
+		Summary: openapi.ImportSummary{
+			TeamsCreated:     s.TeamsCreated,
+			TeamsUpdated:     s.TeamsUpdated,
+			UsersCreated:     s.UsersCreated,
+			UsersUpdated:     s.UsersUpdated,
+			MembershipsAdded: s.MembershipsAdded,
+			Errors:           s.Errors,
+		},
+		Rows: make([]openapi.ImportRowResult, 0, len(report.Rows)),
+	}
+	for _, row := range report.Rows {
+		item := openapi.ImportRowResult{
+			Row:      row.Row,
+			Kind:     row.Kind,
+			Result:   openapi.ImportRowResultResult(row.Result),
+			TeamName: ptr.Optional(row.TeamName),
+			UserId:   ptr.Optional(row.UserID),
+			Error:    ptr.Optional(row.Error),
+		}
+		if len(row.ChangedFields) > 0 {
+			fields := row.ChangedFields
+			item.ChangedFields = &fields
+		}
+		resp.Rows = append(resp.Rows, item)
+	}
+	return resp
+}
+
+func unixPtr(t *time.Time) *int64 {
+	if t == nil {
+		return nil
+	}
+	unix := t.Unix()
+	return &unix
+}
+
+// токен из заголовка Authorization: Bearer; без заголовка - пустая строка
+func bearerToken(r *http.Request) string {
+	token, _ := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	return token
+}
@@ -0,0 +1,70 @@
+package handlers
+
+import (
+	"encoding/json"
+	"log"
+	"mime"
+	"net/http"
+	"strings"
+
+	openapi "github.com/wozhdeleniye/avito-tech-internship/api"
+	serverrors "github.com/wozhdeleniye/avito-tech-internship/internal/pkg/errors"
+)
+
+const problemJSON = "application/problem+json"
+
+// единственное место, где ошибка сервиса превращается в ответ: ErrorResponse или, по Accept,
+// RFC 7807 problem+json. Причина (Err) клиенту не отдаётся, а пишется в журнал
+func WriteError(w http.ResponseWriter, r *http.Request, serr *serverrors.ServiceError) {
+	status := serr.HTTPCode
+	if status == 0 {
+		status = http.StatusInternalServerError
+	}
+	if status >= http.StatusInternalServerError || serr.Err != nil {
+		log.Printf("%s %s: %d %v", r.Method, r.URL.Path, status, serr)
+	}
+
+	if wantsProblem(r) {
+		w.Header().Set("Content-Type", problemJSON)
+		w.WriteHeader(status)
+		_ = json.NewEncoder(w).Encode(openapi.ProblemDetails{
+			Type:     "about:blank",
+			Title:    http.StatusText(status),
+			Status:   status,
+			Detail:   &serr.Message,
+			Instance: &r.URL.Path,
+			Code:     string(serr.Code),
+			Details:  details(serr),
+		})
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	body := openapi.ErrorResponse{Error: ErrorConstructor(serr.Code, serr.Message)}
+	body.Error.Details = details(serr)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func details(serr *serverrors.ServiceError) *[]openapi.FieldError {
+	if len(serr.Details) == 0 {
+		return nil
+	}
+	return &serr.Details
+}
+
+// 400 INVALID_REQUEST для тела или параметров, не прошедших разбор или проверку по openapi.yml
+func WriteInvalidRequest(w http.ResponseWriter, r *http.Request, message string) {
+	WriteError(w, r, serverrors.ErrInvalidRequest.WithMessage(message))
+}
+
+// problem+json только по явному запросу клиента, */* и отсутствие Accept оставляют ErrorResponse
+func wantsProblem(r *http.Request) bool {
+	for _, part := range strings.Split(r.Header.Get("Accept"), ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err == nil && mediaType == problemJSON && params["q"] != "0" {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,115 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"time"
+
+	openapi "github.com/wozhdeleniye/avito-tech-internship/api"
+	serverrors "github.com/wozhdeleniye/avito-tech-internship/internal/pkg/errors"
+	"github.com/wozhdeleniye/avito-tech-internship/internal/pkg/ptr"
+	"github.com/wozhdeleniye/avito-tech-internship/internal/services"
+)
+
+const (
+	// как часто в поток пишется комментарий-пульс, чтобы прокси не закрывали молчащее соединение
+	eventsHeartbeat = 15 * time.Second
+	// через сколько миллисекунд EventSource переподключается после обрыва
+	eventsRetry = 3000
+)
+
+// GET /events/stream
+// Поток Server-Sent Events о назначениях пользователя или команды; продолжение по Last-Event-ID
+func (h MainAPI) GetEventsStream(w http.ResponseWriter, r *http.Request, params openapi.GetEventsStreamParams) {
+	filter := services.EventFilter{UserID: ptr.Deref(params.UserId), TeamName: ptr.Deref(params.TeamName)}
+	if filter.UserID == "" && filter.TeamName == "" {
+		WriteError(w, r, serverrors.ErrInvalidRequest.WithMessage("user_id or team_name is required"))
+		return
+	}
+
+	sub, missed, resumed := h.Events.Subscribe(r.Context(), filter, ptr.Deref(params.LastEventID))
+	defer sub.Close()
+
+	rc := http.NewResponseController(w)
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	if _, err := fmt.Fprintf(w, "retry: %d\n\n", eventsRetry); err != nil {
+		return
+	}
+	if !resumed {
+		if _, err := io.WriteString(w, "event: reset\ndata: {}\n\n"); err != nil {
+			return
+		}
+	}
+	for _, e := range missed {
+		if err := writeEvent(w, e); err != nil {
+			return
+		}
+	}
+	if err := rc.Flush(); err != nil {
+		log.Printf("events: flush: %v", err)
+		return
+	}
+
+	heartbeat := time.NewTicker(eventsHeartbeat)
+	defer heartbeat.Stop()
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-heartbeat.C:
+			if _, err := io.WriteString(w, ": heartbeat\n\n"); err != nil {
+				return
+			}
+		case e, ok := <-sub.Events():
+			// подписку закрыли остановка сервиса или переполнение буфера; клиент переподключится с Last-Event-ID
+			if !ok {
+				return
+			}
+			if err := writeEvent(w, e); err != nil {
+				return
+			}
+		}
+		if err := rc.Flush(); err != nil {
+			return
+		}
+	}
+}
+
+func writeEvent(w io.Writer, e services.Event) error {
+	data, err := json.Marshal(reviewEvent(e))
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "id: %s\nevent: %s\ndata: %s\n\n", e.ID, e.Type, data)
+	return err
+}
+
+func reviewEvent(e services.Event) openapi.ReviewEvent {
+	resp := openapi.ReviewEvent{
+		Id:              e.ID,
+		Type:            openapi.ReviewEventType(e.Type),
+		At:              e.At,
+		PullRequestId:   e.PullRequestID,
+		PullRequestName: e.PullRequestName,
+		AuthorId:        e.AuthorID,
+		Repository:      ptr.Optional(e.Repository),
+		TeamName:        ptr.Optional(e.TeamName),
+		ReviewerId:      ptr.Optional(e.ReviewerID),
+		Replaces:        ptr.Optional(e.Replaces),
+		ReplacedBy:      ptr.Optional(e.ReplacedBy),
+		Reason:          ptr.Optional(e.Reason),
+		Deadline:        e.Deadline,
+	}
+	if e.Type == services.EventPullRequestMerged {
+		reviewers := append(make([]string, 0, len(e.Reviewers)), e.Reviewers...)
+		resp.AssignedReviewers = &reviewers
+	}
+	return resp
+}
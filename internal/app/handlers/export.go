@@ -0,0 +1,141 @@
+package handlers
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"mime"
+	"net/http"
+	"strings"
+
+	serviceerrors "github.com/wozhdeleniye/avito-tech-internship/internal/pkg/errors"
+	"github.com/wozhdeleniye/avito-tech-internship/internal/repo/models"
+)
+
+const (
+	exportCSV    = "csv"
+	exportNDJSON = "ndjson"
+
+	// через сколько строк ответ сбрасывается клиенту
+	exportFlushEvery = 500
+)
+
+// формат выгрузки: параметр format важнее заголовка Accept, без обоих - csv
+func exportFormat(r *http.Request) (string, bool) {
+	switch f := r.URL.Query().Get("format"); f {
+	case exportCSV, exportNDJSON:
+		return f, true
+	case "":
+	default:
+		return "", false
+	}
+
+	accept := r.Header.Get("Accept")
+	if accept == "" {
+		return exportCSV, true
+	}
+	for _, part := range strings.Split(accept, ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil || params["q"] == "0" {
+			continue
+		}
+		switch mediaType {
+		case "text/csv", "text/*", "*/*":
+			return exportCSV, true
+		case "application/x-ndjson", "application/ndjson":
+			return exportNDJSON, true
+		}
+	}
+	return "", false
+}
+
+// пишет строки выгрузки в ответ по мере поступления. Статус и заголовки отправляются с первой строкой,
+// поэтому ошибка до начала выгрузки ещё может быть отдана обычным JSON-ответом
+type exportWriter struct {
+	w       http.ResponseWriter
+	r       *http.Request
+	format  string
+	header  []string
+	name    string
+	csv     *csv.Writer
+	json    *json.Encoder
+	started bool
+	rows    int
+}
+
+func newExportWriter(w http.ResponseWriter, r *http.Request, format, name string, header []string) *exportWriter {
+	return &exportWriter{w: w, r: r, format: format, name: name, header: header}
+}
+
+func (ew *exportWriter) start() error {
+	ew.started = true
+	if ew.format == exportCSV {
+		ew.w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+		ew.w.Header().Set("Content-Disposition", `attachment; filename="`+ew.name+`.csv"`)
+		ew.w.WriteHeader(http.StatusOK)
+		ew.csv = csv.NewWriter(ew.w)
+		return ew.csv.Write(ew.header)
+	}
+	ew.w.Header().Set("Content-Type", "application/x-ndjson")
+	ew.w.Header().Set("Content-Disposition", `attachment; filename="`+ew.name+`.ndjson"`)
+	ew.w.WriteHeader(http.StatusOK)
+	ew.json = json.NewEncoder(ew.w)
+	return nil
+}
+
+func (ew *exportWriter) Write(row models.ExportRow) error {
+	if !ew.started {
+		if err := ew.start(); err != nil {
+			return err
+		}
+	}
+
+	var err error
+	if ew.format == exportCSV {
+		err = ew.csv.Write(row.CSVRecord())
+	} else {
+		err = ew.json.Encode(row)
+	}
+	if err != nil {
+		return err
+	}
+
+	ew.rows++
+	if ew.rows%exportFlushEvery == 0 {
+		return ew.flush()
+	}
+	return nil
+}
+
+func (ew *exportWriter) flush() error {
+	if ew.csv != nil {
+		ew.csv.Flush()
+		if err := ew.csv.Error(); err != nil {
+			return err
+		}
+	}
+	if f, ok := ew.w.(http.Flusher); ok {
+		f.Flush()
+	}
+	return nil
+}
+
+// завершает выгрузку. Если ошибка случилась после отправки первых строк, соединение обрывается,
+// чтобы клиент не принял неполный файл за целый
+func (ew *exportWriter) Finish(serr *serviceerrors.ServiceError) {
+	if serr != nil {
+		if ew.started {
+			panic(http.ErrAbortHandler)
+		}
+		WriteError(ew.w, ew.r, serr)
+		return
+	}
+
+	if !ew.started {
+		if err := ew.start(); err != nil {
+			panic(http.ErrAbortHandler)
+		}
+	}
+	if err := ew.flush(); err != nil {
+		panic(http.ErrAbortHandler)
+	}
+}
@@ -0,0 +1,28 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	openapi "github.com/wozhdeleniye/avito-tech-internship/api"
+	"github.com/wozhdeleniye/avito-tech-internship/internal/pkg/ptr"
+)
+
+// POST /graphql
+// Запрос GraphQL; ошибки разбора и выполнения возвращаются с кодом 200 в errors, 400 - только тело не по схеме
+func (h MainAPI) PostGraphql(w http.ResponseWriter, r *http.Request) {
+	var req openapi.GraphQLRequest
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+
+	var variables map[string]any
+	if req.Variables != nil {
+		variables = *req.Variables
+	}
+	resp := h.GraphQL.Exec(r.Context(), req.Query, ptr.Deref(req.OperationName), variables)
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(resp)
+}
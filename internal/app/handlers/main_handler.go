@@ -5,50 +5,66 @@ import (
 	"net/http"
 
 	openapi "github.com/wozhdeleniye/avito-tech-internship/api"
+	"github.com/wozhdeleniye/avito-tech-internship/internal/app/graphqlapi"
 	serverrors "github.com/wozhdeleniye/avito-tech-internship/internal/pkg/errors"
+	"github.com/wozhdeleniye/avito-tech-internship/internal/pkg/validation"
 	"github.com/wozhdeleniye/avito-tech-internship/internal/repo/models"
 	"github.com/wozhdeleniye/avito-tech-internship/internal/services"
 )
 
 type MainAPI struct {
-	PRService   *services.PReqService
-	TeamService *services.TeamService
+	PRService         *services.PReqService
+	TeamService       *services.TeamService
+	AuditService      *services.AuditService
+	RepositoryService *services.RepositoryService
+	Events            *services.EventBus
+	GraphQL           *graphqlapi.API
+}
+
+// реализация openapi.ServerInterface: публичные эндпоинты и /admin
+type API struct {
+	MainAPI
+	AdminAPI
 }
 
 func ErrorConstructor(code openapi.ErrorResponseErrorCode, message string) (Error struct {
 	Code    openapi.ErrorResponseErrorCode `json:"code"`
+	Details *[]openapi.FieldError          `json:"details,omitempty"`
 	Message string                         `json:"message"`
 }) {
-	return struct {
-		Code    openapi.ErrorResponseErrorCode `json:"code"`
-		Message string                         `json:"message"`
-	}{Code: code, Message: message}
+	Error.Code = code
+	Error.Message = message
+	return Error
+}
+
+// разбирает JSON-тело в req и проверяет его правилами validation; при ошибке сам пишет 400 INVALID_REQUEST
+func decodeJSON(w http.ResponseWriter, r *http.Request, req any) bool {
+	if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+		WriteInvalidRequest(w, r, "invalid request body")
+		return false
+	}
+	if serr := validation.Struct(req); serr != nil {
+		WriteError(w, r, serr)
+		return false
+	}
+	return true
 }
 
-// Создать PR и автоматически назначить до 2 ревьюверов из команды автора
+// Создать PR и назначить ревьюверов по политике репозитория (по умолчанию до 2 из команды автора)
 func (h MainAPI) PostPullRequestCreate(w http.ResponseWriter, r *http.Request) {
 	var req openapi.PostPullRequestCreateJSONBody
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		http.Error(w, "invalid request body", http.StatusBadRequest)
+	if !decodeJSON(w, r, &req) {
 		return
 	}
 
 	pr, serr := h.PRService.CreatePullRequest(r.Context(), req)
 	if serr != nil {
-		w.Header().Set("Content-Type", "application/json")
-		status := serr.HTTPCode
-		if status == 0 {
-			status = http.StatusInternalServerError
-		}
-		w.WriteHeader(status)
-		_ = json.NewEncoder(w).Encode(openapi.ErrorResponse{Error: ErrorConstructor(serr.Code, serr.Message)})
+		WriteError(w, r, serr)
 		return
 	}
 
 	if pr == nil {
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(http.StatusNotFound)
-		_ = json.NewEncoder(w).Encode(openapi.ErrorResponse{Error: ErrorConstructor(serverrors.ErrUserNotFound.Code, "author or team not found")})
+		WriteError(w, r, serverrors.ErrUserNotFound.WithMessage("author or team not found"))
 		return
 	}
 
@@ -60,32 +76,18 @@ func (h MainAPI) PostPullRequestCreate(w http.ResponseWriter, r *http.Request) {
 // Пометить PR как MERGED (идемпотентная операция)
 func (h MainAPI) PostPullRequestMerge(w http.ResponseWriter, r *http.Request) {
 	var req openapi.PostPullRequestMergeJSONBody
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		http.Error(w, "invalid request body", http.StatusBadRequest)
-		return
-	}
-
-	if req.PullRequestId == "" {
-		http.Error(w, "pull_request_id is required", http.StatusBadRequest)
+	if !decodeJSON(w, r, &req) {
 		return
 	}
 
-	pr, serr := h.PRService.MarkPullReqAsMerged(r.Context(), req.PullRequestId)
+	pr, serr := h.PRService.MarkPullReqAsMerged(r.Context(), req.Repository, req.PullRequestId)
 	if serr != nil {
-		w.Header().Set("Content-Type", "application/json")
-		status := serr.HTTPCode
-		if status == 0 {
-			status = http.StatusInternalServerError
-		}
-		w.WriteHeader(status)
-		_ = json.NewEncoder(w).Encode(openapi.ErrorResponse{Error: ErrorConstructor(serr.Code, serr.Message)})
+		WriteError(w, r, serr)
 		return
 	}
 
 	if pr == nil {
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(http.StatusNotFound)
-		_ = json.NewEncoder(w).Encode(openapi.ErrorResponse{Error: ErrorConstructor(serverrors.ErrPRNotFound.Code, "pull request not found")})
+		WriteError(w, r, serverrors.ErrPRNotFound)
 		return
 	}
 
@@ -97,32 +99,18 @@ func (h MainAPI) PostPullRequestMerge(w http.ResponseWriter, r *http.Request) {
 // Переназначить конкретного ревьювера на другого из его команды
 func (h MainAPI) PostPullRequestReassign(w http.ResponseWriter, r *http.Request) {
 	var req openapi.PostPullRequestReassignJSONBody
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		http.Error(w, "invalid request body", http.StatusBadRequest)
+	if !decodeJSON(w, r, &req) {
 		return
 	}
 
-	if req.PullRequestId == "" || req.OldUserId == "" {
-		http.Error(w, "pull_request_id and old_user_id are required", http.StatusBadRequest)
-		return
-	}
-
-	resp, serr := h.PRService.ReassignReviewer(r.Context(), req.PullRequestId, req.OldUserId)
+	resp, serr := h.PRService.ReassignReviewer(r.Context(), req.Repository, req.PullRequestId, req.OldUserId)
 	if serr != nil {
-		w.Header().Set("Content-Type", "application/json")
-		status := serr.HTTPCode
-		if status == 0 {
-			status = http.StatusInternalServerError
-		}
-		w.WriteHeader(status)
-		_ = json.NewEncoder(w).Encode(openapi.ErrorResponse{Error: ErrorConstructor(serr.Code, serr.Message)})
+		WriteError(w, r, serr)
 		return
 	}
 
 	if resp == nil {
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(http.StatusNotFound)
-		_ = json.NewEncoder(w).Encode(openapi.ErrorResponse{Error: ErrorConstructor(serverrors.ErrPRNotFound.Code, "pull request or user not found")})
+		WriteError(w, r, serverrors.ErrPRNotFound.WithMessage("pull request or user not found"))
 		return
 	}
 
@@ -132,55 +120,149 @@ func (h MainAPI) PostPullRequestReassign(w http.ResponseWriter, r *http.Request)
 }
 
 // Создать команду с участниками (создаёт/обновляет пользователей)
-func (h MainAPI) PostTeamAdd(w http.ResponseWriter, r *http.Request) {
+func (h MainAPI) PostTeamAdd(w http.ResponseWriter, r *http.Request, params openapi.PostTeamAddParams) {
 	var req openapi.Team
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		http.Error(w, "invalid request body", http.StatusBadRequest)
+	if !decodeJSON(w, r, &req) {
 		return
 	}
 
-	team, serr := h.TeamService.CreateTeam(r.Context(), req)
+	prune := params.Prune != nil && *params.Prune
+	dryRun := params.DryRun != nil && *params.DryRun
+
+	result, serr := h.TeamService.SyncTeam(r.Context(), req, prune, dryRun)
 	if serr != nil {
-		w.Header().Set("Content-Type", "application/json")
-		status := serr.HTTPCode
-		if status == 0 {
-			status = http.StatusInternalServerError
-		}
-		w.WriteHeader(status)
-		_ = json.NewEncoder(w).Encode(openapi.ErrorResponse{Error: ErrorConstructor(serr.Code, serr.Message)})
+		WriteError(w, r, serr)
 		return
 	}
 
-	if team == nil {
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(http.StatusNotFound)
-		_ = json.NewEncoder(w).Encode(openapi.ErrorResponse{Error: ErrorConstructor(serverrors.ErrPRNotFound.Code, "can`t create team")})
+	status := http.StatusOK
+	if result.Diff.TeamCreated && !dryRun {
+		status = http.StatusCreated
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(result)
+}
+
+// Переименовать команду
+func (h MainAPI) PostTeamRename(w http.ResponseWriter, r *http.Request) {
+	var req openapi.PostTeamRenameJSONBody
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+
+	team, serr := h.TeamService.RenameTeam(r.Context(), req.TeamName, req.NewTeamName)
+	if serr != nil {
+		WriteError(w, r, serr)
 		return
 	}
 
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(http.StatusCreated)
+	w.WriteHeader(http.StatusOK)
 	_ = json.NewEncoder(w).Encode(map[string]*openapi.Team{"team": team})
 }
 
+// Задать SLA первого ответа ревьювера для команды
+func (h MainAPI) PostTeamSetSla(w http.ResponseWriter, r *http.Request) {
+	var req openapi.PostTeamSetSlaJSONBody
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+
+	team, serr := h.TeamService.SetSLA(r.Context(), req.TeamName, req.FirstReviewHours, req.Action)
+	if serr != nil {
+		WriteError(w, r, serr)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(map[string]*openapi.Team{"team": team})
+}
+
+// Удалить пустую команду
+func (h MainAPI) PostTeamDelete(w http.ResponseWriter, r *http.Request) {
+	var req openapi.PostTeamDeleteJSONBody
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+
+	serr := h.TeamService.DeleteTeam(r.Context(), req.TeamName)
+	if serr != nil {
+		WriteError(w, r, serr)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(map[string]string{"team_name": req.TeamName})
+}
+
+// Добавить существующих пользователей в команду с ролью; пользователь может состоять и в других командах
+func (h MainAPI) PostTeamAddMembers(w http.ResponseWriter, r *http.Request) {
+	var req openapi.PostTeamAddMembersJSONBody
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+
+	team, serr := h.TeamService.AddMembers(r.Context(), req.TeamName, req.UserIds, req.Role)
+	if serr != nil {
+		WriteError(w, r, serr)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(map[string]*openapi.Team{"team": team})
+}
+
+// Исключить пользователей из команды с переназначением их открытых ревью внутри команды
+func (h MainAPI) PostTeamRemoveMembers(w http.ResponseWriter, r *http.Request) {
+	var req openapi.PostTeamRemoveMembersJSONBody
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+
+	change, serr := h.TeamService.RemoveMembers(r.Context(), req.TeamName, req.UserIds)
+	if serr != nil {
+		WriteError(w, r, serr)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(change)
+}
+
+// Перевести пользователя в другую команду с переназначением его открытых ревью внутри старой команды
+func (h MainAPI) PostTeamMoveMember(w http.ResponseWriter, r *http.Request) {
+	var req openapi.PostTeamMoveMemberJSONBody
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+
+	change, serr := h.TeamService.MoveMember(r.Context(), req.UserId, req.ToTeamName, req.FromTeamName)
+	if serr != nil {
+		WriteError(w, r, serr)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(change)
+}
+
 // Получить команду с участниками
 func (h MainAPI) GetTeamGet(w http.ResponseWriter, r *http.Request, params openapi.GetTeamGetParams) {
 	team, serr := h.TeamService.GetTeamQuery(r.Context(), params.TeamName)
 	if serr != nil {
-		w.Header().Set("Content-Type", "application/json")
-		status := serr.HTTPCode
-		if status == 0 {
-			status = http.StatusInternalServerError
-		}
-		w.WriteHeader(status)
-		_ = json.NewEncoder(w).Encode(openapi.ErrorResponse{Error: ErrorConstructor(serr.Code, serr.Message)})
+		WriteError(w, r, serr)
 		return
 	}
 
 	if team == nil {
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(http.StatusNotFound)
-		_ = json.NewEncoder(w).Encode(openapi.ErrorResponse{Error: ErrorConstructor(serverrors.ErrTeamNotFound.Code, "team not found")})
+		WriteError(w, r, serverrors.ErrTeamNotFound)
 		return
 	}
 
@@ -189,17 +271,81 @@ func (h MainAPI) GetTeamGet(w http.ResponseWriter, r *http.Request, params opena
 	_ = json.NewEncoder(w).Encode(team)
 }
 
+// Получить список PR с фильтрами и курсорной пагинацией
+func (h MainAPI) GetPullRequestList(w http.ResponseWriter, r *http.Request, params openapi.GetPullRequestListParams) {
+	list, serr := h.PRService.ListPullRequests(r.Context(), params)
+	if serr != nil {
+		WriteError(w, r, serr)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(list)
+}
+
+// Получить PR с ревьюверами, временными метками и историей
+func (h MainAPI) GetPullRequestGet(w http.ResponseWriter, r *http.Request, params openapi.GetPullRequestGetParams) {
+	pr, serr := h.PRService.GetPullRequest(r.Context(), params.Repository, params.PullRequestId)
+	if serr != nil {
+		WriteError(w, r, serr)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(map[string]*openapi.PullRequestDetail{"pr": pr})
+}
+
+// Отметить ответ ревьювера по PR
+func (h MainAPI) PostPullRequestReview(w http.ResponseWriter, r *http.Request) {
+	var req openapi.PostPullRequestReviewJSONBody
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+
+	pr, serr := h.PRService.SubmitReview(r.Context(), req.Repository, req.PullRequestId, req.UserId)
+	if serr != nil {
+		WriteError(w, r, serr)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(map[string]*openapi.PullRequestDetail{"pr": pr})
+}
+
+// Журнал аудита PR в хронологическом порядке
+func (h MainAPI) GetPullRequestHistory(w http.ResponseWriter, r *http.Request, params openapi.GetPullRequestHistoryParams) {
+	log, serr := h.AuditService.PullRequestHistory(r.Context(), params)
+	if serr != nil {
+		WriteError(w, r, serr)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(log)
+}
+
+// Полнотекстовый поиск PR по названию
+func (h MainAPI) GetPullRequestSearch(w http.ResponseWriter, r *http.Request, params openapi.GetPullRequestSearchParams) {
+	list, serr := h.PRService.SearchPullRequests(r.Context(), params)
+	if serr != nil {
+		WriteError(w, r, serr)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(list)
+}
+
 // Получить PR'ы, где пользователь назначен ревьювером
 func (h MainAPI) GetUsersGetReview(w http.ResponseWriter, r *http.Request, params openapi.GetUsersGetReviewParams) {
-	prSearch, serr := h.PRService.GetPullReqsByReviever(r.Context(), params.UserId)
+	prSearch, serr := h.PRService.GetPullReqsByReviever(r.Context(), params)
 	if serr != nil {
-		w.Header().Set("Content-Type", "application/json")
-		status := serr.HTTPCode
-		if status == 0 {
-			status = http.StatusInternalServerError
-		}
-		w.WriteHeader(status)
-		_ = json.NewEncoder(w).Encode(openapi.ErrorResponse{Error: ErrorConstructor(serr.Code, serr.Message)})
+		WriteError(w, r, serr)
 		return
 	}
 
@@ -217,41 +363,72 @@ func (h MainAPI) GetUsersGetReview(w http.ResponseWriter, r *http.Request, param
 
 // Установить флаг активности пользователя
 func (h MainAPI) PostUsersSetIsActive(w http.ResponseWriter, r *http.Request) {
-	var req struct {
-		UserID   string `json:"user_id"`
-		IsActive bool   `json:"is_active"`
+	var req openapi.PostUsersSetIsActiveJSONBody
+	if !decodeJSON(w, r, &req) {
+		return
 	}
 
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		http.Error(w, "invalid request body", http.StatusBadRequest)
+	change, serr := h.TeamService.SetUserActive(r.Context(), req.UserId, req.IsActive, req.ReassignReviews != nil && *req.ReassignReviews)
+	if serr != nil {
+		WriteError(w, r, serr)
 		return
 	}
 
-	if req.UserID == "" {
-		http.Error(w, "user_id is required", http.StatusBadRequest)
+	if change == nil {
+		WriteError(w, r, serverrors.ErrUserNotFound)
 		return
 	}
 
-	user, serr := h.TeamService.SetUserActive(r.Context(), req.UserID, req.IsActive)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(change)
+}
+
+// Создать репозиторий с командой-владельцем и политикой назначения ревьюверов
+func (h MainAPI) PostRepositoryAdd(w http.ResponseWriter, r *http.Request) {
+	var req openapi.Repository
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+
+	repo, serr := h.RepositoryService.CreateRepository(r.Context(), req)
 	if serr != nil {
-		w.Header().Set("Content-Type", "application/json")
-		status := serr.HTTPCode
-		if status == 0 {
-			status = http.StatusInternalServerError
-		}
-		w.WriteHeader(status)
-		_ = json.NewEncoder(w).Encode(openapi.ErrorResponse{Error: ErrorConstructor(serr.Code, serr.Message)})
+		WriteError(w, r, serr)
 		return
 	}
 
-	if user == nil {
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(http.StatusNotFound)
-		_ = json.NewEncoder(w).Encode(openapi.ErrorResponse{Error: ErrorConstructor(serverrors.ErrUserNotFound.Code, "user not found")})
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	_ = json.NewEncoder(w).Encode(map[string]*openapi.Repository{"repository": repo})
+}
+
+// Получить репозиторий
+func (h MainAPI) GetRepositoryGet(w http.ResponseWriter, r *http.Request, params openapi.GetRepositoryGetParams) {
+	repo, serr := h.RepositoryService.GetRepository(r.Context(), params.Name)
+	if serr != nil {
+		WriteError(w, r, serr)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(map[string]*openapi.Repository{"repository": repo})
+}
+
+// Заменить переопределения политики назначения ревьюверов
+func (h MainAPI) PostRepositorySetPolicy(w http.ResponseWriter, r *http.Request) {
+	var req openapi.PostRepositorySetPolicyJSONBody
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+
+	repo, serr := h.RepositoryService.SetReviewerPolicy(r.Context(), req.Name, req.ReviewerPolicy)
+	if serr != nil {
+		WriteError(w, r, serr)
 		return
 	}
 
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
-	_ = json.NewEncoder(w).Encode(map[string]*models.User{"user": user})
+	_ = json.NewEncoder(w).Encode(map[string]*openapi.Repository{"repository": repo})
 }
@@ -0,0 +1,103 @@
+package router
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/wozhdeleniye/avito-tech-internship/internal/app/handlers"
+	serverrors "github.com/wozhdeleniye/avito-tech-internship/internal/pkg/errors"
+	"github.com/wozhdeleniye/avito-tech-internship/internal/services"
+)
+
+const maxIdempotencyKeyLength = 255
+
+// POST с заголовком Idempotency-Key выполняется один раз: повтор того же клиента с тем же ключом и запросом
+// получает сохранённый ответ с заголовком Idempotent-Replayed: true. Должен стоять после определения организации
+func IdempotencyMiddleware(idempotencyService *services.IdempotencyService) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			key := r.Header.Get("Idempotency-Key")
+			if r.Method != http.MethodPost || key == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+			if len(key) > maxIdempotencyKeyLength {
+				handlers.WriteError(w, r, serverrors.ErrInvalidRequest.WithMessage("Idempotency-Key must not exceed 255 characters"))
+				return
+			}
+
+			body, err := io.ReadAll(r.Body)
+			if err != nil {
+				handlers.WriteError(w, r, serverrors.ErrInvalidRequest.WithMessage("request body could not be read"))
+				return
+			}
+			r.Body = io.NopCloser(bytes.NewReader(body))
+
+			token, _ := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+			client := services.IdempotencyClient(token, r.Header.Get("User-id"))
+			record, serr := idempotencyService.Begin(r.Context(), client, key, services.RequestHash(r.Method, r.URL.RequestURI(), body))
+			if serr != nil {
+				handlers.WriteError(w, r, serr)
+				return
+			}
+			if record != nil {
+				if record.ContentType != "" {
+					w.Header().Set("Content-Type", record.ContentType)
+				}
+				if record.Location != "" {
+					w.Header().Set("Location", record.Location)
+				}
+				w.Header().Set("Idempotent-Replayed", "true")
+				w.WriteHeader(record.StatusCode)
+				_, _ = w.Write(record.Body)
+				return
+			}
+
+			// клиент, не дождавшийся ответа, не должен помешать сохранить его для повтора
+			ctx := context.WithoutCancel(r.Context())
+			rec := &recordingWriter{ResponseWriter: w, status: http.StatusOK}
+			completed := false
+			defer func() {
+				if completed {
+					return
+				}
+				if err := idempotencyService.Abandon(ctx, client, key); err != nil {
+					log.Printf("idempotency: release key %q: %v", key, err)
+				}
+			}()
+
+			next.ServeHTTP(rec, r)
+			completed = true
+
+			if err := idempotencyService.Complete(ctx, client, key, rec.status, rec.Header().Get("Content-Type"), rec.Header().Get("Location"), rec.body.Bytes()); err != nil {
+				log.Printf("idempotency: save response for key %q: %v", key, err)
+			}
+		})
+	}
+}
+
+// пропускает ответ клиенту и запоминает статус и тело
+type recordingWriter struct {
+	http.ResponseWriter
+	status      int
+	wroteHeader bool
+	body        bytes.Buffer
+}
+
+func (w *recordingWriter) WriteHeader(status int) {
+	if !w.wroteHeader {
+		w.status = status
+		w.wroteHeader = true
+	}
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *recordingWriter) Write(b []byte) (int, error) {
+	w.wroteHeader = true
+	w.body.Write(b)
+	return w.ResponseWriter.Write(b)
+}
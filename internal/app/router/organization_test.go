@@ -0,0 +1,95 @@
+package router
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/wozhdeleniye/avito-tech-internship/internal/pkg/auth"
+	"github.com/wozhdeleniye/avito-tech-internship/internal/repo/models"
+	postgresrepository "github.com/wozhdeleniye/avito-tech-internship/internal/repo/repositories/postgres_repository"
+	"github.com/wozhdeleniye/avito-tech-internship/internal/services"
+)
+
+const testSecret = "test-secret"
+
+// запрос через OrganizationMiddleware: код ответа, код ошибки и организация, дошедшая до обработчика
+func resolve(t *testing.T, secret string, token string, header string) (int, string, uuid.UUID) {
+	t.Helper()
+	var org uuid.UUID
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		org = postgresrepository.OrganizationID(r.Context())
+	})
+	// организация default не требует обращения к базе, поэтому репозиторий не нужен
+	h := OrganizationMiddleware(services.NewOrganizationService(nil, secret))(next)
+
+	req := httptest.NewRequest(http.MethodGet, "/team/get", nil)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	if header != "" {
+		req.Header.Set("X-Organization", header)
+	}
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	var body struct {
+		Error struct{ Code string } `json:"error"`
+	}
+	_ = json.Unmarshal(rec.Body.Bytes(), &body)
+	return rec.Code, body.Error.Code, org
+}
+
+func issue(t *testing.T, claims auth.Claims, secret string) string {
+	t.Helper()
+	token, err := auth.IssueToken(claims, []byte(secret))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return token
+}
+
+func TestOrganizationMiddlewareRequiresTokenWithSecret(t *testing.T) {
+	withOrg := issue(t, auth.Claims{Subject: "bot", Organization: models.DefaultOrganizationSlug}, testSecret)
+	withoutOrg := issue(t, auth.Claims{Subject: "bot"}, testSecret)
+	foreign := issue(t, auth.Claims{Subject: "bot", Organization: models.DefaultOrganizationSlug}, "other-secret")
+
+	cases := []struct {
+		name   string
+		token  string
+		header string
+		code   string
+	}{
+		{name: "без токена", code: "UNAUTHORIZED"},
+		{name: "без токена с заголовком", header: "acme", code: "UNAUTHORIZED"},
+		{name: "токен без org", token: withoutOrg, code: "INVALID_TOKEN"},
+		{name: "токен без org с заголовком", token: withoutOrg, header: "acme", code: "INVALID_TOKEN"},
+		{name: "заголовок не совпадает с org", token: withOrg, header: "acme", code: "INVALID_TOKEN"},
+		{name: "чужая подпись", token: foreign, code: "INVALID_TOKEN"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			status, code, _ := resolve(t, testSecret, c.token, c.header)
+			if status != http.StatusUnauthorized || code != c.code {
+				t.Fatalf("ожидался 401 %s, получен %d %s", c.code, status, code)
+			}
+		})
+	}
+
+	for _, header := range []string{"", models.DefaultOrganizationSlug} {
+		status, code, org := resolve(t, testSecret, withOrg, header)
+		if status != http.StatusOK || org != models.DefaultOrganizationID {
+			t.Fatalf("заголовок %q: ожидалась организация из токена, получен %d %s %s", header, status, code, org)
+		}
+	}
+}
+
+func TestOrganizationMiddlewareUsesHeaderWithoutSecret(t *testing.T) {
+	// без ACCESS_TOKEN_SECRET токен не проверяется и не влияет на организацию
+	status, code, org := resolve(t, "", "not-a-token", models.DefaultOrganizationSlug)
+	if status != http.StatusOK || org != models.DefaultOrganizationID {
+		t.Fatalf("ожидалась организация из заголовка, получен %d %s %s", status, code, org)
+	}
+}
@@ -0,0 +1,148 @@
+package router
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"log"
+	"math"
+	"net"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/wozhdeleniye/avito-tech-internship/internal/app/handlers"
+	"github.com/wozhdeleniye/avito-tech-internship/internal/config"
+	"github.com/wozhdeleniye/avito-tech-internship/internal/pkg/auth"
+	serverrors "github.com/wozhdeleniye/avito-tech-internship/internal/pkg/errors"
+	"github.com/wozhdeleniye/avito-tech-internship/internal/pkg/ratelimit"
+)
+
+type routeLimit struct {
+	method   string
+	segments []string
+	key      string
+	limit    ratelimit.Limit
+}
+
+// корзины токенов по маршруту и клиенту. Клиент - токен доступа, подпись которого проверена tokenSecret
+// (непроверенный токен можно менять на каждый запрос), иначе IP
+type RateLimiter struct {
+	store             ratelimit.Store
+	defaultLimit      ratelimit.Limit
+	routes            []routeLimit
+	trustForwardedFor bool
+	tokenSecret       []byte
+}
+
+func NewRateLimiter(store ratelimit.Store, cfg config.RateLimitConfig, tokenSecret string) *RateLimiter {
+	l := &RateLimiter{
+		store:             store,
+		defaultLimit:      cfg.Default,
+		trustForwardedFor: cfg.TrustForwardedFor,
+		tokenSecret:       []byte(tokenSecret),
+	}
+	for route, limit := range cfg.Routes {
+		method, path, _ := strings.Cut(route, " ")
+		l.routes = append(l.routes, routeLimit{method: method, segments: splitPath(path), key: route, limit: limit})
+	}
+	// маршрут без параметров точнее шаблона с {param} и проверяется раньше
+	sort.Slice(l.routes, func(i, j int) bool {
+		pi, pj := strings.Count(l.routes[i].key, "{"), strings.Count(l.routes[j].key, "{")
+		if pi != pj {
+			return pi < pj
+		}
+		return l.routes[i].key < l.routes[j].key
+	})
+	return l
+}
+
+// отклоняет запрос сверх лимита ответом 429 RATE_LIMITED с Retry-After; каждый ответ несёт RateLimit-Limit,
+// RateLimit-Remaining и RateLimit-Reset. Недоступное хранилище корзин не останавливает сервис: запрос пропускается
+func (l *RateLimiter) Middleware() func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			route, limit := l.match(r)
+			res, err := l.store.Take(r.Context(), route+"|"+l.client(r), limit)
+			if err != nil {
+				log.Printf("rate limit: %v", err)
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			w.Header().Set("RateLimit-Limit", strconv.Itoa(res.Limit))
+			w.Header().Set("RateLimit-Remaining", strconv.Itoa(res.Remaining))
+			w.Header().Set("RateLimit-Reset", seconds(res.Reset))
+			if !res.Allowed {
+				w.Header().Set("Retry-After", seconds(res.RetryAfter))
+				handlers.WriteError(w, r, serverrors.ErrRateLimited)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// маршрут с собственным лимитом или общая корзина клиента "*"
+func (l *RateLimiter) match(r *http.Request) (string, ratelimit.Limit) {
+	path := r.URL.Path
+	if rctx := chi.RouteContext(r.Context()); rctx != nil && rctx.RoutePath != "" {
+		path = rctx.RoutePath
+	}
+	segments := splitPath(path)
+	for _, route := range l.routes {
+		if route.method == r.Method && matchSegments(route.segments, segments) {
+			return route.key, route.limit
+		}
+	}
+	return "*", l.defaultLimit
+}
+
+func (l *RateLimiter) client(r *http.Request) string {
+	if len(l.tokenSecret) > 0 {
+		token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if _, err := auth.ParseToken(token, l.tokenSecret, time.Now()); ok && err == nil {
+			// в хранилище корзин попадает не сам токен, а его хеш
+			sum := sha256.Sum256([]byte(token))
+			return "token:" + hex.EncodeToString(sum[:16])
+		}
+	}
+	if l.trustForwardedFor {
+		// последний адрес добавлен нашим балансировщиком, предыдущие клиент может подставить сам
+		if forwarded := r.Header.Get("X-Forwarded-For"); forwarded != "" {
+			parts := strings.Split(forwarded, ",")
+			if ip := strings.TrimSpace(parts[len(parts)-1]); ip != "" {
+				return "ip:" + ip
+			}
+		}
+	}
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	return "ip:" + host
+}
+
+func splitPath(path string) []string {
+	return strings.Split(strings.Trim(path, "/"), "/")
+}
+
+// сегмент шаблона {param} совпадает с любым сегментом пути
+func matchSegments(pattern, path []string) bool {
+	if len(pattern) != len(path) {
+		return false
+	}
+	for i, p := range pattern {
+		if p != path[i] && !(strings.HasPrefix(p, "{") && strings.HasSuffix(p, "}")) {
+			return false
+		}
+	}
+	return true
+}
+
+// длительность в целых секундах с округлением вверх, как ждут Retry-After и RateLimit-Reset
+func seconds(d time.Duration) string {
+	return strconv.Itoa(int(math.Ceil(d.Seconds())))
+}
@@ -0,0 +1,39 @@
+package router
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/wozhdeleniye/avito-tech-internship/internal/config"
+	"github.com/wozhdeleniye/avito-tech-internship/internal/pkg/auth"
+)
+
+// корзина по токену только для токена с проверенной подписью, поддельный или любой токен без секрета - по IP
+func TestRateLimiterKeysByVerifiedTokenOnly(t *testing.T) {
+	valid := issue(t, auth.Claims{Subject: "bot", Organization: "default"}, testSecret)
+	forged := issue(t, auth.Claims{Subject: "bot", Organization: "default"}, "other-secret")
+
+	cases := []struct {
+		name   string
+		secret string
+		token  string
+		want   string
+	}{
+		{"verified token", testSecret, valid, "token:"},
+		{"forged token", testSecret, forged, "ip:192.0.2.1"},
+		{"garbage token", testSecret, "x.y.z", "ip:192.0.2.1"},
+		{"no secret", "", valid, "ip:192.0.2.1"},
+	}
+	for _, tc := range cases {
+		l := NewRateLimiter(nil, config.RateLimitConfig{}, tc.secret)
+		req := httptest.NewRequest(http.MethodGet, "/team/get", nil)
+		req.RemoteAddr = "192.0.2.1:1234"
+		req.Header.Set("Authorization", "Bearer "+tc.token)
+		got := l.client(req)
+		if !strings.HasPrefix(got, tc.want) {
+			t.Errorf("%s: client %q, want %q", tc.name, got, tc.want)
+		}
+	}
+}
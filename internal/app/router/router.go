@@ -1,38 +1,77 @@
 package router
 
 import (
+	"fmt"
 	"net/http"
+	"runtime/debug"
+	"strings"
 
 	"github.com/go-chi/chi/v5"
 	openapi "github.com/wozhdeleniye/avito-tech-internship/api"
+	"github.com/wozhdeleniye/avito-tech-internship/internal/app/graphqlapi"
 	"github.com/wozhdeleniye/avito-tech-internship/internal/app/handlers"
+	serverrors "github.com/wozhdeleniye/avito-tech-internship/internal/pkg/errors"
 	"github.com/wozhdeleniye/avito-tech-internship/internal/services"
 )
 
-func NewApp(prService *services.PReqService, teamService *services.TeamService) http.Handler {
+func NewApp(prService *services.PReqService, teamService *services.TeamService, auditService *services.AuditService, slaService *services.SLAService, statsService *services.StatsService, exportService *services.ExportService, importService *services.ImportService, jobService *services.JobService, changesetService *services.ChangesetService, repositoryService *services.RepositoryService, organizationService *services.OrganizationService, idempotencyService *services.IdempotencyService, eventBus *services.EventBus, graphQL *graphqlapi.API, rateLimiter *RateLimiter) http.Handler {
 	r := chi.NewRouter()
+	r.Use(RecoverMiddleware())
 	r.Use(CORSMiddleware())
+	r.Use(ActorMiddleware())
 
 	//middleware := middleware.NewAuthMiddleware(authService)
 
-	mainRouter := chi.NewRouter()
-	mainHandler := handlers.MainAPI{
-		PRService:   prService,
-		TeamService: teamService,
+	// спецификация встроена в бинарник, поэтому ошибка её загрузки - ошибка сборки, а не окружения
+	validation, err := ValidationMiddleware()
+	if err != nil {
+		panic(err)
 	}
-	openapi.HandlerFromMux(mainHandler, mainRouter)
 
-	r.Mount("/api", mainRouter)
-
-	adminRouter := chi.NewRouter()
-	adminHandler := handlers.AdminAPI{
-		PRService:   prService,
-		TeamService: teamService,
+	apiRouter := chi.NewRouter()
+	// лимит проверяется первым, чтобы поток невалидных запросов тоже упирался в него; nil - лимиты выключены
+	if rateLimiter != nil {
+		apiRouter.Use(rateLimiter.Middleware())
+	}
+	apiRouter.Use(validation)
+	apiRouter.Use(OrganizationMiddleware(organizationService))
+	apiRouter.Use(IdempotencyMiddleware(idempotencyService))
+	apiRouter.NotFound(func(w http.ResponseWriter, r *http.Request) {
+		handlers.WriteError(w, r, serverrors.ErrNotFound)
+	})
+	apiRouter.MethodNotAllowed(func(w http.ResponseWriter, r *http.Request) {
+		handlers.WriteError(w, r, serverrors.ErrMethodNotAllowed)
+	})
+	api := handlers.API{
+		MainAPI: handlers.MainAPI{
+			PRService:         prService,
+			TeamService:       teamService,
+			AuditService:      auditService,
+			RepositoryService: repositoryService,
+			Events:            eventBus,
+			GraphQL:           graphQL,
+		},
+		AdminAPI: handlers.AdminAPI{
+			PRService:           prService,
+			TeamService:         teamService,
+			AuditService:        auditService,
+			SLAService:          slaService,
+			StatsService:        statsService,
+			ExportService:       exportService,
+			ImportService:       importService,
+			JobService:          jobService,
+			ChangesetService:    changesetService,
+			OrganizationService: organizationService,
+		},
 	}
-	adminRouter.Get("/stats", adminHandler.GetAdminStats)
-	adminRouter.Post("/team/deactivate", adminHandler.PostAdminTeamDeactivate)
+	openapi.HandlerWithOptions(api, openapi.ChiServerOptions{
+		BaseRouter: apiRouter,
+		ErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			handlers.WriteInvalidRequest(w, r, err.Error())
+		},
+	})
 
-	r.Mount("/api/admin", adminRouter)
+	r.Mount("/api", apiRouter)
 
 	return r
 }
@@ -42,7 +81,8 @@ func CORSMiddleware() func(http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			w.Header().Set("Access-Control-Allow-Origin", "*")
 			w.Header().Set("Access-Control-Allow-Methods", "POST, GET, PUT, DELETE, OPTIONS")
-			w.Header().Set("Access-Control-Allow-Headers", "Origin, Content-Type, Content-Length, Accept-Encoding, X-CSRF-Token, Authorization, User-id")
+			w.Header().Set("Access-Control-Allow-Headers", "Origin, Content-Type, Content-Length, Accept-Encoding, X-CSRF-Token, Authorization, User-id, X-Organization, Idempotency-Key")
+			w.Header().Set("Access-Control-Expose-Headers", "Location, Idempotent-Replayed, Retry-After, RateLimit-Limit, RateLimit-Remaining, RateLimit-Reset")
 
 			if r.Method == "OPTIONS" {
 				w.WriteHeader(http.StatusNoContent)
@@ -53,3 +93,50 @@ func CORSMiddleware() func(http.Handler) http.Handler {
 		})
 	}
 }
+
+// паника в обработчике отдаётся клиенту как 500 UNKNOWN_ERROR, а не обрывом соединения;
+// http.ErrAbortHandler пробрасывается дальше, им выгрузки намеренно обрывают ответ
+func RecoverMiddleware() func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer func() {
+				rec := recover()
+				if rec == nil {
+					return
+				}
+				if rec == http.ErrAbortHandler {
+					panic(rec)
+				}
+				handlers.WriteError(w, r, serverrors.ErrUnknown.Wrap(fmt.Errorf("panic: %v\n%s", rec, debug.Stack())))
+			}()
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// инициатор запроса из заголовка User-id попадает в журнал аудита
+func ActorMiddleware() func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if actor := r.Header.Get("User-id"); actor != "" {
+				r = r.WithContext(services.WithActor(r.Context(), actor))
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// организация запроса из токена доступа или заголовка X-Organization; репозитории ограничивают ею все запросы
+func OrganizationMiddleware(organizationService *services.OrganizationService) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			token, _ := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+			org, serr := organizationService.ResolveOrganization(r.Context(), token, r.Header.Get("X-Organization"))
+			if serr != nil {
+				handlers.WriteError(w, r, serr)
+				return
+			}
+			next.ServeHTTP(w, r.WithContext(services.WithOrganization(r.Context(), org)))
+		})
+	}
+}
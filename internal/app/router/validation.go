@@ -0,0 +1,118 @@
+package router
+
+import (
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers/gorillamux"
+	openapi "github.com/wozhdeleniye/avito-tech-internship/api"
+	"github.com/wozhdeleniye/avito-tech-internship/internal/app/handlers"
+	serverrors "github.com/wozhdeleniye/avito-tech-internship/internal/pkg/errors"
+)
+
+// проверяет параметры и JSON-тела запросов по openapi.yml; несоответствие контракту - 400 INVALID_REQUEST
+// в формате ErrorResponse. Тела в других форматах (файлы импорта) проверяет сервис. Пути, которых нет
+// в спецификации, пропускаются без проверки
+func ValidationMiddleware() (func(http.Handler) http.Handler, error) {
+	spec, err := openapi.GetSwagger()
+	if err != nil {
+		return nil, fmt.Errorf("load openapi spec: %w", err)
+	}
+	routes, err := gorillamux.NewRouter(spec)
+	if err != nil {
+		return nil, fmt.Errorf("build openapi router: %w", err)
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			route, pathParams, err := routes.FindRoute(r)
+			if err != nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			input := &openapi3filter.RequestValidationInput{
+				Request:    r,
+				PathParams: pathParams,
+				Route:      route,
+				Options: &openapi3filter.Options{
+					AuthenticationFunc: openapi3filter.NoopAuthenticationFunc,
+					ExcludeRequestBody: !isJSON(r.Header.Get("Content-Type")),
+				},
+			}
+			if err := openapi3filter.ValidateRequest(r.Context(), input); err != nil {
+				handlers.WriteError(w, r, validationError(err))
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}, nil
+}
+
+func isJSON(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	return err == nil && mediaType == "application/json"
+}
+
+// ошибка по полю, если kin-openapi указал параметр или путь в теле, иначе короткое описание без схемы и значения
+func validationError(err error) *serverrors.ServiceError {
+	var reqErr *openapi3filter.RequestError
+	if !errors.As(err, &reqErr) {
+		return serverrors.ErrInvalidRequest.WithMessage(firstLine(err.Error()))
+	}
+
+	var schemaErr *openapi3.SchemaError
+	if !errors.As(err, &schemaErr) {
+		reason := reqErr.Reason
+		if reqErr.Err != nil {
+			reason = firstLine(reqErr.Err.Error())
+		}
+		if reqErr.Parameter != nil {
+			return serverrors.ErrInvalidRequest.WithDetails([]openapi.FieldError{{Field: reqErr.Parameter.Name, Rule: "parameter", Message: reason}})
+		}
+		return serverrors.ErrInvalidRequest.WithMessage("request body: " + reason)
+	}
+
+	reason := schemaErr.Reason
+	// у ошибок формата в скобках регулярное выражение, клиенту достаточно имени формата
+	if i := strings.Index(reason, " ("); i > 0 && strings.HasSuffix(reason, ")") {
+		reason = reason[:i]
+	}
+	field := jsonPath(schemaErr.JSONPointer())
+	if reqErr.Parameter != nil {
+		field = reqErr.Parameter.Name
+	}
+	if field == "" {
+		return serverrors.ErrInvalidRequest.WithMessage("request body: " + reason)
+	}
+	return serverrors.ErrInvalidRequest.WithDetails([]openapi.FieldError{{Field: field, Rule: schemaErr.SchemaField, Message: reason}})
+}
+
+// путь из JSON Pointer в том же виде, что у validation: members[1].user_id
+func jsonPath(pointer []string) string {
+	var b strings.Builder
+	for _, part := range pointer {
+		if _, err := strconv.Atoi(part); err == nil {
+			b.WriteString("[" + part + "]")
+			continue
+		}
+		if b.Len() > 0 {
+			b.WriteByte('.')
+		}
+		b.WriteString(part)
+	}
+	return b.String()
+}
+
+func firstLine(s string) string {
+	if i := strings.IndexByte(s, '\n'); i >= 0 {
+		return s[:i]
+	}
+	return s
+}
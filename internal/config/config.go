@@ -1,31 +1,79 @@
 package config
 
 import (
+	"log"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/joho/godotenv"
+	"github.com/wozhdeleniye/avito-tech-internship/internal/pkg/ratelimit"
 )
 
 type Config struct {
-	Server   ServerConfig
-	Database DatabaseConfig
-	Redis    RedisConfig
-	JWT      JWTConfig
+	Server      ServerConfig
+	GRPC        GRPCConfig
+	Database    DatabaseConfig
+	Redis       RedisConfig
+	JWT         JWTConfig
+	SLA         SLAConfig
+	Jobs        JobsConfig
+	Idempotency IdempotencyConfig
+	RateLimit   RateLimitConfig
+	Events      EventsConfig
 }
 
 type ServerConfig struct {
 	Port string
 }
 
+// порт gRPC API; GRPC_PORT=off выключает gRPC-сервер
+type GRPCConfig struct {
+	Port string
+}
+
+// ResetOnStart - удалять все таблицы перед миграцией при старте, только для локальной разработки
 type DatabaseConfig struct {
-	Host     string
-	Port     string
-	User     string
-	Password string
-	DBName   string
-	SSLMode  string
+	Host         string
+	Port         string
+	User         string
+	Password     string
+	DBName       string
+	SSLMode      string
+	ResetOnStart bool
+}
+
+type SLAConfig struct {
+	ScanInterval time.Duration
+}
+
+// фоновые задачи: интервал проверки незавершённых задач и размер пачки, обрабатываемой в одной транзакции
+type JobsConfig struct {
+	PollInterval time.Duration
+	BatchSize    int
+}
+
+// сколько хранится первый ответ на POST с Idempotency-Key и сколько ключ держится за запросом без ответа
+type IdempotencyConfig struct {
+	TTL     time.Duration
+	LockTTL time.Duration
+}
+
+// ограничение частоты запросов по клиенту (токен доступа или IP): Backend - memory, redis (общие корзины
+// для нескольких реплик) или off. Routes - лимиты отдельных маршрутов по ключу "POST /pullRequest/reassign",
+// остальные маршруты делят одну корзину клиента с лимитом Default. TrustForwardedFor - брать IP клиента
+// из X-Forwarded-For, когда сервис стоит за балансировщиком
+type RateLimitConfig struct {
+	Backend           string
+	Default           ratelimit.Limit
+	Routes            map[string]ratelimit.Limit
+	TrustForwardedFor bool
+}
+
+// поток событий: сколько последних событий хранится для продолжения по Last-Event-ID
+type EventsConfig struct {
+	BufferSize int
 }
 
 type RedisConfig struct {
@@ -49,6 +97,9 @@ func Load() *Config {
 		Server: ServerConfig{
 			Port: getEnv("SERVER_PORT", "8085"),
 		},
+		GRPC: GRPCConfig{
+			Port: getEnv("GRPC_PORT", "9090"),
+		},
 		Database: DatabaseConfig{
 			Host:     getEnv("DB_HOST", "localhost"),
 			Port:     getEnv("DB_PORT", "5433"),
@@ -56,6 +107,37 @@ func Load() *Config {
 			Password: getEnv("DB_PASSWORD", "mypassword"),
 			DBName:   getEnv("DB_NAME", "mydatabase"),
 			SSLMode:  getEnv("DB_SSL_MODE", "disable"),
+			// по умолчанию данные переживают перезапуск: задачи продолжаются, аудит и наборы изменений сохраняются
+			ResetOnStart: getEnvAsBool("DB_RESET_ON_START", false),
+		},
+		SLA: SLAConfig{
+			ScanInterval: getEnvAsDuration("SLA_SCAN_INTERVAL", 5*time.Minute),
+		},
+		Jobs: JobsConfig{
+			PollInterval: getEnvAsDuration("JOB_POLL_INTERVAL", 30*time.Second),
+			BatchSize:    getEnvAsInt("JOB_BATCH_SIZE", 50),
+		},
+		Idempotency: IdempotencyConfig{
+			TTL:     getEnvAsDuration("IDEMPOTENCY_TTL", 24*time.Hour),
+			LockTTL: getEnvAsDuration("IDEMPOTENCY_LOCK_TTL", 2*time.Minute),
+		},
+		RateLimit: RateLimitConfig{
+			Backend:           getEnv("RATE_LIMIT_BACKEND", "memory"),
+			Default:           getEnvAsLimit("RATE_LIMIT_DEFAULT", ratelimit.Limit{Rate: 1200, Period: time.Minute, Burst: 200}),
+			Routes:            getEnvAsRouteLimits("RATE_LIMIT_ROUTES", "POST /pullRequest/reassign=30/1m:10"),
+			TrustForwardedFor: getEnvAsBool("RATE_LIMIT_TRUST_FORWARDED_FOR", false),
+		},
+		Events: EventsConfig{
+			BufferSize: getEnvAsInt("EVENTS_BUFFER_SIZE", 1000),
+		},
+		Redis: RedisConfig{
+			Host:     getEnv("REDIS_HOST", "localhost"),
+			Port:     getEnv("REDIS_PORT", "6379"),
+			Password: getEnv("REDIS_PASSWORD", ""),
+			DB:       getEnvAsInt("REDIS_DB", 0),
+		},
+		JWT: JWTConfig{
+			AccessTokenSecret: getEnv("ACCESS_TOKEN_SECRET", ""),
 		},
 	}
 }
@@ -68,6 +150,26 @@ func (c *Config) GetDatabaseConfig() DatabaseConfig {
 	return c.Database
 }
 
+func (c *Config) GetSLAConfig() SLAConfig {
+	return c.SLA
+}
+
+func (c *Config) GetJobsConfig() JobsConfig {
+	return c.Jobs
+}
+
+func (c *Config) GetIdempotencyConfig() IdempotencyConfig {
+	return c.Idempotency
+}
+
+func (c *Config) GetRateLimitConfig() RateLimitConfig {
+	return c.RateLimit
+}
+
+func (c *Config) GetEventsConfig() EventsConfig {
+	return c.Events
+}
+
 func (c *Config) GetRedisConfig() RedisConfig {
 	return c.Redis
 }
@@ -100,3 +202,46 @@ func getEnvAsDuration(key string, defaultValue time.Duration) time.Duration {
 	}
 	return defaultValue
 }
+
+func getEnvAsBool(key string, defaultValue bool) bool {
+	if value := os.Getenv(key); value != "" {
+		if boolValue, err := strconv.ParseBool(value); err == nil {
+			return boolValue
+		}
+	}
+	return defaultValue
+}
+
+func getEnvAsLimit(key string, defaultValue ratelimit.Limit) ratelimit.Limit {
+	if value := os.Getenv(key); value != "" {
+		if limit, err := ratelimit.ParseLimit(value); err == nil {
+			return limit
+		}
+	}
+	return defaultValue
+}
+
+// лимиты маршрутов через ";": "POST /pullRequest/reassign=30/1m:10; POST /team/add=5/1m".
+// Ошибочная запись пропускается с предупреждением, чтобы опечатка не оставила без лимитов остальные маршруты
+func getEnvAsRouteLimits(key, defaultValue string) map[string]ratelimit.Limit {
+	limits := map[string]ratelimit.Limit{}
+	for _, entry := range strings.Split(getEnv(key, defaultValue), ";") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		route, spec, ok := strings.Cut(entry, "=")
+		method, path, hasPath := strings.Cut(strings.TrimSpace(route), " ")
+		if !ok || !hasPath {
+			log.Printf("config: %s: skip %q: expected \"METHOD /path=rate/period[:burst]\"", key, entry)
+			continue
+		}
+		limit, err := ratelimit.ParseLimit(spec)
+		if err != nil {
+			log.Printf("config: %s: skip %q: %v", key, entry, err)
+			continue
+		}
+		limits[strings.ToUpper(method)+" "+strings.TrimSpace(path)] = limit
+	}
+	return limits
+}
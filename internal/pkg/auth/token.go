@@ -0,0 +1,79 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+)
+
+var ErrInvalidToken = errors.New("invalid token")
+
+// полезная нагрузка токена доступа; Organization - slug организации, в которой работает запрос,
+// Admin - служебный токен, которому доступно управление организациями
+type Claims struct {
+	Subject      string `json:"sub,omitempty"`
+	Organization string `json:"org,omitempty"`
+	Admin        bool   `json:"admin,omitempty"`
+	ExpiresAt    int64  `json:"exp,omitempty"`
+}
+
+// проверяет JWT с подписью HS256 и сроком действия; другие алгоритмы не принимаются
+func ParseToken(token string, secret []byte, now time.Time) (*Claims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, ErrInvalidToken
+	}
+
+	var header struct {
+		Alg string `json:"alg"`
+	}
+	if err := decodeSegment(parts[0], &header); err != nil || header.Alg != "HS256" {
+		return nil, ErrInvalidToken
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil || !hmac.Equal(signature, sign(parts[0]+"."+parts[1], secret)) {
+		return nil, ErrInvalidToken
+	}
+
+	var claims Claims
+	if err := decodeSegment(parts[1], &claims); err != nil {
+		return nil, ErrInvalidToken
+	}
+	if claims.ExpiresAt != 0 && now.Unix() >= claims.ExpiresAt {
+		return nil, ErrInvalidToken
+	}
+	return &claims, nil
+}
+
+// выпускает токен HS256; используется в тестах и служебных утилитах
+func IssueToken(claims Claims, secret []byte) (string, error) {
+	header, err := json.Marshal(map[string]string{"alg": "HS256", "typ": "JWT"})
+	if err != nil {
+		return "", err
+	}
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+	unsigned := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	return unsigned + "." + base64.RawURLEncoding.EncodeToString(sign(unsigned, secret)), nil
+}
+
+func sign(unsigned string, secret []byte) []byte {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(unsigned))
+	return mac.Sum(nil)
+}
+
+func decodeSegment(segment string, v interface{}) error {
+	data, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
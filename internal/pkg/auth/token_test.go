@@ -0,0 +1,34 @@
+package auth
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseToken(t *testing.T) {
+	secret := []byte("secret")
+	now := time.Unix(1_700_000_000, 0)
+	token, err := IssueToken(Claims{Subject: "u1", Organization: "payments", ExpiresAt: now.Add(time.Hour).Unix()}, secret)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	claims, err := ParseToken(token, secret, now)
+	if err != nil || claims.Organization != "payments" || claims.Subject != "u1" {
+		t.Fatalf("ожидался валидный токен организации payments, получено %+v, %v", claims, err)
+	}
+
+	if _, err := ParseToken(token, []byte("other"), now); err != ErrInvalidToken {
+		t.Fatalf("токен с чужим секретом должен отклоняться, получено %v", err)
+	}
+	if _, err := ParseToken(token, secret, now.Add(2*time.Hour)); err != ErrInvalidToken {
+		t.Fatalf("просроченный токен должен отклоняться, получено %v", err)
+	}
+
+	parts := strings.Split(token, ".")
+	forged := "eyJhbGciOiJub25lIn0." + parts[1] + "."
+	if _, err := ParseToken(forged, secret, now); err != ErrInvalidToken {
+		t.Fatalf("токен без подписи должен отклоняться, получено %v", err)
+	}
+}
@@ -0,0 +1,14 @@
+package clock
+
+import "time"
+
+// источник текущего времени; в тестах подменяется фиксированными часами
+type Clock interface {
+	Now() time.Time
+}
+
+type Real struct{}
+
+func (Real) Now() time.Time {
+	return time.Now()
+}
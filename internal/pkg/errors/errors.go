@@ -1,19 +1,63 @@
 package serverrors
 
 import (
+	"strings"
+
 	openapi "github.com/wozhdeleniye/avito-tech-internship/api"
 )
 
+// ошибка сервиса: HTTPCode и Code отдаются клиенту, Err - исходная причина только для журнала сервера
 type ServiceError struct {
 	HTTPCode int
 	Code     openapi.ErrorResponseErrorCode
 	Message  string
+	Details  []openapi.FieldError
+	Err      error
 }
 
 func (e *ServiceError) Error() string {
+	if e.Err != nil {
+		return string(e.Code) + ": " + e.Message + ": " + e.Err.Error()
+	}
 	return e.Message
 }
 
+func (e *ServiceError) Unwrap() error {
+	return e.Err
+}
+
+// ошибки каталога сравниваются по коду, поэтому errors.Is(err, ErrUnknown) верно и для обёрнутой копии
+func (e *ServiceError) Is(target error) bool {
+	t, ok := target.(*ServiceError)
+	return ok && t.Code == e.Code
+}
+
+// копия ошибки каталога с причиной: serverrors.ErrUnknown.Wrap(fmt.Errorf("load team %s: %w", name, err))
+func (e *ServiceError) Wrap(err error) *ServiceError {
+	c := *e
+	c.Err = err
+	return &c
+}
+
+// копия ошибки каталога с уточнённым сообщением для клиента
+func (e *ServiceError) WithMessage(message string) *ServiceError {
+	c := *e
+	c.Message = message
+	return &c
+}
+
+// копия INVALID_REQUEST-подобной ошибки с ошибками по полям; сообщение перечисляет их для клиентов без разбора details
+func (e *ServiceError) WithDetails(details []openapi.FieldError) *ServiceError {
+	c := *e
+	c.Details = details
+	parts := make([]string, 0, len(details))
+	for _, d := range details {
+		parts = append(parts, d.Field+": "+d.Message)
+	}
+	c.Message = strings.Join(parts, "; ")
+	return &c
+}
+
 var (
 	ErrTeamExists = &ServiceError{HTTPCode: 400, Code: "TEAM_EXISTS", Message: "team_name already in use"}
 
@@ -21,25 +65,62 @@ var (
 	ErrUserExists   = &ServiceError{HTTPCode: 409, Code: "USER_EXISTS", Message: "user exists"}
 )
 var (
-	ErrUnknown = &ServiceError{HTTPCode: 520, Code: "UNKNOWN_ERROR", Message: "unknown error"}
+	ErrUnknown          = &ServiceError{HTTPCode: 500, Code: "UNKNOWN_ERROR", Message: "unknown error"}
+	ErrNotFound         = &ServiceError{HTTPCode: 404, Code: "NOT_FOUND", Message: "resource not found"}
+	ErrMethodNotAllowed = &ServiceError{HTTPCode: 405, Code: "METHOD_NOT_ALLOWED", Message: "method not allowed"}
+)
+var (
+	ErrTeamNotFound  = &ServiceError{HTTPCode: 404, Code: "TEAM_NOT_FOUND", Message: "team not found"}
+	ErrTeamNotEmpty  = &ServiceError{HTTPCode: 409, Code: "TEAM_NOT_EMPTY", Message: "team still has members"}
+	ErrNotTeamMember = &ServiceError{HTTPCode: 409, Code: "NOT_TEAM_MEMBER", Message: "user is not a member of the team"}
+	ErrInvalidRole   = &ServiceError{HTTPCode: 400, Code: "INVALID_ROLE", Message: "role must be one of member, lead, observer"}
+	ErrInvalidSLA    = &ServiceError{HTTPCode: 400, Code: "INVALID_SLA", Message: "first_review_hours must be >= 0 and action one of remind, reassign"}
 )
 var (
-	ErrTeamNotFound = &ServiceError{HTTPCode: 404, Code: "TEAM_NOT_FOUND", Message: "team not found"}
+	ErrPRNotFound  = &ServiceError{HTTPCode: 404, Code: "PR_NOT_FOUND", Message: "pull request not found"}
+	ErrJobNotFound = &ServiceError{HTTPCode: 404, Code: "JOB_NOT_FOUND", Message: "job not found"}
 )
 var (
-	ErrPRNotFound = &ServiceError{HTTPCode: 404, Code: "PR_NOT_FOUND", Message: "pull request not found"}
+	ErrChangesetNotFound   = &ServiceError{HTTPCode: 404, Code: "CHANGESET_NOT_FOUND", Message: "changeset not found"}
+	ErrChangesetReverted   = &ServiceError{HTTPCode: 409, Code: "CHANGESET_REVERTED", Message: "changeset already reverted"}
+	ErrChangesetInProgress = &ServiceError{HTTPCode: 409, Code: "CHANGESET_IN_PROGRESS", Message: "operation is still applying the changeset"}
 )
 var (
-	ErrPRExists = &ServiceError{HTTPCode: 409, Code: "PR_EXISTS", Message: "PR id already exists"}
+	ErrPRExists    = &ServiceError{HTTPCode: 409, Code: "PR_EXISTS", Message: "PR id already exists"}
+	ErrPRAmbiguous = &ServiceError{HTTPCode: 409, Code: "PR_AMBIGUOUS", Message: "PR id exists in several repositories, specify repository"}
 )
 var (
-	ErrNoAvailableReviewers = &ServiceError{Code: "NO_AVAILABLE_REVIEWERS", Message: "no available reviewers in the team"}
+	ErrRepositoryNotFound = &ServiceError{HTTPCode: 404, Code: "REPOSITORY_NOT_FOUND", Message: "repository not found"}
+	ErrRepositoryExists   = &ServiceError{HTTPCode: 409, Code: "REPOSITORY_EXISTS", Message: "repository already exists"}
 )
 var (
-	ErrUnauthorized = &ServiceError{Code: "UNAUTHORIZED", Message: "unauthorized access"}
+	ErrOrganizationNotFound = &ServiceError{HTTPCode: 404, Code: "ORGANIZATION_NOT_FOUND", Message: "organization not found"}
+	ErrOrganizationExists   = &ServiceError{HTTPCode: 409, Code: "ORGANIZATION_EXISTS", Message: "organization already exists"}
 )
 var (
-	ErrInvalidToken = &ServiceError{Code: "INVALID_TOKEN", Message: "invalid token"}
+	ErrIdempotencyKeyReused  = &ServiceError{HTTPCode: 422, Code: "IDEMPOTENCY_KEY_REUSED", Message: "Idempotency-Key was already used with a different request"}
+	ErrIdempotencyInProgress = &ServiceError{HTTPCode: 409, Code: "IDEMPOTENCY_IN_PROGRESS", Message: "request with this Idempotency-Key is still in progress"}
+)
+var (
+	ErrRateLimited = &ServiceError{HTTPCode: 429, Code: "RATE_LIMITED", Message: "too many requests, retry after the time in Retry-After"}
+)
+var (
+	ErrInvalidCursor     = &ServiceError{HTTPCode: 400, Code: "INVALID_CURSOR", Message: "cursor is malformed"}
+	ErrInvalidLimit      = &ServiceError{HTTPCode: 400, Code: "INVALID_LIMIT", Message: "limit must be between 1 and 100"}
+	ErrInvalidQuery      = &ServiceError{HTTPCode: 400, Code: "INVALID_QUERY", Message: "search query is empty"}
+	ErrInvalidFormat     = &ServiceError{HTTPCode: 406, Code: "INVALID_FORMAT", Message: "export format must be csv or ndjson"}
+	ErrInvalidImportFile = &ServiceError{HTTPCode: 400, Code: "INVALID_IMPORT_FILE", Message: "import file must be yaml or csv"}
+	ErrInvalidRequest    = &ServiceError{HTTPCode: 400, Code: "INVALID_REQUEST", Message: "request does not match the API contract"}
+)
+var (
+	ErrNoAvailableReviewers = &ServiceError{HTTPCode: 409, Code: "NO_AVAILABLE_REVIEWERS", Message: "no available reviewers in the team"}
+)
+var (
+	ErrUnauthorized = &ServiceError{HTTPCode: 401, Code: "UNAUTHORIZED", Message: "unauthorized access"}
+)
+var (
+	ErrInvalidToken = &ServiceError{HTTPCode: 401, Code: "INVALID_TOKEN", Message: "invalid token"}
+	ErrForbidden    = &ServiceError{HTTPCode: 403, Code: "FORBIDDEN", Message: "admin token is required"}
 )
 
 var (
@@ -47,3 +128,16 @@ var (
 	ErrNotAssigned = &ServiceError{HTTPCode: 409, Code: "NOT_ASSIGNED", Message: "reviewer is not assigned to this PR"}
 	ErrNoCandidate = &ServiceError{HTTPCode: 409, Code: "NO_CANDIDATE", Message: "no active replacement candidate in team"}
 )
+
+// все коды, которые сервис может вернуть клиенту; должен совпадать с перечислением в ErrorResponse openapi.yml
+var Catalogue = []*ServiceError{
+	ErrInvalidRequest, ErrInvalidCursor, ErrInvalidLimit, ErrInvalidQuery, ErrInvalidRole, ErrInvalidSLA,
+	ErrInvalidImportFile, ErrTeamExists,
+	ErrUnauthorized, ErrInvalidToken, ErrForbidden,
+	ErrNotFound, ErrTeamNotFound, ErrUserNotFound, ErrPRNotFound, ErrJobNotFound, ErrChangesetNotFound,
+	ErrRepositoryNotFound, ErrOrganizationNotFound, ErrMethodNotAllowed, ErrInvalidFormat,
+	ErrUserExists, ErrPRExists, ErrPRAmbiguous, ErrRepositoryExists, ErrOrganizationExists, ErrPRMerged, ErrNotAssigned, ErrNoCandidate, ErrNoAvailableReviewers,
+	ErrTeamNotEmpty, ErrNotTeamMember, ErrChangesetReverted, ErrChangesetInProgress, ErrIdempotencyInProgress,
+	ErrIdempotencyKeyReused, ErrRateLimited,
+	ErrUnknown,
+}
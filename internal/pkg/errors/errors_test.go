@@ -0,0 +1,55 @@
+package serverrors
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	openapi "github.com/wozhdeleniye/avito-tech-internship/api"
+)
+
+func TestCatalogueMatchesSpec(t *testing.T) {
+	spec, err := openapi.GetSwagger()
+	if err != nil {
+		t.Fatalf("спецификация не загрузилась: %v", err)
+	}
+	codeSchema := spec.Components.Schemas["ErrorResponse"].Value.Properties["error"].Value.Properties["code"].Value
+
+	inSpec := map[string]bool{}
+	for _, v := range codeSchema.Enum {
+		inSpec[v.(string)] = true
+	}
+
+	seen := map[openapi.ErrorResponseErrorCode]bool{}
+	for _, e := range Catalogue {
+		if seen[e.Code] {
+			t.Fatalf("код %s повторяется в каталоге", e.Code)
+		}
+		seen[e.Code] = true
+		if !inSpec[string(e.Code)] {
+			t.Fatalf("кода %s нет в перечислении ErrorResponse", e.Code)
+		}
+		if e.HTTPCode < 400 || http.StatusText(e.HTTPCode) == "" {
+			t.Fatalf("у %s нестандартный HTTP-статус %d", e.Code, e.HTTPCode)
+		}
+	}
+	if len(seen) != len(inSpec) {
+		t.Fatalf("в спецификации %d кодов, в каталоге %d", len(inSpec), len(seen))
+	}
+}
+
+func TestWrapKeepsCodeAndCause(t *testing.T) {
+	cause := errors.New("connection refused")
+	serr := ErrUnknown.Wrap(fmt.Errorf("load team backend: %w", cause))
+
+	if !errors.Is(serr, ErrUnknown) || errors.Is(serr, ErrTeamNotFound) {
+		t.Fatalf("обёрнутая ошибка должна сравниваться по коду: %v", serr)
+	}
+	if !errors.Is(serr, cause) {
+		t.Fatalf("причина должна быть доступна через errors.Is: %v", serr)
+	}
+	if serr.Message != ErrUnknown.Message || ErrUnknown.Err != nil {
+		t.Fatalf("Wrap не должен менять сообщение клиенту и ошибку каталога")
+	}
+}
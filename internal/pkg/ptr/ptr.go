@@ -0,0 +1,17 @@
+package ptr
+
+// пустая строка - отсутствующее необязательное поле
+func Optional(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+// отсутствующее необязательное поле - пустая строка
+func Deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -0,0 +1,54 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/wozhdeleniye/avito-tech-internship/internal/pkg/clock"
+)
+
+type bucket struct {
+	tokens  float64
+	updated time.Time
+	full    time.Time
+}
+
+// корзины в памяти процесса; при нескольких репликах каждая считает свои запросы
+type MemoryStore struct {
+	clk       clock.Clock
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+}
+
+// как часто из памяти убираются корзины, которые уже наполнились и ничем не отличаются от новых
+const sweepInterval = time.Minute
+
+func NewMemoryStore(clk clock.Clock) *MemoryStore {
+	return &MemoryStore{clk: clk, buckets: map[string]*bucket{}, lastSweep: clk.Now()}
+}
+
+func (s *MemoryStore) Take(_ context.Context, key string, limit Limit) (Result, error) {
+	now := s.clk.Now()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if now.Sub(s.lastSweep) >= sweepInterval {
+		for k, b := range s.buckets {
+			if !now.Before(b.full) {
+				delete(s.buckets, k)
+			}
+		}
+		s.lastSweep = now
+	}
+
+	b, ok := s.buckets[key]
+	if !ok {
+		b = &bucket{tokens: float64(limit.Burst), updated: now}
+		s.buckets[key] = b
+	}
+	tokens, res := take(b.tokens, max(now.Sub(b.updated), 0), limit)
+	b.tokens, b.updated, b.full = tokens, now, now.Add(res.Reset)
+	return res, nil
+}
@@ -0,0 +1,99 @@
+// Package ratelimit - ограничение частоты запросов корзиной токенов. Корзина на ключ (маршрут и клиент)
+// вмещает Burst токенов и пополняется на Rate токенов за Period; запрос забирает один токен.
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// лимит корзины: Rate запросов за Period, всплеск до Burst запросов подряд
+type Limit struct {
+	Rate   int
+	Period time.Duration
+	Burst  int
+}
+
+func (l Limit) valid() bool {
+	return l.Rate > 0 && l.Period > 0 && l.Burst > 0
+}
+
+// интервал пополнения одного токена
+func (l Limit) interval() time.Duration {
+	return max(l.Period/time.Duration(l.Rate), 1)
+}
+
+// лимит в виде rate/period[:burst], например 10/1m или 100/1s:20; без burst всплеск равен rate
+func ParseLimit(s string) (Limit, error) {
+	spec, burst, hasBurst := strings.Cut(strings.TrimSpace(s), ":")
+	rate, period, ok := strings.Cut(spec, "/")
+	if !ok {
+		return Limit{}, fmt.Errorf("rate limit %q: expected rate/period[:burst]", s)
+	}
+	var l Limit
+	var err error
+	if l.Rate, err = strconv.Atoi(rate); err != nil {
+		return Limit{}, fmt.Errorf("rate limit %q: rate: %w", s, err)
+	}
+	if l.Period, err = time.ParseDuration(period); err != nil {
+		return Limit{}, fmt.Errorf("rate limit %q: period: %w", s, err)
+	}
+	l.Burst = l.Rate
+	if hasBurst {
+		if l.Burst, err = strconv.Atoi(burst); err != nil {
+			return Limit{}, fmt.Errorf("rate limit %q: burst: %w", s, err)
+		}
+	}
+	if !l.valid() {
+		return Limit{}, fmt.Errorf("rate limit %q: rate, period and burst must be positive", s)
+	}
+	return l, nil
+}
+
+func (l Limit) String() string {
+	return fmt.Sprintf("%d/%s:%d", l.Rate, l.Period, l.Burst)
+}
+
+// исход запроса токена: Remaining - сколько запросов ещё пройдёт сразу, Reset - через сколько корзина
+// наполнится целиком, RetryAfter - через сколько появится токен, если запрос отклонён
+type Result struct {
+	Allowed    bool
+	Limit      int
+	Remaining  int
+	Reset      time.Duration
+	RetryAfter time.Duration
+}
+
+// хранилище корзин; в памяти для одной реплики, в Redis - общее для всех реплик
+type Store interface {
+	Take(ctx context.Context, key string, limit Limit) (Result, error)
+}
+
+// пополняет корзину за прошедшее время и забирает токен; tokens - остаток после предыдущего запроса
+func take(tokens float64, elapsed time.Duration, limit Limit) (float64, Result) {
+	tokens = math.Min(float64(limit.Burst), tokens+float64(elapsed)/float64(limit.interval()))
+	allowed := tokens >= 1
+	if allowed {
+		tokens--
+	}
+	return tokens, result(tokens, allowed, limit)
+}
+
+// исход по остатку токенов после запроса
+func result(tokens float64, allowed bool, limit Limit) Result {
+	perToken := float64(limit.interval())
+	res := Result{
+		Allowed:   allowed,
+		Limit:     limit.Burst,
+		Remaining: int(tokens),
+		Reset:     time.Duration(math.Ceil((float64(limit.Burst) - tokens) * perToken)),
+	}
+	if !allowed {
+		res.RetryAfter = time.Duration(math.Ceil((1 - tokens) * perToken))
+	}
+	return res
+}
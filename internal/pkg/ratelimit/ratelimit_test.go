@@ -0,0 +1,63 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+type fixedClock struct{ now time.Time }
+
+func (c *fixedClock) Now() time.Time { return c.now }
+
+func TestParseLimit(t *testing.T) {
+	cases := map[string]Limit{
+		"10/1m":    {Rate: 10, Period: time.Minute, Burst: 10},
+		"100/1s:5": {Rate: 100, Period: time.Second, Burst: 5},
+	}
+	for in, want := range cases {
+		got, err := ParseLimit(in)
+		if err != nil || got != want {
+			t.Fatalf("ParseLimit(%q) = %+v, %v, ожидалось %+v", in, got, err, want)
+		}
+	}
+	for _, in := range []string{"", "10", "0/1m", "10/0s", "10/1m:0", "x/1m", "10/minute"} {
+		if _, err := ParseLimit(in); err == nil {
+			t.Fatalf("ParseLimit(%q) должен вернуть ошибку", in)
+		}
+	}
+}
+
+func TestMemoryStoreTokenBucket(t *testing.T) {
+	clk := &fixedClock{now: time.Unix(1_700_000_000, 0)}
+	store := NewMemoryStore(clk)
+	limit := Limit{Rate: 2, Period: time.Second, Burst: 3}
+	ctx := context.Background()
+
+	for i := 2; i >= 0; i-- {
+		res, _ := store.Take(ctx, "a", limit)
+		if !res.Allowed || res.Remaining != i || res.Limit != 3 {
+			t.Fatalf("запрос в пределах всплеска должен пройти с остатком %d, получено %+v", i, res)
+		}
+	}
+	res, _ := store.Take(ctx, "a", limit)
+	if res.Allowed || res.RetryAfter != 500*time.Millisecond || res.Reset != 1500*time.Millisecond {
+		t.Fatalf("пустая корзина должна отклонять запрос на 500ms, получено %+v", res)
+	}
+	if res, _ := store.Take(ctx, "b", limit); !res.Allowed {
+		t.Fatalf("у другого ключа своя корзина, получено %+v", res)
+	}
+
+	clk.now = clk.now.Add(500 * time.Millisecond)
+	if res, _ := store.Take(ctx, "a", limit); !res.Allowed || res.Remaining != 0 {
+		t.Fatalf("за 500ms должен пополниться один токен, получено %+v", res)
+	}
+
+	clk.now = clk.now.Add(time.Hour)
+	if res, _ := store.Take(ctx, "a", limit); !res.Allowed || res.Remaining != 2 {
+		t.Fatalf("корзина не должна наполняться сверх всплеска, получено %+v", res)
+	}
+	if _, ok := store.buckets["b"]; ok {
+		t.Fatal("наполнившаяся корзина должна убираться из памяти")
+	}
+}
@@ -0,0 +1,64 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// корзина хранится в хеше {tokens, ts}; время берётся из TIME сервера Redis, чтобы расхождение часов
+// реплик не влияло на пополнение. Ключ истекает, когда корзина наполнилась бы целиком
+var takeScript = redis.NewScript(`
+local burst = tonumber(ARGV[1])
+local per_token = tonumber(ARGV[2])
+local t = redis.call('TIME')
+local now = tonumber(t[1]) * 1000000 + tonumber(t[2])
+
+local state = redis.call('HMGET', KEYS[1], 'tokens', 'ts')
+local tokens = tonumber(state[1])
+local ts = tonumber(state[2])
+if tokens == nil or ts == nil then
+	tokens = burst
+	ts = now
+end
+tokens = math.min(burst, tokens + math.max(now - ts, 0) / per_token)
+
+local allowed = 0
+if tokens >= 1 then
+	tokens = tokens - 1
+	allowed = 1
+end
+redis.call('HSET', KEYS[1], 'tokens', tostring(tokens), 'ts', now)
+redis.call('PEXPIRE', KEYS[1], math.ceil((burst - tokens) * per_token / 1000) + 1000)
+return {allowed, tostring(tokens)}
+`)
+
+// корзины в Redis, общие для всех реплик сервиса
+type RedisStore struct {
+	client *redis.Client
+	prefix string
+}
+
+func NewRedisStore(client *redis.Client) *RedisStore {
+	return &RedisStore{client: client, prefix: "ratelimit:"}
+}
+
+func (s *RedisStore) Take(ctx context.Context, key string, limit Limit) (Result, error) {
+	perToken := max(limit.interval().Microseconds(), 1)
+	reply, err := takeScript.Run(ctx, s.client, []string{s.prefix + key}, limit.Burst, perToken).Slice()
+	if err != nil {
+		return Result{}, fmt.Errorf("rate limit %s: %w", key, err)
+	}
+	if len(reply) != 2 {
+		return Result{}, fmt.Errorf("rate limit %s: unexpected reply %v", key, reply)
+	}
+	allowed, _ := reply[0].(int64)
+	left, _ := reply[1].(string)
+	tokens, err := strconv.ParseFloat(left, 64)
+	if err != nil {
+		return Result{}, fmt.Errorf("rate limit %s: tokens: %w", key, err)
+	}
+	return result(tokens, allowed == 1, limit), nil
+}
@@ -0,0 +1,114 @@
+package validation
+
+import (
+	"fmt"
+
+	"github.com/go-playground/validator/v10"
+	openapi "github.com/wozhdeleniye/avito-tech-internship/api"
+)
+
+// правила для тел запросов задаются здесь, а не тегами: server.gen.go перезаписывается при go generate.
+// Ключ - Go-имя поля сгенерированного типа
+const (
+	IDRule       = "required,id,max=64"
+	TeamNameRule = "required,name,max=128"
+	UsernameRule = "required,name,max=128"
+	RepoNameRule = "required,name,max=128"
+
+	roleRule    = "omitempty,oneof=member lead observer"
+	repoRefRule = "omitempty,name,max=128"
+	userIDsRule = "required,min=1,max=100,unique,dive," + IDRule
+)
+
+var requestRules = []struct {
+	typ    any
+	fields map[string]string
+}{
+	{openapi.Team{}, map[string]string{
+		"TeamName": TeamNameRule,
+		"Members":  "max=1000,dive",
+	}},
+	{openapi.TeamMember{}, map[string]string{
+		"UserId":   IDRule,
+		"Username": UsernameRule,
+		"Role":     roleRule,
+	}},
+	{openapi.PostUsersSetIsActiveJSONBody{}, map[string]string{
+		"UserId": IDRule,
+	}},
+	{openapi.PostPullRequestCreateJSONBody{}, map[string]string{
+		"PullRequestId":   IDRule,
+		"PullRequestName": "required,name,max=256",
+		"AuthorId":        IDRule,
+		"Repository":      repoRefRule,
+	}},
+	{openapi.PostPullRequestMergeJSONBody{}, map[string]string{
+		"PullRequestId": IDRule,
+		"Repository":    repoRefRule,
+	}},
+	{openapi.PostPullRequestReassignJSONBody{}, map[string]string{
+		"PullRequestId": IDRule,
+		"OldUserId":     IDRule,
+		"Repository":    repoRefRule,
+	}},
+	{openapi.PostPullRequestReviewJSONBody{}, map[string]string{
+		"PullRequestId": IDRule,
+		"UserId":        IDRule,
+		"Repository":    repoRefRule,
+	}},
+	{openapi.Repository{}, map[string]string{
+		"Name":      RepoNameRule,
+		"OwnerTeam": TeamNameRule,
+	}},
+	{openapi.Organization{}, map[string]string{
+		"Slug": IDRule,
+		"Name": "required,name,max=128",
+	}},
+	{openapi.PostRepositorySetPolicyJSONBody{}, map[string]string{
+		"Name": RepoNameRule,
+	}},
+	{openapi.ReviewerPolicy{}, map[string]string{
+		"ReviewerSource": "omitempty,oneof=author_team owner_team",
+		"ReviewersCount": "omitempty,min=0,max=5",
+	}},
+	{openapi.PostTeamRenameJSONBody{}, map[string]string{
+		"TeamName":    TeamNameRule,
+		"NewTeamName": TeamNameRule,
+	}},
+	{openapi.PostTeamSetSlaJSONBody{}, map[string]string{
+		"TeamName": TeamNameRule,
+	}},
+	{openapi.PostTeamDeleteJSONBody{}, map[string]string{
+		"TeamName": TeamNameRule,
+	}},
+	{openapi.PostTeamAddMembersJSONBody{}, map[string]string{
+		"TeamName": TeamNameRule,
+		"UserIds":  userIDsRule,
+		"Role":     roleRule,
+	}},
+	{openapi.PostTeamRemoveMembersJSONBody{}, map[string]string{
+		"TeamName": TeamNameRule,
+		"UserIds":  userIDsRule,
+	}},
+	{openapi.PostTeamMoveMemberJSONBody{}, map[string]string{
+		"UserId":       IDRule,
+		"FromTeamName": "omitempty,name,max=128",
+		"ToTeamName":   TeamNameRule,
+	}},
+	{openapi.PostAdminTeamDeactivateJSONBody{}, map[string]string{
+		"OldTeamName": TeamNameRule,
+		"NewTeamName": TeamNameRule,
+	}},
+}
+
+// повтор user_id в members помечается на самом повторе, чтобы остальные ошибки элементов тоже попали в ответ
+func uniqueMembers(sl validator.StructLevel) {
+	team := sl.Current().Interface().(openapi.Team)
+	seen := make(map[string]bool, len(team.Members))
+	for i, m := range team.Members {
+		if m.UserId != "" && seen[m.UserId] {
+			sl.ReportError(m.UserId, fmt.Sprintf("members[%d].user_id", i), "UserId", "unique", "")
+		}
+		seen[m.UserId] = true
+	}
+}
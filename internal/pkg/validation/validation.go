@@ -0,0 +1,134 @@
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/go-playground/validator/v10"
+	openapi "github.com/wozhdeleniye/avito-tech-internship/api"
+	serverrors "github.com/wozhdeleniye/avito-tech-internship/internal/pkg/errors"
+)
+
+var validate = newValidator()
+
+// идентификаторы пользователей и PR: латиница, цифры и . _ - : @, первый символ - буква или цифра
+var idPattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._:@-]*$`)
+
+func newValidator() *validator.Validate {
+	v := validator.New()
+	v.RegisterTagNameFunc(jsonName)
+	if err := v.RegisterValidation("id", isID); err != nil {
+		panic(err)
+	}
+	if err := v.RegisterValidation("name", isName); err != nil {
+		panic(err)
+	}
+	for _, r := range requestRules {
+		v.RegisterStructValidationMapRules(r.fields, r.typ)
+	}
+	v.RegisterStructValidation(uniqueMembers, openapi.Team{})
+	return v
+}
+
+// nil, если v проходит правила; иначе INVALID_REQUEST с ошибкой по каждому полю в details
+func Struct(v any) *serverrors.ServiceError {
+	err := validate.Struct(v)
+	if err == nil {
+		return nil
+	}
+	var fieldErrs validator.ValidationErrors
+	if !errors.As(err, &fieldErrs) {
+		return serverrors.ErrInvalidRequest.Wrap(err)
+	}
+
+	details := make([]openapi.FieldError, 0, len(fieldErrs))
+	for _, fe := range fieldErrs {
+		details = append(details, openapi.FieldError{Field: fieldPath(fe), Rule: fe.Tag(), Message: message(fe)})
+	}
+	return serverrors.ErrInvalidRequest.WithDetails(details)
+}
+
+// проверка одного значения вне структуры запроса (строки файла импорта); текст ошибки - как в details
+func Var(value any, tag string) error {
+	err := validate.Var(value, tag)
+	var fieldErrs validator.ValidationErrors
+	if errors.As(err, &fieldErrs) && len(fieldErrs) > 0 {
+		return errors.New(message(fieldErrs[0]))
+	}
+	return err
+}
+
+// проверка параметра вне тела запроса (поля gRPC-запроса, которые в REST проверяет openapi.yml); ошибка - как у Struct
+func Field(name string, value any, tag string) *serverrors.ServiceError {
+	err := validate.Var(value, tag)
+	if err == nil {
+		return nil
+	}
+	var fieldErrs validator.ValidationErrors
+	if !errors.As(err, &fieldErrs) || len(fieldErrs) == 0 {
+		return serverrors.ErrInvalidRequest.Wrap(err)
+	}
+	fe := fieldErrs[0]
+	return serverrors.ErrInvalidRequest.WithDetails([]openapi.FieldError{{Field: name, Rule: fe.Tag(), Message: message(fe)}})
+}
+
+func isID(fl validator.FieldLevel) bool {
+	return idPattern.MatchString(fl.Field().String())
+}
+
+// имя команды или пользователя: не пустое, без пробелов по краям и управляющих символов
+func isName(fl validator.FieldLevel) bool {
+	s := fl.Field().String()
+	if s == "" || strings.TrimSpace(s) != s || !utf8.ValidString(s) {
+		return false
+	}
+	return strings.IndexFunc(s, unicode.IsControl) < 0
+}
+
+func jsonName(fld reflect.StructField) string {
+	name, _, _ := strings.Cut(fld.Tag.Get("json"), ",")
+	if name == "-" {
+		return ""
+	}
+	return name
+}
+
+// Namespace начинается с имени Go-типа запроса, клиенту нужен путь от корня тела: members[1].user_id
+func fieldPath(fe validator.FieldError) string {
+	_, path, found := strings.Cut(fe.Namespace(), ".")
+	if !found {
+		return fe.Field()
+	}
+	return path
+}
+
+func message(fe validator.FieldError) string {
+	switch fe.Tag() {
+	case "required":
+		return "is required"
+	case "id":
+		return "must start with a letter or digit and contain only latin letters, digits and . _ - : @"
+	case "name":
+		return "must not be blank, have leading or trailing spaces or contain control characters"
+	case "max":
+		if fe.Kind() == reflect.Slice {
+			return fmt.Sprintf("must contain at most %s items", fe.Param())
+		}
+		return fmt.Sprintf("must be at most %s characters long", fe.Param())
+	case "min":
+		if fe.Kind() == reflect.Slice {
+			return fmt.Sprintf("must contain at least %s items", fe.Param())
+		}
+		return fmt.Sprintf("must be at least %s characters long", fe.Param())
+	case "unique":
+		return "must not repeat within the request"
+	case "oneof":
+		return "must be one of " + strings.ReplaceAll(fe.Param(), " ", ", ")
+	}
+	return fmt.Sprintf("failed %q rule", fe.Tag())
+}
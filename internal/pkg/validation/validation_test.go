@@ -0,0 +1,71 @@
+package validation
+
+import (
+	"strings"
+	"testing"
+
+	openapi "github.com/wozhdeleniye/avito-tech-internship/api"
+)
+
+func TestStructReportsEveryField(t *testing.T) {
+	role := openapi.TeamMemberRole("owner")
+	team := openapi.Team{
+		TeamName: " ",
+		Members: []openapi.TeamMember{
+			{UserId: "u1", Username: "Alice", IsActive: true},
+			{UserId: "u1", Username: "Alice again", IsActive: true},
+			{UserId: "bad id", Username: "Bob", Role: &role},
+			{UserId: strings.Repeat("x", 65), Username: ""},
+		},
+	}
+
+	serr := Struct(team)
+	if serr == nil {
+		t.Fatal("ожидалась ошибка проверки")
+	}
+	if serr.Code != "INVALID_REQUEST" || serr.HTTPCode != 400 {
+		t.Fatalf("ожидалась 400 INVALID_REQUEST, получено %d %s", serr.HTTPCode, serr.Code)
+	}
+
+	got := map[string]string{}
+	for _, d := range serr.Details {
+		got[d.Field] = d.Rule
+	}
+	want := map[string]string{
+		"team_name":           "name",
+		"members[1].user_id":  "unique",
+		"members[2].user_id":  "id",
+		"members[2].role":     "oneof",
+		"members[3].user_id":  "max",
+		"members[3].username": "required",
+	}
+	for field, rule := range want {
+		if got[field] != rule {
+			t.Fatalf("поле %s: ожидалось правило %s, получены ошибки %+v", field, rule, serr.Details)
+		}
+	}
+	if len(got) != len(want) {
+		t.Fatalf("лишние ошибки: %+v", serr.Details)
+	}
+}
+
+func TestStructAcceptsValidRequest(t *testing.T) {
+	req := openapi.PostTeamAddMembersJSONBody{TeamName: "payments", UserIds: []string{"u1", "u2.b@corp"}}
+	if serr := Struct(req); serr != nil {
+		t.Fatalf("неожиданная ошибка: %v %+v", serr, serr.Details)
+	}
+
+	req.UserIds = []string{"u1", "u1"}
+	if serr := Struct(req); serr == nil || serr.Details[0].Field != "user_ids" {
+		t.Fatalf("ожидалась ошибка уникальности user_ids, получено %+v", serr)
+	}
+}
+
+func TestVar(t *testing.T) {
+	if err := Var("backend team", TeamNameRule); err != nil {
+		t.Fatalf("имя с пробелом внутри допустимо: %v", err)
+	}
+	if err := Var("u 1", IDRule); err == nil || !strings.Contains(err.Error(), "latin letters") {
+		t.Fatalf("ожидалась ошибка формата id, получено %v", err)
+	}
+}
@@ -5,33 +5,85 @@ import (
 	"gorm.io/gorm"
 )
 
+// reset - перед миграцией удалить все таблицы; только для локальной разработки (DB_RESET_ON_START),
+// иначе при каждом старте пропадают незавершённые задачи, журнал аудита и наборы изменений
 type GormMigrator struct {
-	db *gorm.DB
+	db    *gorm.DB
+	reset bool
 }
 
-func NewGormMigrator(db *gorm.DB) *GormMigrator {
-	return &GormMigrator{db: db}
+func NewGormMigrator(db *gorm.DB, reset bool) *GormMigrator {
+	return &GormMigrator{db: db, reset: reset}
 }
 
 func (m *GormMigrator) Migrate() error {
 	m.db.Exec("SET CONSTRAINTS ALL DEFERRED")
 
-	tables, err := m.db.Migrator().GetTables()
-	if err != nil {
-		return err
+	if m.reset {
+		tables, err := m.db.Migrator().GetTables()
+		if err != nil {
+			return err
+		}
+		for _, table := range tables {
+			if err := m.db.Migrator().DropTable(table); err != nil {
+				return err
+			}
+		}
 	}
 
-	// закомментировать чтобы не дропать таблицы при миграции(если не забуду добавлю флажок для dev режима в конфиг)
-	for _, table := range tables {
-		if err := m.db.Migrator().DropTable(table); err != nil {
+	// ключ идемпотентности стал принадлежать клиенту, а первичный ключ AutoMigrate не меняет;
+	// в таблице только ответы для повторов, поэтому старую таблицу можно пересоздать
+	if m.db.Migrator().HasTable(&models.IdempotencyRecord{}) && !m.db.Migrator().HasColumn(&models.IdempotencyRecord{}, "Client") {
+		if err := m.db.Migrator().DropTable(&models.IdempotencyRecord{}); err != nil {
 			return err
 		}
 	}
 
-	err = m.db.AutoMigrate(
+	if err := m.db.SetupJoinTable(&models.PullRequest{}, "AssignedReviewers", &models.PullRequestReviewer{}); err != nil {
+		return err
+	}
+	if err := m.db.SetupJoinTable(&models.Team{}, "Members", &models.TeamMembership{}); err != nil {
+		return err
+	}
+
+	err := m.db.AutoMigrate(
+		&models.Organization{},
 		&models.User{},
 		&models.Team{},
+		&models.Repository{},
 		&models.PullRequest{},
+		&models.AuditEntry{},
+		&models.SLABreach{},
+		&models.Job{},
+		&models.JobItem{},
+		&models.Changeset{},
+		&models.ChangesetEntry{},
+		&models.IdempotencyRecord{},
 	)
-	return err
+	if err != nil {
+		return err
+	}
+
+	// запросы без организации работают в организации по умолчанию
+	if err := m.db.FirstOrCreate(&models.Organization{ID: models.DefaultOrganizationID, Slug: models.DefaultOrganizationSlug, Name: "Default"}).Error; err != nil {
+		return err
+	}
+
+	// pull_request_id PR вне репозиториев уникален среди таких PR организации; в репозитории уникальность даёт idx_pull_requests_repository_pr
+	if err := m.db.Exec("CREATE UNIQUE INDEX IF NOT EXISTS idx_pull_requests_no_repository ON pull_requests (organization_id, pull_request_custom_id) WHERE repository_id IS NULL").Error; err != nil {
+		return err
+	}
+
+	// у пользователя не больше одной основной команды
+	if err := m.db.Exec("CREATE UNIQUE INDEX IF NOT EXISTS idx_team_memberships_primary ON team_memberships (user_id) WHERE is_primary").Error; err != nil {
+		return err
+	}
+
+	// индекс для полнотекстового поиска по названию PR, есть только в postgres
+	if m.db.Dialector.Name() == "postgres" {
+		if err := m.db.Exec("CREATE INDEX IF NOT EXISTS idx_pull_requests_name_tsv ON pull_requests USING GIN (to_tsvector('simple', pull_request_name))").Error; err != nil {
+			return err
+		}
+	}
+	return nil
 }
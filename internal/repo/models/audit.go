@@ -0,0 +1,84 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"gorm.io/gorm"
+)
+
+// действия журнала аудита
+const (
+	AuditPRCreated           = "PR_CREATED"
+	AuditReviewerAssigned    = "REVIEWER_ASSIGNED"
+	AuditReviewerReassigned  = "REVIEWER_REASSIGNED"
+	AuditReviewSubmitted     = "REVIEW_SUBMITTED"
+	AuditPRMerged            = "PR_MERGED"
+	AuditUserActivated       = "USER_ACTIVATED"
+	AuditUserDeactivated     = "USER_DEACTIVATED"
+	AuditTeamMassDeactivated = "TEAM_MASS_DEACTIVATED"
+	AuditSLABreached         = "SLA_BREACHED"
+	AuditChangesetReverted   = "CHANGESET_REVERTED"
+)
+
+// причины переназначения и смены активности
+const (
+	ReasonManual           = "manual"
+	ReasonMemberRemoved    = "member_removed"
+	ReasonMemberMoved      = "member_moved"
+	ReasonTeamSync         = "team_sync"
+	ReasonMassDeactivation = "mass_deactivation"
+	ReasonUserDeactivated  = "user_deactivated"
+	ReasonSLABreach        = "sla_breach"
+	ReasonChangesetRevert  = "changeset_reverted"
+)
+
+// стратегии выбора ревьювера
+const (
+	StrategyRandomAuthorTeam   = "random_author_team"
+	StrategyRandomOwnerTeam    = "random_owner_team"
+	StrategyRandomReviewerTeam = "random_reviewer_team"
+	StrategyRandomTeam         = "random_team"
+	StrategyRandomTargetTeam   = "random_target_team"
+)
+
+// запись журнала аудита; таблица только дополняется, записи не изменяются и не удаляются.
+// идентификаторы хранятся в виде custom id, чтобы запись оставалась читаемой после удаления сущностей
+type AuditEntry struct {
+	ID                  int64     `gorm:"primaryKey;autoIncrement"`
+	OrganizationID      uuid.UUID `gorm:"type:uuid;not null;index"`
+	Action              string    `gorm:"type:varchar(32);not null;index"`
+	Actor               string    `gorm:"not null;index"`
+	PullRequestCustomID string    `gorm:"index"`
+	Repository          string
+	UserCustomID        string `gorm:"index"`
+	OldReviewerID       string
+	NewReviewerID       string
+	TeamName            string `gorm:"index"`
+	Reason              string
+	Strategy            string
+	CreatedAt           int64 `gorm:"not null;index"`
+}
+
+func (a *AuditEntry) BeforeCreate(tx *gorm.DB) error {
+	if a.CreatedAt == 0 {
+		a.CreatedAt = time.Now().Unix()
+	}
+	return nil
+}
+
+// фильтр выборки журнала; UserCustomID ищется также среди старого и нового ревьювера,
+// Repository nil - PR из любого репозитория, пустая строка - PR вне репозиториев
+type AuditFilter struct {
+	Action              string
+	Actor               string
+	PullRequestCustomID string
+	Repository          *string
+	UserCustomID        string
+	TeamName            string
+	From                *int64
+	To                  *int64
+	Limit               int
+	AfterID             int64
+}
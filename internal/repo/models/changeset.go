@@ -0,0 +1,55 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// виды обратимых наборов изменений
+const (
+	ChangesetMassDeactivation = "mass_deactivation"
+	ChangesetUserDeactivation = "user_deactivation"
+)
+
+// изменения внутри набора
+const (
+	ChangeUserDeactivated    = "user_deactivated"
+	ChangeReviewerReassigned = "reviewer_reassigned"
+)
+
+// набор изменений массовой операции, по которому её можно откатить; CompletedAt проставляется,
+// когда операция закончила вносить изменения, RevertedAt - после отката
+type Changeset struct {
+	ID             uuid.UUID `gorm:"type:uuid;primaryKey"`
+	OrganizationID uuid.UUID `gorm:"type:uuid;not null;index"`
+	Kind           string    `gorm:"type:varchar(32);not null"`
+	Actor          string    `gorm:"not null"`
+	TeamName       string
+	CreatedAt      int64 `gorm:"not null"`
+	CompletedAt    *int64
+	RevertedAt     *int64
+	RevertedBy     string
+}
+
+func (c *Changeset) BeforeCreate(tx *gorm.DB) error {
+	if c.ID == uuid.Nil {
+		c.ID = uuid.New()
+	}
+	if c.CreatedAt == 0 {
+		c.CreatedAt = time.Now().Unix()
+	}
+	return nil
+}
+
+// одно изменение набора в порядке применения; для замены ревьювера UserCustomID - исходный ревьювер
+type ChangesetEntry struct {
+	ID                  int64     `gorm:"primaryKey;autoIncrement"`
+	ChangesetID         uuid.UUID `gorm:"type:uuid;not null;index"`
+	Kind                string    `gorm:"type:varchar(32);not null"`
+	UserCustomID        string    `gorm:"not null"`
+	PullRequestCustomID string
+	Repository          string
+	NewReviewerID       string
+}
@@ -0,0 +1,103 @@
+package models
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/wozhdeleniye/avito-tech-internship/internal/pkg/ptr"
+)
+
+// строка выгрузки: в NDJSON кодируется как есть, в CSV - значениями в порядке заголовка
+type ExportRow interface {
+	CSVRecord() []string
+}
+
+var PullRequestExportHeader = []string{"pull_request_id", "repository", "pull_request_name", "author_id", "team_name", "status", "created_at", "merged_at"}
+
+type PullRequestExportRow struct {
+	PullRequestID   string  `json:"pull_request_id"`
+	Repository      string  `json:"repository,omitempty"`
+	PullRequestName string  `json:"pull_request_name"`
+	AuthorID        string  `json:"author_id"`
+	TeamName        string  `json:"team_name"`
+	Status          string  `json:"status"`
+	CreatedAt       string  `json:"created_at"`
+	MergedAt        *string `json:"merged_at"`
+}
+
+func (r *PullRequestExportRow) CSVRecord() []string {
+	return []string{r.PullRequestID, r.Repository, r.PullRequestName, r.AuthorID, r.TeamName, r.Status, r.CreatedAt, ptr.Deref(r.MergedAt)}
+}
+
+var AssignmentExportHeader = []string{"pull_request_id", "repository", "reviewer_id", "status", "assigned_at", "responded_at"}
+
+type AssignmentExportRow struct {
+	PullRequestID string  `json:"pull_request_id"`
+	Repository    string  `json:"repository,omitempty"`
+	ReviewerID    string  `json:"reviewer_id"`
+	Status        string  `json:"status"`
+	AssignedAt    string  `json:"assigned_at"`
+	RespondedAt   *string `json:"responded_at"`
+}
+
+func (r *AssignmentExportRow) CSVRecord() []string {
+	return []string{r.PullRequestID, r.Repository, r.ReviewerID, r.Status, r.AssignedAt, ptr.Deref(r.RespondedAt)}
+}
+
+var AuditExportHeader = []string{"id", "action", "actor", "at", "pull_request_id", "repository", "user_id", "old_reviewer_id", "new_reviewer_id", "team_name", "reason", "strategy"}
+
+type AuditExportRow struct {
+	ID            int64  `json:"id"`
+	Action        string `json:"action"`
+	Actor         string `json:"actor"`
+	At            string `json:"at"`
+	PullRequestID string `json:"pull_request_id,omitempty"`
+	Repository    string `json:"repository,omitempty"`
+	UserID        string `json:"user_id,omitempty"`
+	OldReviewerID string `json:"old_reviewer_id,omitempty"`
+	NewReviewerID string `json:"new_reviewer_id,omitempty"`
+	TeamName      string `json:"team_name,omitempty"`
+	Reason        string `json:"reason,omitempty"`
+	Strategy      string `json:"strategy,omitempty"`
+}
+
+func NewAuditExportRow(e *AuditEntry) *AuditExportRow {
+	return &AuditExportRow{
+		ID:            e.ID,
+		Action:        e.Action,
+		Actor:         e.Actor,
+		At:            FormatUnix(e.CreatedAt),
+		PullRequestID: e.PullRequestCustomID,
+		Repository:    e.Repository,
+		UserID:        e.UserCustomID,
+		OldReviewerID: e.OldReviewerID,
+		NewReviewerID: e.NewReviewerID,
+		TeamName:      e.TeamName,
+		Reason:        e.Reason,
+		Strategy:      e.Strategy,
+	}
+}
+
+func (r *AuditExportRow) CSVRecord() []string {
+	return []string{strconv.FormatInt(r.ID, 10), r.Action, r.Actor, r.At, r.PullRequestID, r.Repository, r.UserID, r.OldReviewerID, r.NewReviewerID, r.TeamName, r.Reason, r.Strategy}
+}
+
+// агрегированная статистика в длинном формате: одна метрика на строку
+var StatExportHeader = []string{"metric", "dimension", "key", "week_start", "value"}
+
+type StatExportRow struct {
+	Metric    string  `json:"metric"`
+	Dimension string  `json:"dimension"`
+	Key       string  `json:"key"`
+	WeekStart string  `json:"week_start,omitempty"`
+	Value     float64 `json:"value"`
+}
+
+func (r *StatExportRow) CSVRecord() []string {
+	return []string{r.Metric, r.Dimension, r.Key, r.WeekStart, strconv.FormatFloat(r.Value, 'f', -1, 64)}
+}
+
+// время в выгрузках - RFC3339 в UTC
+func FormatUnix(ts int64) string {
+	return time.Unix(ts, 0).UTC().Format(time.RFC3339)
+}
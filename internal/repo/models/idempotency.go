@@ -0,0 +1,37 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// первый ответ на POST с заголовком Idempotency-Key; повтор того же клиента с тем же ключом и телом получает его же.
+// Client - хеш клиента, ключи разных клиентов не пересекаются. StatusCode 0 - первый запрос ещё выполняется,
+// после LockedUntil он считается оборвавшимся, и ключ может занять повтор
+type IdempotencyRecord struct {
+	OrganizationID uuid.UUID `gorm:"type:uuid;primaryKey"`
+	Client         string    `gorm:"type:varchar(64);primaryKey"`
+	IdempotencyKey string    `gorm:"type:varchar(255);primaryKey"`
+	RequestHash    string    `gorm:"type:varchar(64);not null"`
+	StatusCode     int       `gorm:"not null;default:0"`
+	ContentType    string
+	Location       string
+	Body           []byte
+	LockedUntil    int64 `gorm:"not null;default:0"`
+	CreatedAt      int64 `gorm:"not null"`
+	ExpiresAt      int64 `gorm:"not null;index"`
+}
+
+func (r *IdempotencyRecord) BeforeCreate(tx *gorm.DB) error {
+	if r.CreatedAt == 0 {
+		r.CreatedAt = time.Now().Unix()
+	}
+	return nil
+}
+
+// ответ уже сохранён и может быть воспроизведён
+func (r *IdempotencyRecord) Completed() bool {
+	return r.StatusCode != 0
+}
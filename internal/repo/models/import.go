@@ -0,0 +1,65 @@
+package models
+
+// файл организации для массового импорта; Row - строка исходного файла, на которую ссылается результат
+type OrgFile struct {
+	Teams []*OrgTeam
+}
+
+type OrgTeam struct {
+	Row      int
+	TeamName string
+	SLA      *OrgTeamSLA
+	Members  []*OrgMember
+}
+
+type OrgTeamSLA struct {
+	FirstReviewHours int    `yaml:"first_review_hours"`
+	Action           string `yaml:"action"`
+}
+
+type OrgMember struct {
+	Row      int
+	UserID   string
+	Username string
+	IsActive bool
+	Role     string
+}
+
+// результаты импорта по строкам файла
+const (
+	ImportRowTeam   = "team"
+	ImportRowMember = "member"
+
+	ImportCreated   = "created"
+	ImportUpdated   = "updated"
+	ImportAttached  = "attached"
+	ImportUnchanged = "unchanged"
+	ImportInvalid   = "invalid"
+)
+
+type ImportRowResult struct {
+	Row           int      `json:"row"`
+	Kind          string   `json:"kind"`
+	TeamName      string   `json:"team_name,omitempty"`
+	UserID        string   `json:"user_id,omitempty"`
+	Result        string   `json:"result"`
+	ChangedFields []string `json:"changed_fields,omitempty"`
+	Error         string   `json:"error,omitempty"`
+}
+
+type ImportSummary struct {
+	TeamsCreated     int `json:"teams_created"`
+	TeamsUpdated     int `json:"teams_updated"`
+	UsersCreated     int `json:"users_created"`
+	UsersUpdated     int `json:"users_updated"`
+	MembershipsAdded int `json:"memberships_added"`
+	Errors           int `json:"errors"`
+}
+
+// отчёт импорта: при ошибках валидации или dry run изменения не сохраняются и Applied = false
+type ImportReport struct {
+	DryRun  bool              `json:"dry_run"`
+	Applied bool              `json:"applied"`
+	Summary ImportSummary     `json:"summary"`
+	Rows    []ImportRowResult `json:"rows"`
+}
@@ -0,0 +1,84 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// виды фоновых задач
+const (
+	JobKindMassDeactivation = "mass_deactivation"
+)
+
+// статусы задачи; pending и running после перезапуска сервиса продолжаются с необработанных элементов
+const (
+	JobPending   = "pending"
+	JobRunning   = "running"
+	JobSucceeded = "succeeded"
+	JobFailed    = "failed"
+)
+
+// элементы задачи массовой деактивации
+const (
+	JobItemDeactivate = "deactivate"
+	JobItemKeepActive = "keep_active"
+	JobItemReassign   = "reassign"
+)
+
+// исходы элемента; пустой исход - элемент ещё не обработан
+const (
+	JobOutcomeDeactivated = "deactivated"
+	JobOutcomeKeptActive  = "kept_active"
+	JobOutcomeReassigned  = "reassigned"
+	JobOutcomeNoCandidate = "no_candidate"
+	JobOutcomeSkipped     = "skipped"
+)
+
+// фоновая задача; Actor - инициатор запроса, от его имени пишется журнал аудита.
+// LeaseOwner и LeaseUntil - аренда: задачу обрабатывает только реплика-арендатор, пока аренда не истекла
+type Job struct {
+	ID             uuid.UUID `gorm:"type:uuid;primaryKey"`
+	OrganizationID uuid.UUID `gorm:"type:uuid;not null;index"`
+	Kind           string    `gorm:"type:varchar(32);not null"`
+	Status         string    `gorm:"type:varchar(16);not null;index"`
+	DryRun         bool      `gorm:"not null;default:false"`
+	Actor          string    `gorm:"not null"`
+	OldTeamName    string    `gorm:"not null"`
+	NewTeamName    string    `gorm:"not null"`
+	Total          int       `gorm:"not null;default:0"`
+	Processed      int       `gorm:"not null;default:0"`
+	// набор изменений для отката, у dry run его нет
+	ChangesetID *uuid.UUID `gorm:"type:uuid"`
+	Error       string
+	LeaseOwner  string `gorm:"type:varchar(64);not null;default:''"`
+	LeaseUntil  int64  `gorm:"not null;default:0"`
+	CreatedAt   int64  `gorm:"not null"`
+	UpdatedAt   int64  `gorm:"not null"`
+	FinishedAt  *int64
+}
+
+func (j *Job) BeforeCreate(tx *gorm.DB) error {
+	if j.ID == uuid.Nil {
+		j.ID = uuid.New()
+	}
+	now := time.Now().Unix()
+	if j.CreatedAt == 0 {
+		j.CreatedAt = now
+	}
+	j.UpdatedAt = now
+	return nil
+}
+
+// шаг задачи: деактивация пользователя или замена ревьювера в PR; обрабатываются в порядке ID
+type JobItem struct {
+	ID                  int64     `gorm:"primaryKey;autoIncrement"`
+	JobID               uuid.UUID `gorm:"type:uuid;not null;index"`
+	Kind                string    `gorm:"type:varchar(16);not null"`
+	UserCustomID        string    `gorm:"not null"`
+	PullRequestCustomID string
+	Repository          string
+	NewReviewerID       string
+	Outcome             string `gorm:"type:varchar(16);not null;default:''"`
+}
@@ -0,0 +1,33 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// организация по умолчанию: в ней работают запросы без заголовка X-Organization и токена,
+// её создаёт мигратор
+var DefaultOrganizationID = uuid.MustParse("00000000-0000-0000-0000-000000000001")
+
+const DefaultOrganizationSlug = "default"
+
+// организация (тенант): команды, пользователи, PR, репозитории и служебные данные
+// принадлежат ровно одной организации, имена и custom id уникальны в её пределах
+type Organization struct {
+	ID        uuid.UUID `gorm:"type:uuid;primaryKey"`
+	Slug      string    `gorm:"unique;not null"`
+	Name      string    `gorm:"not null"`
+	CreatedAt int64     `gorm:"not null"`
+}
+
+func (o *Organization) BeforeCreate(tx *gorm.DB) error {
+	if o.ID == uuid.Nil {
+		o.ID = uuid.New()
+	}
+	if o.CreatedAt == 0 {
+		o.CreatedAt = time.Now().Unix()
+	}
+	return nil
+}
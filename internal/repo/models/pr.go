@@ -8,16 +8,37 @@ import (
 	"gorm.io/gorm"
 )
 
+// PR уникален по паре (репозиторий, PullRequestCustomID); у PR вне репозиториев RepositoryID пустой,
+// их уникальность в пределах организации обеспечивает частичный индекс из миграции
 type PullRequest struct {
-	ID                  uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
-	PullRequestCustomID string    `gorm:"uniqueIndex;not null" json:"pull_request_custom_id"`
-	PullRequestName     string    `gorm:"not null" json:"pull_request_name"`
-	AuthorID            uuid.UUID `gorm:"type:uuid;not null" json:"author_id"`
-	Author              User      `gorm:"foreignKey:AuthorID" json:"-"`
-	Status              string    `gorm:"type:varchar(10);not null;default:'OPEN'" json:"status"`
-	AssignedReviewers   []*User   `gorm:"many2many:pull_request_reviewers;" json:"assigned_reviewers"`
-	MergedAt            *int64    `json:"mergedAt,omitempty"`
-	CreatedAt           int64     `gorm:"autoCreateTime" json:"createdAt"`
+	ID                  uuid.UUID   `gorm:"type:uuid;primaryKey;default:gen_random_uuid()" json:"id"`
+	OrganizationID      uuid.UUID   `gorm:"type:uuid;not null;index" json:"-"`
+	RepositoryID        *uuid.UUID  `gorm:"type:uuid;uniqueIndex:idx_pull_requests_repository_pr,priority:1" json:"repository_id,omitempty"`
+	Repository          *Repository `gorm:"foreignKey:RepositoryID" json:"-"`
+	PullRequestCustomID string      `gorm:"not null;index;uniqueIndex:idx_pull_requests_repository_pr,priority:2" json:"pull_request_custom_id"`
+	PullRequestName     string      `gorm:"not null" json:"pull_request_name"`
+	AuthorID            uuid.UUID   `gorm:"type:uuid;not null" json:"author_id"`
+	Author              User        `gorm:"foreignKey:AuthorID" json:"-"`
+	Status              string      `gorm:"type:varchar(10);not null;default:'OPEN'" json:"status"`
+	AssignedReviewers   []*User     `gorm:"many2many:pull_request_reviewers;" json:"assigned_reviewers"`
+	MergedAt            *int64      `json:"mergedAt,omitempty"`
+	CreatedAt           int64       `gorm:"autoCreateTime" json:"createdAt"`
+}
+
+// имя репозитория PR, пустая строка - PR вне репозиториев
+func (p *PullRequest) RepositoryName() string {
+	if p.Repository == nil {
+		return ""
+	}
+	return p.Repository.Name
+}
+
+// pull_request_id для списков, где рядом могут оказаться PR разных репозиториев: repository/pull_request_id
+func (p *PullRequest) Key() string {
+	if p.Repository == nil {
+		return p.PullRequestCustomID
+	}
+	return p.Repository.Name + "/" + p.PullRequestCustomID
 }
 
 func (p *PullRequest) BeforeCreate(tx *gorm.DB) error {
@@ -28,6 +49,28 @@ func (p *PullRequest) BeforeCreate(tx *gorm.DB) error {
 	return nil
 }
 
+// строка таблицы назначений ревьюверов, хранит момент назначения
+type PullRequestReviewer struct {
+	PullRequestID uuid.UUID `gorm:"type:uuid;primaryKey"`
+	UserID        uuid.UUID `gorm:"type:uuid;primaryKey"`
+	AssignedAt    int64     `gorm:"not null;default:0"`
+	RespondedAt   *int64
+}
+
+func (p *PullRequestReviewer) BeforeCreate(tx *gorm.DB) error {
+	if p.AssignedAt == 0 {
+		p.AssignedAt = time.Now().Unix()
+	}
+	return nil
+}
+
+type ReviewerAssignment struct {
+	UserCustomID string
+	Nickname     string
+	AssignedAt   int64
+	RespondedAt  *int64
+}
+
 type PullRequestReassign struct {
 	PullRequest   openapi.PullRequest `json:"pr"`
 	NewReviewerID string              `json:"replaced_by"`
@@ -36,4 +79,45 @@ type PullRequestReassign struct {
 type PullRequestSearch struct {
 	PullRequest []*openapi.PullRequestShort `json:"pull_requests"`
 	Author      string                      `json:"user_id"`
+	NextCursor  *string                     `json:"next_cursor,omitempty"`
+}
+
+type PullRequestList struct {
+	PullRequest []*openapi.PullRequestShort `json:"pull_requests"`
+	NextCursor  *string                     `json:"next_cursor,omitempty"`
+}
+
+// фильтр для выборки PR, все условия применяются на стороне БД
+type PullRequestFilter struct {
+	ReviewerCustomID string
+	AuthorCustomID   string
+	TeamName         string
+	Status           string
+	CreatedFrom      *int64
+	CreatedTo        *int64
+	Sort             openapi.PullRequestSort
+	Query            string
+	Limit            int
+	After            *PullRequestCursor
+}
+
+// позиция последнего элемента страницы: значение ключа сортировки и id как тайбрейкер
+type PullRequestCursor struct {
+	CreatedAt int64
+	Name      string
+	ID        uuid.UUID
+}
+
+// смерженный PR для метрик времени до merge; TeamName - основная команда автора
+type MergedPullRequest struct {
+	AuthorCustomID string
+	TeamName       string
+	CreatedAt      int64
+	MergedAt       int64
+}
+
+// ревью смерженного PR для метрик пропускной способности ревьюверов
+type MergedReview struct {
+	ReviewerCustomID string
+	MergedAt         int64
 }
@@ -0,0 +1,59 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// откуда подбираются ревьюверы PR репозитория
+const (
+	ReviewerSourceAuthorTeam = "author_team"
+	ReviewerSourceOwnerTeam  = "owner_team"
+)
+
+// политика по умолчанию для PR вне репозиториев и для не переопределённых полей
+const (
+	DefaultReviewerSource = ReviewerSourceAuthorTeam
+	DefaultReviewersCount = 2
+)
+
+// репозиторий кода, которым владеет команда организации; pull_request_id уникален в пределах репозитория.
+// ReviewerSource и ReviewersCount - переопределения политики, nil - значение по умолчанию
+type Repository struct {
+	ID             uuid.UUID `gorm:"type:uuid;primaryKey"`
+	OrganizationID uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_repositories_organization_name,priority:1"`
+	Name           string    `gorm:"not null;uniqueIndex:idx_repositories_organization_name,priority:2"`
+	OwnerTeamID    uuid.UUID `gorm:"type:uuid;not null;index"`
+	OwnerTeam      Team      `gorm:"foreignKey:OwnerTeamID"`
+	ReviewerSource *string   `gorm:"type:varchar(16)"`
+	ReviewersCount *int
+	CreatedAt      int64 `gorm:"not null"`
+}
+
+func (r *Repository) BeforeCreate(tx *gorm.DB) error {
+	if r.ID == uuid.Nil {
+		r.ID = uuid.New()
+	}
+	if r.CreatedAt == 0 {
+		r.CreatedAt = time.Now().Unix()
+	}
+	return nil
+}
+
+// действующий источник ревьюверов; nil-репозиторий - PR вне репозиториев
+func (r *Repository) Source() string {
+	if r == nil || r.ReviewerSource == nil {
+		return DefaultReviewerSource
+	}
+	return *r.ReviewerSource
+}
+
+// действующее число ревьюверов при создании PR
+func (r *Repository) Reviewers() int {
+	if r == nil || r.ReviewersCount == nil {
+		return DefaultReviewersCount
+	}
+	return *r.ReviewersCount
+}
@@ -0,0 +1,54 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"gorm.io/gorm"
+)
+
+// действие команды при нарушении SLA
+const (
+	SLAActionRemind   = "remind"
+	SLAActionReassign = "reassign"
+)
+
+// результат обработки нарушения
+const (
+	SLAOutcomeReminded    = "reminded"
+	SLAOutcomeReassigned  = "reassigned"
+	SLAOutcomeNoCandidate = "no_candidate"
+)
+
+// назначение без ответа ревьювера по PR, автор которого состоит в команде с SLA
+type PendingAssignment struct {
+	PullRequestCustomID string
+	Repository          string
+	ReviewerCustomID    string
+	TeamName            string
+	AssignedAt          int64
+	SLAHours            int
+	SLAAction           string
+}
+
+// нарушение SLA; одно назначение фиксируется не больше одного раза
+type SLABreach struct {
+	ID                  int64     `gorm:"primaryKey;autoIncrement"`
+	OrganizationID      uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_sla_breaches_assignment"`
+	PullRequestCustomID string    `gorm:"not null;uniqueIndex:idx_sla_breaches_assignment"`
+	Repository          string    `gorm:"not null;default:'';uniqueIndex:idx_sla_breaches_assignment"`
+	ReviewerCustomID    string    `gorm:"not null;uniqueIndex:idx_sla_breaches_assignment"`
+	AssignedAt          int64     `gorm:"not null;uniqueIndex:idx_sla_breaches_assignment"`
+	TeamName            string    `gorm:"not null;index"`
+	Deadline            int64     `gorm:"not null"`
+	Outcome             string    `gorm:"type:varchar(16);not null"`
+	DetectedAt          int64     `gorm:"not null;index"`
+}
+
+func (b *SLABreach) BeforeCreate(tx *gorm.DB) error {
+	if b.DetectedAt == 0 {
+		b.DetectedAt = time.Now().Unix()
+	}
+	return nil
+}
@@ -0,0 +1,26 @@
+package models
+
+// перцентили длительности в часах, метод ближайшего ранга
+type DurationPercentiles struct {
+	Count    int     `json:"count"`
+	P50Hours float64 `json:"p50_hours"`
+	P90Hours float64 `json:"p90_hours"`
+	P99Hours float64 `json:"p99_hours"`
+}
+
+// переназначения за неделю, week_start - понедельник недели в UTC
+type ReassignmentTrendPoint struct {
+	WeekStart           string  `json:"week_start"`
+	Reassignments       int     `json:"reassignments"`
+	PullRequestsCreated int     `json:"pull_requests_created"`
+	ReassignmentsPerPR  float64 `json:"reassignments_per_pr"`
+}
+
+type ReviewStats struct {
+	TimeToMergePerTeam   map[string]DurationPercentiles `json:"time_to_merge_per_team"`
+	TimeToMergePerAuthor map[string]DurationPercentiles `json:"time_to_merge_per_author"`
+	// reviewer -> week_start -> количество смерженных PR, где он был ревьювером
+	ReviewerThroughput map[string]map[string]int `json:"reviewer_throughput_per_week"`
+	OpenPRAge          map[string]int            `json:"open_pr_age"`
+	ReassignmentTrend  []ReassignmentTrendPoint  `json:"reassignment_trend"`
+}
@@ -1,14 +1,20 @@
 package models
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 )
 
 type Team struct {
-	ID       uuid.UUID `gorm:"type:uuid;primaryKey" json:"-"`
-	TeamName string    `gorm:"unique;not null" json:"team_name"`
-	Members  []*User   `gorm:"many2many:user_teams;" json:"members"`
+	ID             uuid.UUID `gorm:"type:uuid;primaryKey" json:"-"`
+	OrganizationID uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_teams_organization_name,priority:1" json:"-"`
+	TeamName       string    `gorm:"not null;uniqueIndex:idx_teams_organization_name,priority:2" json:"team_name"`
+	Members        []*User   `gorm:"many2many:team_memberships;" json:"members"`
+	// срок первого ответа ревьювера в часах, 0 - SLA не отслеживается
+	SLAFirstReviewHours int    `gorm:"not null;default:0" json:"-"`
+	SLAAction           string `gorm:"type:varchar(16);not null;default:'remind'" json:"-"`
 }
 
 func (p *Team) BeforeCreate(tx *gorm.DB) error {
@@ -17,3 +23,30 @@ func (p *Team) BeforeCreate(tx *gorm.DB) error {
 	}
 	return nil
 }
+
+// роли участника команды; observer видит команду, но не назначается ревьювером
+const (
+	RoleMember   = "member"
+	RoleLead     = "lead"
+	RoleObserver = "observer"
+)
+
+// членство пользователя в команде - единственный источник правды о составе команд;
+// у пользователя может быть несколько команд, одна из них помечена как основная
+type TeamMembership struct {
+	TeamID    uuid.UUID `gorm:"type:uuid;primaryKey"`
+	UserID    uuid.UUID `gorm:"type:uuid;primaryKey;index"`
+	IsPrimary bool      `gorm:"not null;default:false"`
+	Role      string    `gorm:"type:varchar(16);not null;default:'member'"`
+	CreatedAt time.Time
+}
+
+func (m *TeamMembership) BeforeCreate(tx *gorm.DB) error {
+	if m.Role == "" {
+		m.Role = RoleMember
+	}
+	if m.CreatedAt.IsZero() {
+		m.CreatedAt = time.Now()
+	}
+	return nil
+}
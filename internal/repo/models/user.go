@@ -8,27 +8,14 @@ import (
 )
 
 type User struct {
-	ID           uuid.UUID      `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
-	UserCustomID string         `gorm:"uniqueIndex;not null"`
-	Nickname     string         `json:"nickname" gorm:"not null"`
-	IsActive     bool           `json:"is_active" gorm:"default:true"`
-	CreatedAt    time.Time      `json:"created_at"`
-	UpdatedAt    time.Time      `json:"updated_at"`
-	DeletedAt    gorm.DeletedAt `json:"-" gorm:"index"`
-	TeamID       *uuid.UUID     `json:"team_id" gorm:"type:uuid;index;default:null"`
-	Team         *Team          `json:"team,omitempty" gorm:"foreignKey:TeamID"`
-}
-
-type CreateUserRequest struct {
-	Email    string `json:"email" validate:"required,email"`
-	Password string `json:"password" validate:"required,min=6"`
-	Nickname string `json:"nickname" validate:"required"`
-	Id       string `json:"id" validate:"required"`
-}
-
-type LoginRequest struct {
-	Email    string `json:"email" validate:"required,email"`
-	Password string `json:"password" validate:"required"`
+	ID             uuid.UUID      `json:"id" gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	OrganizationID uuid.UUID      `json:"-" gorm:"type:uuid;not null;uniqueIndex:idx_users_organization_custom_id,priority:1"`
+	UserCustomID   string         `gorm:"not null;uniqueIndex:idx_users_organization_custom_id,priority:2"`
+	Nickname       string         `json:"nickname" gorm:"not null"`
+	IsActive       bool           `json:"is_active" gorm:"default:true"`
+	CreatedAt      time.Time      `json:"created_at"`
+	UpdatedAt      time.Time      `json:"updated_at"`
+	DeletedAt      gorm.DeletedAt `json:"-" gorm:"index"`
 }
 
 type AuthResponse struct {
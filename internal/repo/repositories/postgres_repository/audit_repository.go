@@ -0,0 +1,103 @@
+package postgresrepository
+
+import (
+	"context"
+
+	"github.com/wozhdeleniye/avito-tech-internship/internal/repo/models"
+	"gorm.io/gorm"
+)
+
+type AuditRepository struct {
+	db *gorm.DB
+}
+
+func NewAuditRepository(db *gorm.DB) *AuditRepository {
+	return &AuditRepository{db: db}
+}
+
+// дописывает записи в журнал в текущей транзакции, если она есть
+func (r *AuditRepository) Append(ctx context.Context, entries []*models.AuditEntry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+	org := OrganizationID(ctx)
+	for _, e := range entries {
+		e.OrganizationID = org
+	}
+	return conn(ctx, r.db).Create(&entries).Error
+}
+
+// записи журнала в порядке добавления; возвращает до filter.Limit+1 записей, лишняя запись сигнализирует о следующей странице
+func (r *AuditRepository) List(ctx context.Context, filter models.AuditFilter) ([]*models.AuditEntry, error) {
+	var entries []*models.AuditEntry
+
+	q := applyAuditFilter(conn(ctx, r.db).Model(&models.AuditEntry{}).Scopes(tenant(ctx, "audit_entries")), filter)
+	if filter.AfterID > 0 {
+		q = q.Where("id > ?", filter.AfterID)
+	}
+	q = q.Order("id")
+	if filter.Limit > 0 {
+		q = q.Limit(filter.Limit + 1)
+	}
+
+	if err := q.Find(&entries).Error; err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// все записи журнала по фильтру в порядке добавления; Limit и AfterID не учитываются
+func (r *AuditRepository) Stream(ctx context.Context, filter models.AuditFilter, fn func(*models.AuditEntry) error) error {
+	q := applyAuditFilter(conn(ctx, r.db).Model(&models.AuditEntry{}).Scopes(tenant(ctx, "audit_entries")), filter).Order("id")
+	return streamRows(q, fn)
+}
+
+func applyAuditFilter(q *gorm.DB, filter models.AuditFilter) *gorm.DB {
+	if filter.Action != "" {
+		q = q.Where("action = ?", filter.Action)
+	}
+	if filter.Actor != "" {
+		q = q.Where("actor = ?", filter.Actor)
+	}
+	if filter.PullRequestCustomID != "" {
+		q = q.Where("pull_request_custom_id = ?", filter.PullRequestCustomID)
+	}
+	if filter.Repository != nil {
+		q = q.Where("repository = ?", *filter.Repository)
+	}
+	if filter.UserCustomID != "" {
+		q = q.Where("user_custom_id = ? OR old_reviewer_id = ? OR new_reviewer_id = ?", filter.UserCustomID, filter.UserCustomID, filter.UserCustomID)
+	}
+	if filter.TeamName != "" {
+		q = q.Where("team_name = ?", filter.TeamName)
+	}
+	return timeRange(q, "created_at", filter.From, filter.To)
+}
+
+// записи об активации и деактивации пользователей начиная с момента from в порядке добавления
+func (r *AuditRepository) ListUserActivity(ctx context.Context, userCustomIDs []string, from int64) ([]*models.AuditEntry, error) {
+	var entries []*models.AuditEntry
+	if len(userCustomIDs) == 0 {
+		return entries, nil
+	}
+	err := conn(ctx, r.db).Scopes(tenant(ctx, "audit_entries")).
+		Where("action IN ?", []string{models.AuditUserActivated, models.AuditUserDeactivated}).
+		Where("user_custom_id IN ?", userCustomIDs).
+		Where("created_at >= ?", from).
+		Order("id").
+		Find(&entries).Error
+	if err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// моменты записей с указанным действием в диапазоне
+func (r *AuditRepository) ListActionTimes(ctx context.Context, action string, from, to *int64) ([]int64, error) {
+	var rows []int64
+	q := timeRange(conn(ctx, r.db).Model(&models.AuditEntry{}).Scopes(tenant(ctx, "audit_entries")).Where("action = ?", action), "created_at", from, to)
+	if err := q.Pluck("created_at", &rows).Error; err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
@@ -0,0 +1,64 @@
+package postgresrepository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/wozhdeleniye/avito-tech-internship/internal/repo/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type ChangesetRepository struct {
+	db *gorm.DB
+}
+
+func NewChangesetRepository(db *gorm.DB) *ChangesetRepository {
+	return &ChangesetRepository{db: db}
+}
+
+func (r *ChangesetRepository) CreateChangeset(ctx context.Context, changeset *models.Changeset) error {
+	changeset.OrganizationID = OrganizationID(ctx)
+	return conn(ctx, r.db).Create(changeset).Error
+}
+
+// дописывает изменения в текущей транзакции, чтобы они фиксировались вместе с самими изменениями
+func (r *ChangesetRepository) AppendEntries(ctx context.Context, entries []*models.ChangesetEntry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+	return conn(ctx, r.db).Create(&entries).Error
+}
+
+func (r *ChangesetRepository) GetChangeset(ctx context.Context, id uuid.UUID) (*models.Changeset, error) {
+	var changeset models.Changeset
+	if err := conn(ctx, r.db).Scopes(tenant(ctx, "changesets")).Where("id = ?", id).First(&changeset).Error; err != nil {
+		return nil, err
+	}
+	return &changeset, nil
+}
+
+// набор с блокировкой строки до конца транзакции, чтобы один набор не откатывался дважды параллельно
+func (r *ChangesetRepository) GetChangesetForUpdate(ctx context.Context, id uuid.UUID) (*models.Changeset, error) {
+	var changeset models.Changeset
+	if err := conn(ctx, r.db).Clauses(clause.Locking{Strength: "UPDATE"}).Scopes(tenant(ctx, "changesets")).Where("id = ?", id).First(&changeset).Error; err != nil {
+		return nil, err
+	}
+	return &changeset, nil
+}
+
+func (r *ChangesetRepository) ListEntries(ctx context.Context, id uuid.UUID) ([]*models.ChangesetEntry, error) {
+	var entries []*models.ChangesetEntry
+	if err := conn(ctx, r.db).Where("changeset_id = ?", id).Order("id").Find(&entries).Error; err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+func (r *ChangesetRepository) UpdateChangeset(ctx context.Context, changeset *models.Changeset) error {
+	return conn(ctx, r.db).Model(changeset).Updates(map[string]interface{}{
+		"completed_at": changeset.CompletedAt,
+		"reverted_at":  changeset.RevertedAt,
+		"reverted_by":  changeset.RevertedBy,
+	}).Error
+}
@@ -0,0 +1,76 @@
+package postgresrepository
+
+import (
+	"context"
+
+	"github.com/wozhdeleniye/avito-tech-internship/internal/repo/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ключи идемпотентности организации из ctx; ключ ищется вместе с клиентом, который его прислал
+type IdempotencyRepository struct {
+	db *gorm.DB
+}
+
+func NewIdempotencyRepository(db *gorm.DB) *IdempotencyRepository {
+	return &IdempotencyRepository{db: db}
+}
+
+// занимает ключ под выполняющийся запрос; false, если ключ уже занят
+func (r *IdempotencyRepository) Reserve(ctx context.Context, record *models.IdempotencyRecord) (bool, error) {
+	record.OrganizationID = OrganizationID(ctx)
+	result := conn(ctx, r.db).Clauses(clause.OnConflict{DoNothing: true}).Create(record)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+func (r *IdempotencyRepository) Find(ctx context.Context, client string, key string) (*models.IdempotencyRecord, error) {
+	var record models.IdempotencyRecord
+	if err := r.byKey(ctx, client, key).First(&record).Error; err != nil {
+		return nil, err
+	}
+	return &record, nil
+}
+
+// перехватывает ключ оборвавшегося запроса: ответа нет, а блокировка истекла к моменту now;
+// false - ключ успел занять другой повтор или первый запрос сохранил ответ
+func (r *IdempotencyRepository) TakeOver(ctx context.Context, record *models.IdempotencyRecord, now int64) (bool, error) {
+	result := r.byKey(ctx, record.Client, record.IdempotencyKey).
+		Model(&models.IdempotencyRecord{}).
+		Where("request_hash = ? AND status_code = 0 AND locked_until <= ?", record.RequestHash, now).
+		Update("locked_until", record.LockedUntil)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// сохраняет ответ, если его ещё нет: после перехвата ключа ответ сохраняет запрос, закончивший первым
+func (r *IdempotencyRepository) SaveResponse(ctx context.Context, record *models.IdempotencyRecord) error {
+	return r.byKey(ctx, record.Client, record.IdempotencyKey).
+		Model(&models.IdempotencyRecord{}).
+		Where("status_code = 0").
+		Updates(map[string]interface{}{
+			"status_code":  record.StatusCode,
+			"content_type": record.ContentType,
+			"location":     record.Location,
+			"body":         record.Body,
+		}).Error
+}
+
+// освобождает ключ, чтобы повтор выполнил запрос заново; сохранённый ответ не удаляется
+func (r *IdempotencyRepository) Delete(ctx context.Context, client string, key string) error {
+	return r.byKey(ctx, client, key).Where("status_code = 0").Delete(&models.IdempotencyRecord{}).Error
+}
+
+// удаляет ключи организации, срок хранения которых истёк к моменту now
+func (r *IdempotencyRepository) DeleteExpired(ctx context.Context, now int64) error {
+	return conn(ctx, r.db).Scopes(tenant(ctx, "idempotency_records")).Where("expires_at <= ?", now).Delete(&models.IdempotencyRecord{}).Error
+}
+
+func (r *IdempotencyRepository) byKey(ctx context.Context, client string, key string) *gorm.DB {
+	return conn(ctx, r.db).Scopes(tenant(ctx, "idempotency_records")).Where("client = ? AND idempotency_key = ?", client, key)
+}
@@ -0,0 +1,145 @@
+package postgresrepository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/wozhdeleniye/avito-tech-internship/internal/repo/models"
+	"gorm.io/gorm"
+)
+
+type JobRepository struct {
+	db *gorm.DB
+}
+
+func NewJobRepository(db *gorm.DB) *JobRepository {
+	return &JobRepository{db: db}
+}
+
+// сохраняет задачу вместе с планом обработки
+func (r *JobRepository) CreateJob(ctx context.Context, job *models.Job, items []*models.JobItem) error {
+	job.OrganizationID = OrganizationID(ctx)
+	return conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(job).Error; err != nil {
+			return err
+		}
+		if len(items) == 0 {
+			return nil
+		}
+		for _, item := range items {
+			item.JobID = job.ID
+		}
+		return tx.CreateInBatches(items, 500).Error
+	})
+}
+
+func (r *JobRepository) GetJob(ctx context.Context, id uuid.UUID) (*models.Job, error) {
+	var job models.Job
+	if err := conn(ctx, r.db).Scopes(tenant(ctx, "jobs")).Where("id = ?", id).First(&job).Error; err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
+
+// задачи организации, которые ещё не завершены, в порядке создания
+func (r *JobRepository) ListUnfinishedJobs(ctx context.Context) ([]*models.Job, error) {
+	var jobs []*models.Job
+	if err := conn(ctx, r.db).
+		Scopes(tenant(ctx, "jobs")).
+		Where("status IN ?", []string{models.JobPending, models.JobRunning}).
+		Order("created_at, id").
+		Find(&jobs).Error; err != nil {
+		return nil, err
+	}
+	return jobs, nil
+}
+
+// берёт аренду задачи до until, если задача не завершена и аренда свободна, истекла или уже принадлежит owner;
+// false - задачу обрабатывает другая реплика
+func (r *JobRepository) ClaimJob(ctx context.Context, job *models.Job, owner string, until int64) (bool, error) {
+	res := conn(ctx, r.db).
+		Model(&models.Job{}).
+		Scopes(tenant(ctx, "jobs")).
+		Where("id = ? AND status IN ?", job.ID, []string{models.JobPending, models.JobRunning}).
+		Where("(lease_owner = ? OR lease_until < ?)", owner, time.Now().Unix()).
+		Updates(map[string]interface{}{"lease_owner": owner, "lease_until": until})
+	if res.Error != nil {
+		return false, res.Error
+	}
+	if res.RowsAffected == 0 {
+		return false, nil
+	}
+	job.LeaseOwner, job.LeaseUntil = owner, until
+	return true, nil
+}
+
+func (r *JobRepository) ListItems(ctx context.Context, jobID uuid.UUID) ([]*models.JobItem, error) {
+	var items []*models.JobItem
+	if err := conn(ctx, r.db).Where("job_id = ?", jobID).Order("id").Find(&items).Error; err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+// следующие limit необработанных элементов задачи
+func (r *JobRepository) NextItems(ctx context.Context, jobID uuid.UUID, limit int) ([]*models.JobItem, error) {
+	var items []*models.JobItem
+	if err := conn(ctx, r.db).
+		Where("job_id = ? AND outcome = ?", jobID, "").
+		Order("id").
+		Limit(limit).
+		Find(&items).Error; err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+// user_id всех пользователей, которых задача деактивирует
+func (r *JobRepository) ListDeactivatedUserIDs(ctx context.Context, jobID uuid.UUID) ([]string, error) {
+	var ids []string
+	if err := conn(ctx, r.db).
+		Model(&models.JobItem{}).
+		Where("job_id = ? AND kind = ?", jobID, models.JobItemDeactivate).
+		Pluck("user_custom_id", &ids).Error; err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// сохраняет прогресс задачи и исходы обработанных элементов; аренда проверяется до записи элементов
+func (r *JobRepository) SaveProgress(ctx context.Context, job *models.Job, items []*models.JobItem) error {
+	if err := r.UpdateJob(ctx, job); err != nil {
+		return err
+	}
+	db := conn(ctx, r.db)
+	for _, item := range items {
+		if err := db.Model(item).Updates(map[string]interface{}{
+			"outcome":         item.Outcome,
+			"new_reviewer_id": item.NewReviewerID,
+		}).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// обновляет задачу и продлевает аренду до job.LeaseUntil; ErrJobLeaseLost - аренда перешла к другой реплике
+func (r *JobRepository) UpdateJob(ctx context.Context, job *models.Job) error {
+	job.UpdatedAt = time.Now().Unix()
+	res := conn(ctx, r.db).Model(job).Where("lease_owner = ?", job.LeaseOwner).Updates(map[string]interface{}{
+		"status":      job.Status,
+		"processed":   job.Processed,
+		"error":       job.Error,
+		"lease_until": job.LeaseUntil,
+		"updated_at":  job.UpdatedAt,
+		"finished_at": job.FinishedAt,
+	})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return ErrJobLeaseLost
+	}
+	return nil
+}
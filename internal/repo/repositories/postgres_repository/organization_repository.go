@@ -0,0 +1,54 @@
+package postgresrepository
+
+import (
+	"context"
+	"strings"
+
+	"github.com/wozhdeleniye/avito-tech-internship/internal/repo/models"
+	"gorm.io/gorm"
+)
+
+// организации не принадлежат тенанту, поэтому запросы к ним не ограничиваются организацией из ctx
+type OrganizationRepository struct {
+	db *gorm.DB
+}
+
+func NewOrganizationRepository(db *gorm.DB) *OrganizationRepository {
+	return &OrganizationRepository{db: db}
+}
+
+func (r *OrganizationRepository) CreateOrganization(ctx context.Context, org *models.Organization) error {
+	result := conn(ctx, r.db).Create(org)
+	if result.Error != nil {
+		le := strings.ToLower(result.Error.Error())
+		if strings.Contains(le, "duplicate") || strings.Contains(le, "unique") || strings.Contains(le, "violates unique") {
+			return ErrOrganizationExists
+		}
+	}
+	return result.Error
+}
+
+func (r *OrganizationRepository) FindOrganizationBySlug(ctx context.Context, slug string) (*models.Organization, error) {
+	var org models.Organization
+	if err := conn(ctx, r.db).Where("slug = ?", slug).First(&org).Error; err != nil {
+		return nil, err
+	}
+	return &org, nil
+}
+
+// организация запроса из ctx
+func (r *OrganizationRepository) FindOrganization(ctx context.Context) (*models.Organization, error) {
+	var org models.Organization
+	if err := conn(ctx, r.db).Where("id = ?", OrganizationID(ctx)).First(&org).Error; err != nil {
+		return nil, err
+	}
+	return &org, nil
+}
+
+func (r *OrganizationRepository) ListOrganizations(ctx context.Context) ([]*models.Organization, error) {
+	var orgs []*models.Organization
+	if err := conn(ctx, r.db).Order("created_at, slug").Find(&orgs).Error; err != nil {
+		return nil, err
+	}
+	return orgs, nil
+}
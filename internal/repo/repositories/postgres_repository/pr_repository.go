@@ -4,8 +4,11 @@ import (
 	"context"
 	"strings"
 
+	"github.com/google/uuid"
+	openapi "github.com/wozhdeleniye/avito-tech-internship/api"
 	"github.com/wozhdeleniye/avito-tech-internship/internal/repo/models"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type PReqRepository struct {
@@ -17,7 +20,8 @@ func NewPReqRepository(db *gorm.DB) *PReqRepository {
 }
 
 func (r *PReqRepository) CreatePullRequest(ctx context.Context, pr *models.PullRequest) error {
-	result := r.db.WithContext(ctx).Create(pr)
+	pr.OrganizationID = OrganizationID(ctx)
+	result := conn(ctx, r.db).Create(pr)
 	if result.Error != nil {
 		le := strings.ToLower(result.Error.Error())
 		if strings.Contains(le, "duplicate") || strings.Contains(le, "unique") || strings.Contains(le, "violates unique") {
@@ -27,17 +31,41 @@ func (r *PReqRepository) CreatePullRequest(ctx context.Context, pr *models.PullR
 	return result.Error
 }
 
-func (r *PReqRepository) GetPullRequestByID(ctx context.Context, id string) (*models.PullRequest, error) {
+// PR по паре (репозиторий, pull_request_id); пустой repository - PR вне репозиториев
+func (r *PReqRepository) GetPullRequest(ctx context.Context, repository string, id string) (*models.PullRequest, error) {
 	var pr models.PullRequest
-	result := r.db.WithContext(ctx).Preload("Author").Preload("AssignedReviewers").Where("pull_request_custom_id = ?", id).First(&pr)
-	if result.Error != nil {
-		return nil, result.Error
+	q := preloadPullRequest(conn(ctx, r.db)).Scopes(tenant(ctx, "pull_requests")).Where("pull_requests.pull_request_custom_id = ?", id)
+	if repository == "" {
+		q = q.Where("pull_requests.repository_id IS NULL")
+	} else {
+		q = q.Where("pull_requests.repository_id = (SELECT id FROM repositories WHERE organization_id = ? AND name = ?)", OrganizationID(ctx), repository)
+	}
+	if err := q.First(&pr).Error; err != nil {
+		return nil, err
 	}
 	return &pr, nil
 }
 
+// PR с pull_request_id из любых репозиториев, не больше limit; вызывающий проверяет однозначность
+func (r *PReqRepository) FindPullRequests(ctx context.Context, id string, limit int) ([]*models.PullRequest, error) {
+	var prs []*models.PullRequest
+	if err := preloadPullRequest(conn(ctx, r.db)).
+		Scopes(tenant(ctx, "pull_requests")).
+		Where("pull_requests.pull_request_custom_id = ?", id).
+		Order("pull_requests.created_at").
+		Limit(limit).
+		Find(&prs).Error; err != nil {
+		return nil, err
+	}
+	return prs, nil
+}
+
+func preloadPullRequest(q *gorm.DB) *gorm.DB {
+	return q.Preload("Author").Preload("AssignedReviewers").Preload("Repository")
+}
+
 func (r *PReqRepository) UpdatePullRequest(ctx context.Context, pr *models.PullRequest) error {
-	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+	return conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
 		if err := tx.Save(pr).Error; err != nil {
 			return err
 		}
@@ -50,34 +78,130 @@ func (r *PReqRepository) UpdatePullRequest(ctx context.Context, pr *models.PullR
 	})
 }
 
-func (r *PReqRepository) ListPullRequests(ctx context.Context) ([]*models.PullRequest, error) {
+// выборка страницы PR по фильтру; возвращает до filter.Limit+1 записей, лишняя запись сигнализирует о следующей странице
+func (r *PReqRepository) ListPullRequests(ctx context.Context, filter models.PullRequestFilter) ([]*models.PullRequest, error) {
 	var prs []*models.PullRequest
 
-	result := r.db.WithContext(ctx).Preload("Author").Preload("AssignedReviewers").Find(&prs)
-	if result.Error != nil {
-		return nil, result.Error
+	q := applyPullRequestFilter(preloadPullRequest(conn(ctx, r.db)).Scopes(tenant(ctx, "pull_requests")), filter)
+
+	column, desc := "pull_requests.created_at", true
+	switch filter.Sort {
+	case openapi.CreatedAtAsc:
+		desc = false
+	case openapi.NameAsc:
+		column, desc = "pull_requests.pull_request_name", false
+	case openapi.NameDesc:
+		column = "pull_requests.pull_request_name"
+	}
+
+	if filter.After != nil {
+		var key interface{} = filter.After.CreatedAt
+		if column == "pull_requests.pull_request_name" {
+			key = filter.After.Name
+		}
+		op := ">"
+		if desc {
+			op = "<"
+		}
+		q = q.Where("("+column+", pull_requests.id) "+op+" (?, ?)", key, filter.After.ID)
+	}
+
+	direction := " ASC"
+	if desc {
+		direction = " DESC"
+	}
+	q = q.Order(column + direction).Order("pull_requests.id" + direction)
+
+	if filter.Limit > 0 {
+		q = q.Limit(filter.Limit + 1)
+	}
+
+	if err := q.Find(&prs).Error; err != nil {
+		return nil, err
 	}
 	return prs, nil
 }
 
-func (r *PReqRepository) ListPullRequestsByReviewer(ctx context.Context, reviewerID string) ([]*models.PullRequest, error) {
+func applyPullRequestFilter(q *gorm.DB, filter models.PullRequestFilter) *gorm.DB {
+	if filter.ReviewerCustomID != "" {
+		q = q.Joins("JOIN pull_request_reviewers ON pull_requests.id = pull_request_reviewers.pull_request_id").
+			Joins("JOIN users reviewers ON pull_request_reviewers.user_id = reviewers.id").
+			Where("reviewers.user_custom_id = ?", filter.ReviewerCustomID)
+	}
+	if filter.AuthorCustomID != "" {
+		q = q.Joins("JOIN users authors ON pull_requests.author_id = authors.id").
+			Where("authors.user_custom_id = ?", filter.AuthorCustomID)
+	}
+	if filter.TeamName != "" {
+		q = q.Where("EXISTS (SELECT 1 FROM team_memberships tm JOIN teams ON tm.team_id = teams.id WHERE tm.user_id = pull_requests.author_id AND teams.team_name = ?)", filter.TeamName)
+	}
+	if filter.Status != "" {
+		q = q.Where("pull_requests.status = ?", filter.Status)
+	}
+	if filter.CreatedFrom != nil {
+		q = q.Where("pull_requests.created_at >= ?", *filter.CreatedFrom)
+	}
+	if filter.CreatedTo != nil {
+		q = q.Where("pull_requests.created_at < ?", *filter.CreatedTo)
+	}
+	return q
+}
+
+// полнотекстовый поиск по названию: tsvector в postgres, LIKE для остальных бэкендов
+func (r *PReqRepository) SearchPullRequests(ctx context.Context, filter models.PullRequestFilter) ([]*models.PullRequest, error) {
 	var prs []*models.PullRequest
-	result := r.db.WithContext(ctx).Preload("Author").Preload("AssignedReviewers").Joins("JOIN pull_request_reviewers ON pull_requests.id = pull_request_reviewers.pull_request_id").Where("pull_request_reviewers.user_id = ?", reviewerID).Find(&prs)
+
+	q := applyPullRequestFilter(preloadPullRequest(conn(ctx, r.db)).Scopes(tenant(ctx, "pull_requests")), filter)
+
+	if r.db.Dialector.Name() == "postgres" {
+		q = q.Where("to_tsvector('simple', pull_requests.pull_request_name) @@ plainto_tsquery('simple', ?)", filter.Query).
+			Order(clause.Expr{SQL: "ts_rank(to_tsvector('simple', pull_requests.pull_request_name), plainto_tsquery('simple', ?)) DESC", Vars: []interface{}{filter.Query}})
+	} else {
+		q = q.Where("LOWER(pull_requests.pull_request_name) LIKE ? ESCAPE '\\'", "%"+escapeLike(strings.ToLower(filter.Query))+"%")
+	}
+	q = q.Order("pull_requests.created_at DESC")
+
+	if filter.Limit > 0 {
+		q = q.Limit(filter.Limit)
+	}
+
+	if err := q.Find(&prs).Error; err != nil {
+		return nil, err
+	}
+	return prs, nil
+}
+
+func escapeLike(s string) string {
+	return strings.NewReplacer("\\", "\\\\", "%", "\\%", "_", "\\_").Replace(s)
+}
+
+func (r *PReqRepository) ListReviewerAssignments(ctx context.Context, prID uuid.UUID) ([]*models.ReviewerAssignment, error) {
+	var rows []*models.ReviewerAssignment
+	result := conn(ctx, r.db).
+		Table("pull_request_reviewers").
+		Select("users.user_custom_id as user_custom_id, users.nickname as nickname, pull_request_reviewers.assigned_at as assigned_at, pull_request_reviewers.responded_at as responded_at").
+		Joins("JOIN users ON pull_request_reviewers.user_id = users.id").
+		Scopes(tenant(ctx, "users")).
+		Where("pull_request_reviewers.pull_request_id = ?", prID).
+		Order("pull_request_reviewers.assigned_at, users.user_custom_id").
+		Scan(&rows)
 	if result.Error != nil {
 		return nil, result.Error
 	}
-	return prs, nil
+	return rows, nil
+}
+
+// отмечает первый ответ ревьювера; повторный ответ время не меняет
+func (r *PReqRepository) MarkReviewerResponded(ctx context.Context, prID uuid.UUID, userID uuid.UUID, at int64) error {
+	return conn(ctx, r.db).
+		Model(&models.PullRequestReviewer{}).
+		Where("pull_request_id = ? AND user_id = ? AND responded_at IS NULL", prID, userID).
+		Update("responded_at", at).Error
 }
 
-func (r *PReqRepository) ListPullRequestsByReviewerCustomID(ctx context.Context, userCustomID string) ([]*models.PullRequest, error) {
+func (r *PReqRepository) ListPullRequestsByReviewer(ctx context.Context, reviewerID string) ([]*models.PullRequest, error) {
 	var prs []*models.PullRequest
-	result := r.db.WithContext(ctx).
-		Preload("Author").
-		Preload("AssignedReviewers").
-		Joins("JOIN pull_request_reviewers ON pull_requests.id = pull_request_reviewers.pull_request_id").
-		Joins("JOIN users ON pull_request_reviewers.user_id = users.id").
-		Where("users.user_custom_id = ?", userCustomID).
-		Find(&prs)
+	result := preloadPullRequest(conn(ctx, r.db)).Scopes(tenant(ctx, "pull_requests")).Joins("JOIN pull_request_reviewers ON pull_requests.id = pull_request_reviewers.pull_request_id").Where("pull_request_reviewers.user_id = ?", reviewerID).Find(&prs)
 	if result.Error != nil {
 		return nil, result.Error
 	}
@@ -85,15 +209,22 @@ func (r *PReqRepository) ListPullRequestsByReviewerCustomID(ctx context.Context,
 }
 
 func (r *PReqRepository) ListOpenPullRequestsByReviewerIDs(ctx context.Context, reviewerIDs []string) ([]*models.PullRequest, error) {
+	return r.ListPullRequestsByReviewerIDs(ctx, reviewerIDs, "OPEN")
+}
+
+// PR со статусом status, где ревьювером назначен хотя бы один из reviewerIDs (id пользователей, не user_id);
+// ревьюверы предзагружены, по ним вызывающий раскладывает PR по пользователям
+func (r *PReqRepository) ListPullRequestsByReviewerIDs(ctx context.Context, reviewerIDs []string, status string) ([]*models.PullRequest, error) {
 	if len(reviewerIDs) == 0 {
 		return nil, nil
 	}
 	var prs []*models.PullRequest
-	result := r.db.WithContext(ctx).
-		Preload("Author").
-		Preload("AssignedReviewers").
-		Joins("JOIN pull_request_reviewers ON pull_requests.id = pull_request_reviewers.pull_request_id").
-		Where("pull_requests.status = ? AND pull_request_reviewers.user_id IN ?", "OPEN", reviewerIDs).
+	db := conn(ctx, r.db)
+	assigned := db.Table("pull_request_reviewers").Select("pull_request_id").Where("user_id IN ?", reviewerIDs)
+	result := preloadPullRequest(db).
+		Scopes(tenant(ctx, "pull_requests")).
+		Where("pull_requests.status = ? AND pull_requests.id IN (?)", status, assigned).
+		Order("pull_requests.created_at DESC, pull_requests.id").
 		Find(&prs)
 	if result.Error != nil {
 		return nil, result.Error
@@ -107,11 +238,12 @@ func (r *PReqRepository) CountAssignmentsPerUser(ctx context.Context) (map[strin
 		Cnt          int64
 	}
 	var rows []row
-	q := r.db.WithContext(ctx).
+	q := conn(ctx, r.db).
 		Table("pull_requests").
 		Select("users.user_custom_id as user_custom_id, COUNT(*) as cnt").
 		Joins("JOIN pull_request_reviewers ON pull_requests.id = pull_request_reviewers.pull_request_id").
 		Joins("JOIN users ON pull_request_reviewers.user_id = users.id").
+		Scopes(tenant(ctx, "pull_requests")).
 		Where("pull_requests.status = ?", "OPEN").
 		Group("users.user_custom_id")
 
@@ -126,18 +258,22 @@ func (r *PReqRepository) CountAssignmentsPerUser(ctx context.Context) (map[strin
 	return res, nil
 }
 
+// ключ - pull_request_id, для PR из репозитория - repository/pull_request_id
 func (r *PReqRepository) CountAssignmentsPerPR(ctx context.Context) (map[string]int64, error) {
 	type row struct {
+		Repository string
 		PRCustomID string
 		Cnt        int64
 	}
 	var rows []row
 
-	q := r.db.WithContext(ctx).
+	q := conn(ctx, r.db).
 		Table("pull_requests").
-		Select("pull_requests.pull_request_custom_id as pr_custom_id, COUNT(pull_request_reviewers.user_id) as cnt").
+		Select("COALESCE(repositories.name, '') as repository, pull_requests.pull_request_custom_id as pr_custom_id, COUNT(pull_request_reviewers.user_id) as cnt").
+		Joins("LEFT JOIN repositories ON pull_requests.repository_id = repositories.id").
 		Joins("LEFT JOIN pull_request_reviewers ON pull_requests.id = pull_request_reviewers.pull_request_id").
-		Group("pull_requests.pull_request_custom_id")
+		Scopes(tenant(ctx, "pull_requests")).
+		Group("repositories.name, pull_requests.pull_request_custom_id")
 
 	if err := q.Scan(&rows).Error; err != nil {
 		return nil, err
@@ -145,7 +281,162 @@ func (r *PReqRepository) CountAssignmentsPerPR(ctx context.Context) (map[string]
 
 	res := make(map[string]int64, len(rows))
 	for _, r := range rows {
-		res[r.PRCustomID] = r.Cnt
+		key := r.PRCustomID
+		if r.Repository != "" {
+			key = r.Repository + "/" + r.PRCustomID
+		}
+		res[key] = r.Cnt
 	}
 	return res, nil
 }
+
+// смерженные PR с merged_at в полуинтервале [from, to), nil - без ограничения
+func (r *PReqRepository) ListMergedPullRequests(ctx context.Context, from, to *int64) ([]*models.MergedPullRequest, error) {
+	var rows []*models.MergedPullRequest
+	q := conn(ctx, r.db).
+		Table("pull_requests").
+		Select("authors.user_custom_id as author_custom_id, COALESCE(teams.team_name, '') as team_name, pull_requests.created_at as created_at, pull_requests.merged_at as merged_at").
+		Joins("JOIN users authors ON pull_requests.author_id = authors.id").
+		Joins("LEFT JOIN team_memberships tm ON tm.user_id = authors.id AND tm.is_primary = ?", true).
+		Joins("LEFT JOIN teams ON tm.team_id = teams.id").
+		Scopes(tenant(ctx, "pull_requests")).
+		Where("pull_requests.status = ? AND pull_requests.merged_at IS NOT NULL", "MERGED")
+	q = timeRange(q, "pull_requests.merged_at", from, to)
+
+	if err := q.Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+func (r *PReqRepository) ListMergedReviews(ctx context.Context, from, to *int64) ([]*models.MergedReview, error) {
+	var rows []*models.MergedReview
+	q := conn(ctx, r.db).
+		Table("pull_request_reviewers").
+		Select("users.user_custom_id as reviewer_custom_id, pull_requests.merged_at as merged_at").
+		Joins("JOIN pull_requests ON pull_request_reviewers.pull_request_id = pull_requests.id").
+		Joins("JOIN users ON pull_request_reviewers.user_id = users.id").
+		Scopes(tenant(ctx, "pull_requests")).
+		Where("pull_requests.status = ? AND pull_requests.merged_at IS NOT NULL", "MERGED")
+	q = timeRange(q, "pull_requests.merged_at", from, to)
+
+	if err := q.Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// моменты создания PR в диапазоне; status пустой - любые PR
+func (r *PReqRepository) ListCreatedAt(ctx context.Context, status string, from, to *int64) ([]int64, error) {
+	var rows []int64
+	q := conn(ctx, r.db).Model(&models.PullRequest{}).Scopes(tenant(ctx, "pull_requests"))
+	if status != "" {
+		q = q.Where("status = ?", status)
+	}
+	q = timeRange(q, "created_at", from, to)
+
+	if err := q.Pluck("created_at", &rows).Error; err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+func timeRange(q *gorm.DB, column string, from, to *int64) *gorm.DB {
+	if from != nil {
+		q = q.Where(column+" >= ?", *from)
+	}
+	if to != nil {
+		q = q.Where(column+" < ?", *to)
+	}
+	return q
+}
+
+type pullRequestExportScan struct {
+	PullRequestCustomID string
+	Repository          string
+	PullRequestName     string
+	AuthorCustomID      string
+	TeamName            string
+	Status              string
+	CreatedAt           int64
+	MergedAt            *int64
+}
+
+// PR по фильтру для выгрузки в порядке создания; TeamName - основная команда автора
+func (r *PReqRepository) StreamPullRequests(ctx context.Context, filter models.PullRequestFilter, fn func(*models.PullRequestExportRow) error) error {
+	q := conn(ctx, r.db).
+		Table("pull_requests").
+		Select("pull_requests.pull_request_custom_id as pull_request_custom_id, COALESCE(export_repositories.name, '') as repository, "+
+			"pull_requests.pull_request_name as pull_request_name, export_authors.user_custom_id as author_custom_id, COALESCE(teams.team_name, '') as team_name, "+
+			"pull_requests.status as status, pull_requests.created_at as created_at, pull_requests.merged_at as merged_at").
+		Joins("JOIN users export_authors ON pull_requests.author_id = export_authors.id").
+		Joins("LEFT JOIN repositories export_repositories ON pull_requests.repository_id = export_repositories.id").
+		Joins("LEFT JOIN team_memberships tm ON tm.user_id = export_authors.id AND tm.is_primary = ?", true).
+		Joins("LEFT JOIN teams ON tm.team_id = teams.id").
+		Scopes(tenant(ctx, "pull_requests"))
+	q = applyPullRequestFilter(q, filter).Order("pull_requests.created_at").Order("pull_requests.id")
+
+	return streamRows(q, func(row *pullRequestExportScan) error {
+		return fn(&models.PullRequestExportRow{
+			PullRequestID:   row.PullRequestCustomID,
+			Repository:      row.Repository,
+			PullRequestName: row.PullRequestName,
+			AuthorID:        row.AuthorCustomID,
+			TeamName:        row.TeamName,
+			Status:          row.Status,
+			CreatedAt:       models.FormatUnix(row.CreatedAt),
+			MergedAt:        formatUnixPtr(row.MergedAt),
+		})
+	})
+}
+
+type assignmentExportScan struct {
+	PullRequestCustomID string
+	Repository          string
+	ReviewerCustomID    string
+	Status              string
+	AssignedAt          int64
+	RespondedAt         *int64
+}
+
+// назначения ревьюверов на PR, подходящие под фильтр; ReviewerCustomID ограничивает самих ревьюверов
+func (r *PReqRepository) StreamReviewerAssignments(ctx context.Context, filter models.PullRequestFilter, fn func(*models.AssignmentExportRow) error) error {
+	reviewer := filter.ReviewerCustomID
+	filter.ReviewerCustomID = ""
+
+	q := conn(ctx, r.db).
+		Table("pull_requests").
+		Select("pull_requests.pull_request_custom_id as pull_request_custom_id, COALESCE(export_repositories.name, '') as repository, " +
+			"export_reviewers.user_custom_id as reviewer_custom_id, pull_requests.status as status, export_prr.assigned_at as assigned_at, export_prr.responded_at as responded_at").
+		Joins("JOIN pull_request_reviewers export_prr ON pull_requests.id = export_prr.pull_request_id").
+		Joins("JOIN users export_reviewers ON export_prr.user_id = export_reviewers.id").
+		Joins("LEFT JOIN repositories export_repositories ON pull_requests.repository_id = export_repositories.id").
+		Scopes(tenant(ctx, "pull_requests"))
+	if reviewer != "" {
+		q = q.Where("export_reviewers.user_custom_id = ?", reviewer)
+	}
+	q = applyPullRequestFilter(q, filter).
+		Order("pull_requests.created_at").
+		Order("pull_requests.id").
+		Order("export_prr.assigned_at").
+		Order("export_reviewers.user_custom_id")
+
+	return streamRows(q, func(row *assignmentExportScan) error {
+		return fn(&models.AssignmentExportRow{
+			PullRequestID: row.PullRequestCustomID,
+			Repository:    row.Repository,
+			ReviewerID:    row.ReviewerCustomID,
+			Status:        row.Status,
+			AssignedAt:    models.FormatUnix(row.AssignedAt),
+			RespondedAt:   formatUnixPtr(row.RespondedAt),
+		})
+	})
+}
+
+func formatUnixPtr(ts *int64) *string {
+	if ts == nil {
+		return nil
+	}
+	s := models.FormatUnix(*ts)
+	return &s
+}
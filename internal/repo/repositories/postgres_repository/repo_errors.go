@@ -5,3 +5,8 @@ import "errors"
 var ErrUserExists = errors.New("user already exists")
 var ErrTeamExists = errors.New("team already exists")
 var ErrPRExists = errors.New("pr already exists")
+var ErrRepositoryExists = errors.New("repository already exists")
+var ErrOrganizationExists = errors.New("organization already exists")
+
+// аренду задачи перехватила другая реплика
+var ErrJobLeaseLost = errors.New("job lease lost")
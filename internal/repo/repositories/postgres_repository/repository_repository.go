@@ -0,0 +1,51 @@
+package postgresrepository
+
+import (
+	"context"
+	"strings"
+
+	"github.com/wozhdeleniye/avito-tech-internship/internal/repo/models"
+	"gorm.io/gorm"
+)
+
+// репозитории кода, которыми владеют команды
+type RepositoryRepository struct {
+	db *gorm.DB
+}
+
+func NewRepositoryRepository(db *gorm.DB) *RepositoryRepository {
+	return &RepositoryRepository{db: db}
+}
+
+func (r *RepositoryRepository) CreateRepository(ctx context.Context, repo *models.Repository) error {
+	repo.OrganizationID = OrganizationID(ctx)
+	result := conn(ctx, r.db).Omit("OwnerTeam").Create(repo)
+	if result.Error != nil {
+		le := strings.ToLower(result.Error.Error())
+		if strings.Contains(le, "duplicate") || strings.Contains(le, "unique") || strings.Contains(le, "violates unique") {
+			return ErrRepositoryExists
+		}
+	}
+	return result.Error
+}
+
+func (r *RepositoryRepository) FindRepositoryByName(ctx context.Context, name string) (*models.Repository, error) {
+	var repo models.Repository
+	if err := conn(ctx, r.db).Scopes(tenant(ctx, "repositories")).Preload("OwnerTeam").Where("name = ?", name).First(&repo).Error; err != nil {
+		return nil, err
+	}
+	return &repo, nil
+}
+
+// заменяет переопределения политики целиком: nil возвращает поле к значению по умолчанию
+func (r *RepositoryRepository) SetReviewerPolicy(ctx context.Context, repo *models.Repository, source *string, count *int) error {
+	if err := conn(ctx, r.db).Model(repo).Scopes(tenant(ctx, "repositories")).Updates(map[string]interface{}{
+		"reviewer_source": source,
+		"reviewers_count": count,
+	}).Error; err != nil {
+		return err
+	}
+	repo.ReviewerSource = source
+	repo.ReviewersCount = count
+	return nil
+}
@@ -0,0 +1,76 @@
+package postgresrepository
+
+import (
+	"context"
+
+	"github.com/wozhdeleniye/avito-tech-internship/internal/repo/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type SLARepository struct {
+	db *gorm.DB
+}
+
+func NewSLARepository(db *gorm.DB) *SLARepository {
+	return &SLARepository{db: db}
+}
+
+// назначения в открытых PR организации без ответа ревьювера, для которых действует SLA основной команды автора
+// и нарушение ещё не зафиксировано; срок проверяет вызывающий по своим часам
+func (r *SLARepository) ListPendingAssignments(ctx context.Context) ([]*models.PendingAssignment, error) {
+	var rows []*models.PendingAssignment
+	result := conn(ctx, r.db).
+		Table("pull_request_reviewers").
+		Select("pull_requests.pull_request_custom_id as pull_request_custom_id, COALESCE(repositories.name, '') as repository, reviewers.user_custom_id as reviewer_custom_id, teams.team_name as team_name, pull_request_reviewers.assigned_at as assigned_at, teams.sla_first_review_hours as sla_hours, teams.sla_action as sla_action").
+		Joins("JOIN pull_requests ON pull_request_reviewers.pull_request_id = pull_requests.id").
+		Joins("LEFT JOIN repositories ON pull_requests.repository_id = repositories.id").
+		Joins("JOIN users reviewers ON pull_request_reviewers.user_id = reviewers.id").
+		Joins("JOIN team_memberships tm ON tm.user_id = pull_requests.author_id AND tm.is_primary = ?", true).
+		Joins("JOIN teams ON tm.team_id = teams.id").
+		Scopes(tenant(ctx, "pull_requests")).
+		Where("pull_requests.status = ? AND pull_request_reviewers.responded_at IS NULL AND teams.sla_first_review_hours > 0", "OPEN").
+		Where("NOT EXISTS (SELECT 1 FROM sla_breaches b WHERE b.organization_id = pull_requests.organization_id AND b.pull_request_custom_id = pull_requests.pull_request_custom_id AND b.repository = COALESCE(repositories.name, '') AND b.reviewer_custom_id = reviewers.user_custom_id AND b.assigned_at = pull_request_reviewers.assigned_at)").
+		Order("pull_request_reviewers.assigned_at").
+		Scan(&rows)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return rows, nil
+}
+
+// фиксирует нарушение; false, если оно уже было записано другим проходом
+func (r *SLARepository) RecordBreach(ctx context.Context, breach *models.SLABreach) (bool, error) {
+	breach.OrganizationID = OrganizationID(ctx)
+	result := conn(ctx, r.db).Clauses(clause.OnConflict{DoNothing: true}).Create(breach)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+func (r *SLARepository) SetOutcome(ctx context.Context, breach *models.SLABreach) error {
+	return conn(ctx, r.db).Model(breach).Update("outcome", breach.Outcome).Error
+}
+
+func (r *SLARepository) CountBreachesPerTeam(ctx context.Context) (map[string]int64, error) {
+	type row struct {
+		TeamName string
+		Cnt      int64
+	}
+	var rows []row
+	if err := conn(ctx, r.db).
+		Model(&models.SLABreach{}).
+		Scopes(tenant(ctx, "sla_breaches")).
+		Select("team_name, COUNT(*) as cnt").
+		Group("team_name").
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	res := make(map[string]int64, len(rows))
+	for _, r := range rows {
+		res[r.TeamName] = r.Cnt
+	}
+	return res, nil
+}
@@ -0,0 +1,28 @@
+package postgresrepository
+
+import (
+	"database/sql"
+
+	"gorm.io/gorm"
+)
+
+// читает результат запроса курсором БД по одной строке и передаёт каждую в fn;
+// выборка целиком в память не загружается, ошибка fn прерывает чтение
+func streamRows[T any](q *gorm.DB, fn func(*T) error) error {
+	rows, err := q.Rows()
+	if err != nil {
+		return err
+	}
+	defer func(rows *sql.Rows) { _ = rows.Close() }(rows)
+
+	for rows.Next() {
+		var row T
+		if err := q.ScanRows(rows, &row); err != nil {
+			return err
+		}
+		if err := fn(&row); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
@@ -2,14 +2,18 @@ package postgresrepository
 
 import (
 	"context"
+	"errors"
 	"math/rand"
 	"strings"
 
 	"github.com/google/uuid"
 	"github.com/wozhdeleniye/avito-tech-internship/internal/repo/models"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
+// членства (team_memberships) не хранят организацию: запросы к ним идут по id команд и пользователей,
+// уже найденных в организации из ctx
 type TeamRepository struct {
 	db *gorm.DB
 }
@@ -19,71 +23,51 @@ func NewTeamRepository(db *gorm.DB) *TeamRepository {
 }
 
 func (r *TeamRepository) CreateTeam(ctx context.Context, team *models.Team) error {
-	result := r.db.WithContext(ctx).Create(team)
-	return result.Error
-}
-
-// цельная транзакция для создания команды и созд участников
-func (r *TeamRepository) CreateTeamWithMembers(ctx context.Context, team *models.Team) error {
-	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		if err := tx.Omit("Members").Create(team).Error; err != nil {
-			le := strings.ToLower(err.Error())
-			if strings.Contains(le, "duplicate") || strings.Contains(le, "unique") || strings.Contains(le, "violates unique") {
-				return ErrTeamExists
-			}
-			return err
-		}
-
-		for _, member := range team.Members {
-			if member == nil {
-				continue
-			}
-
-			member.TeamID = &team.ID
-			if err := tx.Create(member).Error; err != nil {
-				le := strings.ToLower(err.Error())
-				if strings.Contains(le, "duplicate") || strings.Contains(le, "unique") || strings.Contains(le, "violates unique") {
-					return ErrUserExists
-				}
-				return err
-			}
-		}
-
-		if len(team.Members) > 0 {
-			toAppend := make([]*models.User, 0, len(team.Members))
-			for _, m := range team.Members {
-				if m == nil {
-					continue
-				}
-				toAppend = append(toAppend, &models.User{ID: m.ID})
-			}
-
-			teamModel := &models.Team{ID: team.ID}
-			if err := tx.Model(teamModel).Association("Members").Append(toAppend); err != nil {
-				return err
-			}
+	team.OrganizationID = OrganizationID(ctx)
+	result := conn(ctx, r.db).Omit("Members").Create(team)
+	if result.Error != nil {
+		le := strings.ToLower(result.Error.Error())
+		if strings.Contains(le, "duplicate") || strings.Contains(le, "unique") || strings.Contains(le, "violates unique") {
+			return ErrTeamExists
 		}
-
-		return nil
-	})
+	}
+	return result.Error
 }
 
 func (r *TeamRepository) GetTeamByID(ctx context.Context, id uuid.UUID) (*models.Team, error) {
 	var team models.Team
-	result := r.db.WithContext(ctx).Preload("Members").Where("id = ?", id).First(&team)
+	result := conn(ctx, r.db).Scopes(tenant(ctx, "teams")).Preload("Members").Where("id = ?", id).First(&team)
 	if result.Error != nil {
 		return nil, result.Error
 	}
 	return &team, nil
 }
 
+// активные участники команды, которых можно назначить ревьювером, кроме userID
 func (r *TeamRepository) GetAllParticipantsButNotSpecial(ctx context.Context, teamID string, userID string) ([]*models.User, error) {
 	var members []*models.User
 
-	if err := r.db.WithContext(ctx).
+	if err := conn(ctx, r.db).
 		Model(&models.User{}).
-		Joins("JOIN user_teams ut ON ut.user_id = users.id").
-		Where("ut.team_id = ? AND users.id != ? AND users.is_active = ?", teamID, userID, true).
+		Scopes(tenant(ctx, "users")).
+		Joins("JOIN team_memberships tm ON tm.user_id = users.id").
+		Where("tm.team_id = ? AND tm.role != ? AND users.id != ? AND users.is_active = ?", teamID, models.RoleObserver, userID, true).
+		Find(&members).Error; err != nil {
+		return nil, err
+	}
+
+	return members, nil
+}
+
+// участники команды, которых можно назначать ревьюверами (все, кроме observer)
+func (r *TeamRepository) ListReviewCandidates(ctx context.Context, teamID uuid.UUID) ([]*models.User, error) {
+	var members []*models.User
+
+	if err := conn(ctx, r.db).
+		Model(&models.User{}).
+		Scopes(tenant(ctx, "users")).
+		Joins("JOIN team_memberships tm ON tm.user_id = users.id").
+		Where("tm.team_id = ? AND tm.role != ?", teamID, models.RoleObserver).
 		Find(&members).Error; err != nil {
 		return nil, err
 	}
@@ -125,9 +109,185 @@ func (r *TeamRepository) PickMemberNotInList(members []*models.User, excluded []
 	return candidates[rand.Intn(len(candidates))]
 }
 
+func (r *TeamRepository) RenameTeam(ctx context.Context, team *models.Team, newName string) error {
+	err := conn(ctx, r.db).Model(team).Scopes(tenant(ctx, "teams")).Update("team_name", newName).Error
+	if err != nil {
+		le := strings.ToLower(err.Error())
+		if strings.Contains(le, "duplicate") || strings.Contains(le, "unique") || strings.Contains(le, "violates unique") {
+			return ErrTeamExists
+		}
+		return err
+	}
+	return nil
+}
+
+// количество репозиториев, которыми владеет команда
+func (r *TeamRepository) CountOwnedRepositories(ctx context.Context, teamID uuid.UUID) (int64, error) {
+	var count int64
+	err := conn(ctx, r.db).Model(&models.Repository{}).Scopes(tenant(ctx, "repositories")).Where("owner_team_id = ?", teamID).Count(&count).Error
+	return count, err
+}
+
+func (r *TeamRepository) DeleteTeam(ctx context.Context, team *models.Team) error {
+	db := conn(ctx, r.db)
+	if err := db.Model(team).Association("Members").Clear(); err != nil {
+		return err
+	}
+	return db.Scopes(tenant(ctx, "teams")).Delete(team).Error
+}
+
+// добавляет членство в команде; для пользователей без основной команды она становится основной
+func (r *TeamRepository) AddMembers(ctx context.Context, team *models.Team, users []*models.User, role string) error {
+	if len(users) == 0 {
+		return nil
+	}
+	db := conn(ctx, r.db)
+	ids := make([]uuid.UUID, 0, len(users))
+	rows := make([]*models.TeamMembership, 0, len(users))
+	for _, u := range users {
+		ids = append(ids, u.ID)
+		rows = append(rows, &models.TeamMembership{TeamID: team.ID, UserID: u.ID, Role: role})
+	}
+	if err := db.Clauses(clause.OnConflict{DoNothing: true}).Create(&rows).Error; err != nil {
+		return err
+	}
+	return db.Model(&models.TeamMembership{}).
+		Where("team_id = ? AND user_id IN ?", team.ID, ids).
+		Where("NOT EXISTS (SELECT 1 FROM team_memberships p WHERE p.user_id = team_memberships.user_id AND p.is_primary = ?)", true).
+		Update("is_primary", true).Error
+}
+
+// удаляет членство в команде; если команда была основной, основной становится самая ранняя из оставшихся
+func (r *TeamRepository) RemoveMembers(ctx context.Context, team *models.Team, users []*models.User) error {
+	if len(users) == 0 {
+		return nil
+	}
+	db := conn(ctx, r.db)
+	ids := make([]uuid.UUID, 0, len(users))
+	for _, u := range users {
+		ids = append(ids, u.ID)
+	}
+	if err := db.Where("team_id = ? AND user_id IN ?", team.ID, ids).Delete(&models.TeamMembership{}).Error; err != nil {
+		return err
+	}
+
+	for _, id := range ids {
+		var primaries int64
+		if err := db.Model(&models.TeamMembership{}).Where("user_id = ? AND is_primary = ?", id, true).Count(&primaries).Error; err != nil {
+			return err
+		}
+		if primaries > 0 {
+			continue
+		}
+
+		var next models.TeamMembership
+		err := db.Where("user_id = ?", id).Order("created_at").First(&next).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if err := r.SetPrimaryTeam(ctx, id, next.TeamID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *TeamRepository) SetPrimaryTeam(ctx context.Context, userID uuid.UUID, teamID uuid.UUID) error {
+	db := conn(ctx, r.db)
+	if err := db.Model(&models.TeamMembership{}).Where("user_id = ? AND team_id != ?", userID, teamID).Update("is_primary", false).Error; err != nil {
+		return err
+	}
+	return db.Model(&models.TeamMembership{}).Where("user_id = ? AND team_id = ?", userID, teamID).Update("is_primary", true).Error
+}
+
+func (r *TeamRepository) SetSLAPolicy(ctx context.Context, team *models.Team, hours int, action string) error {
+	return conn(ctx, r.db).Model(team).Scopes(tenant(ctx, "teams")).Updates(map[string]interface{}{"sla_first_review_hours": hours, "sla_action": action}).Error
+}
+
+func (r *TeamRepository) SetMemberRole(ctx context.Context, teamID uuid.UUID, userID uuid.UUID, role string) error {
+	return conn(ctx, r.db).Model(&models.TeamMembership{}).Where("team_id = ? AND user_id = ?", teamID, userID).Update("role", role).Error
+}
+
+func (r *TeamRepository) ListMemberships(ctx context.Context, teamID uuid.UUID) ([]*models.TeamMembership, error) {
+	var memberships []*models.TeamMembership
+	if err := conn(ctx, r.db).Where("team_id = ?", teamID).Find(&memberships).Error; err != nil {
+		return nil, err
+	}
+	return memberships, nil
+}
+
+// членства нескольких команд одним запросом
+func (r *TeamRepository) ListMembershipsByTeamIDs(ctx context.Context, teamIDs []uuid.UUID) ([]*models.TeamMembership, error) {
+	var memberships []*models.TeamMembership
+	if len(teamIDs) == 0 {
+		return memberships, nil
+	}
+	if err := conn(ctx, r.db).Where("team_id IN ?", teamIDs).Find(&memberships).Error; err != nil {
+		return nil, err
+	}
+	return memberships, nil
+}
+
+// членства в основных командах пользователей; у пользователя без команд записи нет
+func (r *TeamRepository) ListPrimaryMemberships(ctx context.Context, userIDs []uuid.UUID) ([]*models.TeamMembership, error) {
+	var memberships []*models.TeamMembership
+	if len(userIDs) == 0 {
+		return memberships, nil
+	}
+	if err := conn(ctx, r.db).Where("user_id IN ? AND is_primary = ?", userIDs, true).Find(&memberships).Error; err != nil {
+		return nil, err
+	}
+	return memberships, nil
+}
+
+// команды организации с участниками по id; отсутствующие id пропускаются
+func (r *TeamRepository) GetTeamsByIDs(ctx context.Context, ids []uuid.UUID) ([]*models.Team, error) {
+	var teams []*models.Team
+	if len(ids) == 0 {
+		return teams, nil
+	}
+	if err := conn(ctx, r.db).Scopes(tenant(ctx, "teams")).Preload("Members").Where("teams.id IN ?", ids).Find(&teams).Error; err != nil {
+		return nil, err
+	}
+	return teams, nil
+}
+
+func (r *TeamRepository) GetMembership(ctx context.Context, teamID uuid.UUID, userID uuid.UUID) (*models.TeamMembership, error) {
+	var membership models.TeamMembership
+	result := conn(ctx, r.db).Where("team_id = ? AND user_id = ?", teamID, userID).First(&membership)
+	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return &membership, nil
+}
+
+// основная команда пользователя или nil, если пользователь не состоит ни в одной команде
+func (r *TeamRepository) GetPrimaryTeam(ctx context.Context, userID uuid.UUID) (*models.Team, error) {
+	var team models.Team
+	result := conn(ctx, r.db).
+		Scopes(tenant(ctx, "teams")).
+		Preload("Members").
+		Joins("JOIN team_memberships tm ON tm.team_id = teams.id").
+		Where("tm.user_id = ? AND tm.is_primary = ?", userID, true).
+		First(&team)
+	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return &team, nil
+}
+
 func (r *TeamRepository) FindTeamByName(ctx context.Context, name string) (*models.Team, error) {
 	var team models.Team
-	result := r.db.WithContext(ctx).Preload("Members").Where("team_name = ?", name).First(&team)
+	result := conn(ctx, r.db).Scopes(tenant(ctx, "teams")).Preload("Members").Where("team_name = ?", name).First(&team)
 	if result.Error != nil {
 		return nil, result.Error
 	}
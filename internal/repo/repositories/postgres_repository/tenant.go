@@ -0,0 +1,32 @@
+package postgresrepository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/wozhdeleniye/avito-tech-internship/internal/repo/models"
+	"gorm.io/gorm"
+)
+
+type organizationKey struct{}
+
+// организация, в пределах которой репозитории читают и создают записи
+func WithOrganization(ctx context.Context, id uuid.UUID) context.Context {
+	return context.WithValue(ctx, organizationKey{}, id)
+}
+
+// организация из ctx; без неё - организация по умолчанию
+func OrganizationID(ctx context.Context) uuid.UUID {
+	if id, ok := ctx.Value(organizationKey{}).(uuid.UUID); ok && id != uuid.Nil {
+		return id
+	}
+	return models.DefaultOrganizationID
+}
+
+// ограничивает запрос организацией из ctx; table - таблица или алиас с колонкой organization_id
+func tenant(ctx context.Context, table string) func(*gorm.DB) *gorm.DB {
+	id := OrganizationID(ctx)
+	return func(q *gorm.DB) *gorm.DB {
+		return q.Where(table+".organization_id = ?", id)
+	}
+}
@@ -0,0 +1,58 @@
+package postgresrepository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+)
+
+type txKey struct{}
+
+type afterCommitKey struct{}
+
+// действия, отложенные до фиксации транзакции
+type afterCommit struct {
+	fns []func()
+}
+
+type TxManager struct {
+	db *gorm.DB
+}
+
+func NewTxManager(db *gorm.DB) *TxManager {
+	return &TxManager{db: db}
+}
+
+// выполняет fn в одной транзакции, репозитории получают её через ctx; вложенные вызовы переиспользуют внешнюю транзакцию
+func (m *TxManager) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txKey{}).(*gorm.DB); ok {
+		return fn(ctx)
+	}
+	hooks := &afterCommit{}
+	err := m.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(context.WithValue(context.WithValue(ctx, txKey{}, tx), afterCommitKey{}, hooks))
+	})
+	if err == nil {
+		for _, f := range hooks.fns {
+			f()
+		}
+	}
+	return err
+}
+
+// выполняет fn после фиксации транзакции из ctx, при откате - никогда; вне транзакции - сразу
+func AfterCommit(ctx context.Context, fn func()) {
+	if hooks, ok := ctx.Value(afterCommitKey{}).(*afterCommit); ok {
+		hooks.fns = append(hooks.fns, fn)
+		return
+	}
+	fn()
+}
+
+// соединение для запроса: транзакция из ctx, если она открыта, иначе общий пул
+func conn(ctx context.Context, db *gorm.DB) *gorm.DB {
+	if tx, ok := ctx.Value(txKey{}).(*gorm.DB); ok {
+		return tx.WithContext(ctx)
+	}
+	return db.WithContext(ctx)
+}
@@ -3,6 +3,7 @@ package postgresrepository
 import (
 	"context"
 	"errors"
+	"strings"
 
 	"github.com/wozhdeleniye/avito-tech-internship/internal/repo/models"
 	"gorm.io/gorm"
@@ -17,16 +18,29 @@ func NewUserRepository(db *gorm.DB) *UserRepository {
 }
 
 func (r *UserRepository) CreateUser(ctx context.Context, user *models.User) error {
-	result := r.db.WithContext(ctx).Create(user)
+	db := conn(ctx, r.db)
+	user.OrganizationID = OrganizationID(ctx)
+	isActive := user.IsActive
+	result := db.Create(user)
 	if result.Error != nil {
+		le := strings.ToLower(result.Error.Error())
+		if strings.Contains(le, "duplicate") || strings.Contains(le, "unique") || strings.Contains(le, "violates unique") {
+			return ErrUserExists
+		}
 		return result.Error
 	}
+
+	// gorm подставляет default:true вместо нулевого false при вставке, поэтому неактивность выставляем отдельно
+	if !isActive {
+		user.IsActive = false
+		return db.Model(user).UpdateColumn("is_active", false).Error
+	}
 	return nil
 }
 
 func (r *UserRepository) GetUserByCustomId(ctx context.Context, cutstomId string) (*models.User, error) {
 	var user models.User
-	result := r.db.WithContext(ctx).Where("user_custom_id = ?", cutstomId).First(&user) //доделать проверку с custom id
+	result := conn(ctx, r.db).Scopes(tenant(ctx, "users")).Where("user_custom_id = ?", cutstomId).First(&user) //доделать проверку с custom id
 
 	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
 		return nil, nil
@@ -39,19 +53,31 @@ func (r *UserRepository) GetUserByCustomId(ctx context.Context, cutstomId string
 	return &user, nil
 }
 
+func (r *UserRepository) GetUsersByCustomIDs(ctx context.Context, customIDs []string) ([]*models.User, error) {
+	var users []*models.User
+	if len(customIDs) == 0 {
+		return users, nil
+	}
+	result := conn(ctx, r.db).Scopes(tenant(ctx, "users")).Where("user_custom_id IN ?", customIDs).Find(&users)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return users, nil
+}
+
 func (r *UserRepository) UpdateUser(ctx context.Context, user *models.User) error {
-	result := r.db.WithContext(ctx).Save(user)
+	result := conn(ctx, r.db).Save(user)
 	return result.Error
 }
 
 func (r *UserRepository) DeleteUser(ctx context.Context, user *models.User) error {
-	result := r.db.WithContext(ctx).Delete(user)
+	result := conn(ctx, r.db).Scopes(tenant(ctx, "users")).Delete(user)
 	return result.Error
 }
 
 func (r *UserRepository) GetUserByCustomIDActive(ctx context.Context, customID string) (*models.User, error) {
 	var user models.User
-	result := r.db.WithContext(ctx).Where("user_custom_id = ? AND is_active = ?", customID, true).First(&user)
+	result := conn(ctx, r.db).Scopes(tenant(ctx, "users")).Where("user_custom_id = ? AND is_active = ?", customID, true).First(&user)
 
 	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
 		return nil, gorm.ErrRecordNotFound
@@ -66,14 +92,17 @@ func (r *UserRepository) GetUserByCustomIDActive(ctx context.Context, customID s
 
 func (r *UserRepository) UserExistsByCustomID(ctx context.Context, customID string) (bool, error) {
 	var count int64
-	result := r.db.WithContext(ctx).Model(&models.User{}).Where("user_custom_id = ? AND is_active = ?", customID, true).Count(&count)
+	result := conn(ctx, r.db).Model(&models.User{}).Scopes(tenant(ctx, "users")).Where("user_custom_id = ? AND is_active = ?", customID, true).Count(&count)
 	return count > 0, result.Error
 }
 
 func (r *UserRepository) SetUsersActiveByTeamID(ctx context.Context, teamID string, isActive bool) error {
-	result := r.db.WithContext(ctx).
+	db := conn(ctx, r.db)
+	members := db.Model(&models.TeamMembership{}).Select("user_id").Where("team_id = ?", teamID)
+	result := db.
 		Model(&models.User{}).
-		Where("team_id = ?", teamID).
+		Scopes(tenant(ctx, "users")).
+		Where("id IN (?)", members).
 		Update("is_active", isActive)
 	return result.Error
 }
@@ -82,8 +111,9 @@ func (r *UserRepository) SetUsersActiveByIDs(ctx context.Context, ids []string,
 	if len(ids) == 0 {
 		return nil
 	}
-	result := r.db.WithContext(ctx).
+	result := conn(ctx, r.db).
 		Model(&models.User{}).
+		Scopes(tenant(ctx, "users")).
 		Where("id IN ?", ids).
 		Update("is_active", isActive)
 	return result.Error
@@ -0,0 +1,136 @@
+package services
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"strconv"
+	"time"
+
+	openapi "github.com/wozhdeleniye/avito-tech-internship/api"
+	serviceerrors "github.com/wozhdeleniye/avito-tech-internship/internal/pkg/errors"
+	"github.com/wozhdeleniye/avito-tech-internship/internal/pkg/ptr"
+	"github.com/wozhdeleniye/avito-tech-internship/internal/repo/models"
+	postgresrepository "github.com/wozhdeleniye/avito-tech-internship/internal/repo/repositories/postgres_repository"
+)
+
+const (
+	AnonymousActor = "anonymous"
+	SystemActor    = "system"
+)
+
+type actorKey struct{}
+
+// кладёт в контекст инициатора действия для журнала аудита
+func WithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+func ActorFromContext(ctx context.Context) string {
+	if actor, ok := ctx.Value(actorKey{}).(string); ok && actor != "" {
+		return actor
+	}
+	return AnonymousActor
+}
+
+type AuditService struct {
+	AuditRepo *postgresrepository.AuditRepository
+	PRRepo    *postgresrepository.PReqRepository
+}
+
+func NewAuditService(auditRepo *postgresrepository.AuditRepository, prRepo *postgresrepository.PReqRepository) *AuditService {
+	return &AuditService{
+		AuditRepo: auditRepo,
+		PRRepo:    prRepo,
+	}
+}
+
+// дописывает записи в журнал от имени инициатора из контекста; вызывается внутри транзакции изменения
+func (s *AuditService) Record(ctx context.Context, entries ...*models.AuditEntry) error {
+	actor := ActorFromContext(ctx)
+	for _, e := range entries {
+		if e.Actor == "" {
+			e.Actor = actor
+		}
+	}
+	return s.AuditRepo.Append(ctx, entries)
+}
+
+func (s *AuditService) ListAudit(ctx context.Context, filter models.AuditFilter, limit *int, cursor *string) (*openapi.AuditLog, *serviceerrors.ServiceError) {
+	filter.Limit = defaultPageLimit
+	if limit != nil {
+		if *limit < 1 || *limit > maxPageLimit {
+			return nil, serviceerrors.ErrInvalidLimit
+		}
+		filter.Limit = *limit
+	}
+	if cursor != nil && *cursor != "" {
+		after, err := decodeAuditCursor(*cursor)
+		if err != nil {
+			return nil, serviceerrors.ErrInvalidCursor
+		}
+		filter.AfterID = after
+	}
+
+	entries, err := s.AuditRepo.List(ctx, filter)
+	if err != nil {
+		return nil, serviceerrors.ErrUnknown.Wrap(err)
+	}
+
+	resp := &openapi.AuditLog{Entries: make([]openapi.AuditEntry, 0, len(entries))}
+	if len(entries) > filter.Limit {
+		entries = entries[:filter.Limit]
+		next := encodeAuditCursor(entries[len(entries)-1].ID)
+		resp.NextCursor = &next
+	}
+	for _, e := range entries {
+		resp.Entries = append(resp.Entries, auditEntryResponse(e))
+	}
+	return resp, nil
+}
+
+func (s *AuditService) PullRequestHistory(ctx context.Context, params openapi.GetPullRequestHistoryParams) (*openapi.AuditLog, *serviceerrors.ServiceError) {
+	pr, serr := findPullRequest(ctx, s.PRRepo, params.Repository, params.PullRequestId)
+	if serr != nil {
+		return nil, serr
+	}
+
+	repository := pr.RepositoryName()
+	return s.ListAudit(ctx, models.AuditFilter{PullRequestCustomID: pr.PullRequestCustomID, Repository: &repository}, params.Limit, params.Cursor)
+}
+
+func auditEntryResponse(e *models.AuditEntry) openapi.AuditEntry {
+	return openapi.AuditEntry{
+		Id:            e.ID,
+		Action:        openapi.AuditAction(e.Action),
+		Actor:         e.Actor,
+		At:            time.Unix(e.CreatedAt, 0).UTC(),
+		PullRequestId: ptr.Optional(e.PullRequestCustomID),
+		Repository:    ptr.Optional(e.Repository),
+		UserId:        ptr.Optional(e.UserCustomID),
+		OldReviewerId: ptr.Optional(e.OldReviewerID),
+		NewReviewerId: ptr.Optional(e.NewReviewerID),
+		TeamName:      ptr.Optional(e.TeamName),
+		Reason:        ptr.Optional(e.Reason),
+		Strategy:      ptr.Optional(e.Strategy),
+	}
+}
+
+func encodeAuditCursor(id int64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatInt(id, 10)))
+}
+
+func decodeAuditCursor(s string) (int64, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return 0, err
+	}
+	id, err := strconv.ParseInt(string(raw), 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	if id <= 0 {
+		return 0, errors.New("cursor without id")
+	}
+	return id, nil
+}
@@ -0,0 +1,297 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	openapi "github.com/wozhdeleniye/avito-tech-internship/api"
+	serviceerrors "github.com/wozhdeleniye/avito-tech-internship/internal/pkg/errors"
+	"github.com/wozhdeleniye/avito-tech-internship/internal/pkg/ptr"
+	"github.com/wozhdeleniye/avito-tech-internship/internal/repo/models"
+	postgresrepository "github.com/wozhdeleniye/avito-tech-internship/internal/repo/repositories/postgres_repository"
+	"gorm.io/gorm"
+)
+
+// просмотр и откат наборов изменений массовых операций; сами наборы пишут TeamService и JobService
+type ChangesetService struct {
+	ChangesetRepo *postgresrepository.ChangesetRepository
+	PRRepo        *postgresrepository.PReqRepository
+	UserRepo      *postgresrepository.UserRepository
+	Tx            *postgresrepository.TxManager
+	Audit         *AuditService
+	Events        *EventBus
+}
+
+func NewChangesetService(changesetRepo *postgresrepository.ChangesetRepository, prRepo *postgresrepository.PReqRepository, userRepo *postgresrepository.UserRepository, tx *postgresrepository.TxManager, audit *AuditService, events *EventBus) *ChangesetService {
+	return &ChangesetService{
+		ChangesetRepo: changesetRepo,
+		PRRepo:        prRepo,
+		UserRepo:      userRepo,
+		Tx:            tx,
+		Audit:         audit,
+		Events:        events,
+	}
+}
+
+func (s *ChangesetService) GetChangeset(ctx context.Context, id string) (*openapi.Changeset, *serviceerrors.ServiceError) {
+	changesetID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, serviceerrors.ErrChangesetNotFound
+	}
+	changeset, err := s.ChangesetRepo.GetChangeset(ctx, changesetID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, serviceerrors.ErrChangesetNotFound
+	}
+	if err != nil {
+		return nil, serviceerrors.ErrUnknown.Wrap(err)
+	}
+	entries, err := s.ChangesetRepo.ListEntries(ctx, changesetID)
+	if err != nil {
+		return nil, serviceerrors.ErrUnknown.Wrap(err)
+	}
+	resp := changesetResponse(changeset, entries)
+	return &resp, nil
+}
+
+// в одной транзакции активирует деактивированных пользователей и возвращает исходных ревьюверов
+// в открытые PR; замены, поверх которых состояние уже изменилось, попадают в conflicts
+func (s *ChangesetService) RevertChangeset(ctx context.Context, id string) (*openapi.ChangesetRevert, *serviceerrors.ServiceError) {
+	changesetID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, serviceerrors.ErrChangesetNotFound
+	}
+
+	resp := &openapi.ChangesetRevert{
+		ReactivatedUsers:  make([]string, 0),
+		RestoredReviewers: make([]openapi.Reassignment, 0),
+		Conflicts:         make([]openapi.ChangesetConflict, 0),
+	}
+	err = s.Tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		changeset, err := s.ChangesetRepo.GetChangesetForUpdate(ctx, changesetID)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return serviceerrors.ErrChangesetNotFound
+		}
+		if err != nil {
+			return err
+		}
+		if changeset.RevertedAt != nil {
+			return serviceerrors.ErrChangesetReverted
+		}
+		if changeset.CompletedAt == nil {
+			return serviceerrors.ErrChangesetInProgress
+		}
+
+		entries, err := s.ChangesetRepo.ListEntries(ctx, changesetID)
+		if err != nil {
+			return err
+		}
+		if err := s.reactivateUsers(ctx, changeset, entries, resp); err != nil {
+			return err
+		}
+		// замены откатываются в обратном порядке, чтобы цепочка A -> B -> C вернулась к A
+		for i := len(entries) - 1; i >= 0; i-- {
+			if entries[i].Kind != models.ChangeReviewerReassigned {
+				continue
+			}
+			if err := s.restoreReviewer(ctx, entries[i], resp); err != nil {
+				return err
+			}
+		}
+
+		now := time.Now().Unix()
+		changeset.RevertedAt = &now
+		changeset.RevertedBy = ActorFromContext(ctx)
+		if err := s.ChangesetRepo.UpdateChangeset(ctx, changeset); err != nil {
+			return err
+		}
+		if err := s.Audit.Record(ctx, &models.AuditEntry{
+			Action:   models.AuditChangesetReverted,
+			TeamName: changeset.TeamName,
+			Reason:   models.ReasonChangesetRevert,
+		}); err != nil {
+			return err
+		}
+		resp.Changeset = changesetResponse(changeset, entries)
+		return nil
+	})
+	if err != nil {
+		var serr *serviceerrors.ServiceError
+		if errors.As(err, &serr) {
+			return nil, serr
+		}
+		return nil, serviceerrors.ErrUnknown.Wrap(err)
+	}
+	return resp, nil
+}
+
+// активирует пользователей набора; пользователь, чью активность меняли после операции, не трогается
+// и попадает в conflicts с user_changed
+func (s *ChangesetService) reactivateUsers(ctx context.Context, changeset *models.Changeset, entries []*models.ChangesetEntry, resp *openapi.ChangesetRevert) error {
+	customIDs := make([]string, 0)
+	for _, e := range entries {
+		if e.Kind == models.ChangeUserDeactivated {
+			customIDs = append(customIDs, e.UserCustomID)
+		}
+	}
+	users, err := s.UserRepo.GetUsersByCustomIDs(ctx, customIDs)
+	if err != nil {
+		return err
+	}
+	changed, err := s.activityChangedSince(ctx, customIDs, changeset.CreatedAt)
+	if err != nil {
+		return err
+	}
+	byCustomID := make(map[string]*models.User, len(users))
+	for _, u := range users {
+		byCustomID[u.UserCustomID] = u
+	}
+
+	ids := make([]string, 0, len(users))
+	audit := make([]*models.AuditEntry, 0, len(users))
+	for _, customID := range customIDs {
+		u, ok := byCustomID[customID]
+		if !ok {
+			userID := customID
+			resp.Conflicts = append(resp.Conflicts, openapi.ChangesetConflict{UserId: &userID, Reason: openapi.UserNotFound})
+			continue
+		}
+		if changed[customID] {
+			userID := customID
+			resp.Conflicts = append(resp.Conflicts, openapi.ChangesetConflict{UserId: &userID, Reason: openapi.UserChanged})
+			continue
+		}
+		if u.IsActive {
+			continue
+		}
+		u.IsActive = true
+		ids = append(ids, u.ID.String())
+		audit = append(audit, activityEntry(u, models.ReasonChangesetRevert, changeset.TeamName))
+		resp.ReactivatedUsers = append(resp.ReactivatedUsers, customID)
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	if err := s.UserRepo.SetUsersActiveByIDs(ctx, ids, true); err != nil {
+		return err
+	}
+	return s.Audit.Record(ctx, audit...)
+}
+
+// пользователи, чью активность меняли с момента since помимо деактивации самим набором: по журналу аудита
+// первая запись окна - деактивация набором, любая запись после неё (или вместо неё) - чужое изменение
+func (s *ChangesetService) activityChangedSince(ctx context.Context, customIDs []string, since int64) (map[string]bool, error) {
+	entries, err := s.Audit.AuditRepo.ListUserActivity(ctx, customIDs, since)
+	if err != nil {
+		return nil, err
+	}
+	seen := make(map[string]bool, len(customIDs))
+	changed := make(map[string]bool)
+	for _, e := range entries {
+		if !seen[e.UserCustomID] && e.Action == models.AuditUserDeactivated {
+			seen[e.UserCustomID] = true
+			continue
+		}
+		seen[e.UserCustomID] = true
+		changed[e.UserCustomID] = true
+	}
+	return changed, nil
+}
+
+func (s *ChangesetService) restoreReviewer(ctx context.Context, e *models.ChangesetEntry, resp *openapi.ChangesetRevert) error {
+	conflict := func(reason openapi.ChangesetConflictReason) error {
+		resp.Conflicts = append(resp.Conflicts, openapi.ChangesetConflict{PullRequestId: &e.PullRequestCustomID, Repository: ptr.Optional(e.Repository), UserId: &e.UserCustomID, Reason: reason})
+		return nil
+	}
+
+	pr, err := s.PRRepo.GetPullRequest(ctx, e.Repository, e.PullRequestCustomID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return conflict(openapi.PrNotFound)
+	}
+	if err != nil {
+		return err
+	}
+	if pr.Status != "OPEN" {
+		return conflict(openapi.PrMerged)
+	}
+
+	idx := -1
+	for i, reviewer := range pr.AssignedReviewers {
+		if reviewer == nil {
+			continue
+		}
+		if reviewer.UserCustomID == e.UserCustomID {
+			return conflict(openapi.AlreadyAssigned)
+		}
+		if reviewer.UserCustomID == e.NewReviewerID {
+			idx = i
+		}
+	}
+	if idx < 0 {
+		return conflict(openapi.ReviewerChanged)
+	}
+
+	original, err := s.UserRepo.GetUserByCustomId(ctx, e.UserCustomID)
+	if err != nil {
+		return err
+	}
+	if original == nil {
+		return conflict(openapi.UserNotFound)
+	}
+
+	pr.AssignedReviewers[idx] = original
+	if err := s.PRRepo.UpdatePullRequest(ctx, pr); err != nil {
+		return err
+	}
+	if err := s.Audit.Record(ctx, &models.AuditEntry{
+		Action:              models.AuditReviewerReassigned,
+		PullRequestCustomID: pr.PullRequestCustomID,
+		Repository:          pr.RepositoryName(),
+		OldReviewerID:       e.NewReviewerID,
+		NewReviewerID:       e.UserCustomID,
+		Reason:              models.ReasonChangesetRevert,
+	}); err != nil {
+		return err
+	}
+	s.Events.PublishAfterCommit(ctx, reassignmentEvents(pr, e.NewReviewerID, e.UserCustomID, "", models.ReasonChangesetRevert)...)
+	resp.RestoredReviewers = append(resp.RestoredReviewers, openapi.Reassignment{
+		PullRequestId: pr.PullRequestCustomID,
+		Repository:    ptr.Optional(pr.RepositoryName()),
+		OldReviewerId: e.NewReviewerID,
+		NewReviewerId: e.UserCustomID,
+	})
+	return nil
+}
+
+func changesetResponse(changeset *models.Changeset, entries []*models.ChangesetEntry) openapi.Changeset {
+	resp := openapi.Changeset{
+		Id:               changeset.ID.String(),
+		Kind:             openapi.ChangesetKind(changeset.Kind),
+		Actor:            changeset.Actor,
+		TeamName:         ptr.Optional(changeset.TeamName),
+		Complete:         changeset.CompletedAt != nil,
+		CreatedAt:        time.Unix(changeset.CreatedAt, 0).UTC(),
+		RevertedBy:       ptr.Optional(changeset.RevertedBy),
+		DeactivatedUsers: make([]string, 0),
+		Reassignments:    make([]openapi.Reassignment, 0),
+	}
+	if changeset.RevertedAt != nil {
+		t := time.Unix(*changeset.RevertedAt, 0).UTC()
+		resp.RevertedAt = &t
+	}
+	for _, e := range entries {
+		switch e.Kind {
+		case models.ChangeUserDeactivated:
+			resp.DeactivatedUsers = append(resp.DeactivatedUsers, e.UserCustomID)
+		case models.ChangeReviewerReassigned:
+			resp.Reassignments = append(resp.Reassignments, openapi.Reassignment{
+				PullRequestId: e.PullRequestCustomID,
+				Repository:    ptr.Optional(e.Repository),
+				OldReviewerId: e.UserCustomID,
+				NewReviewerId: e.NewReviewerID,
+			})
+		}
+	}
+	return resp
+}
@@ -0,0 +1,247 @@
+package services
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/wozhdeleniye/avito-tech-internship/internal/repo/models"
+	postgresrepository "github.com/wozhdeleniye/avito-tech-internship/internal/repo/repositories/postgres_repository"
+)
+
+// типы событий потока /events/stream
+const (
+	EventReviewerAssigned       = "reviewer.assigned"
+	EventReviewerReassignedAway = "reviewer.reassigned_away"
+	EventPullRequestMerged      = "pull_request.merged"
+	EventSLAReminder            = "sla.reminder"
+)
+
+// событие о назначениях: Users - кого оно касается (ревьювер, для merge - автор и ревьюверы),
+// TeamName - команда, из которой назначаются ревьюверы PR
+type Event struct {
+	ID              string
+	Type            string
+	OrganizationID  uuid.UUID
+	Users           []string
+	TeamName        string
+	PullRequestID   string
+	Repository      string
+	PullRequestName string
+	AuthorID        string
+	ReviewerID      string
+	Replaces        string
+	ReplacedBy      string
+	Reviewers       []string
+	Reason          string
+	Deadline        *time.Time
+	At              time.Time
+}
+
+// подписка на события пользователя или команды; пустое поле не ограничивает, совпадение любого - достаточно
+type EventFilter struct {
+	UserID   string
+	TeamName string
+}
+
+func (f EventFilter) match(org uuid.UUID, e *Event) bool {
+	if e.OrganizationID != org {
+		return false
+	}
+	return (f.UserID != "" && slices.Contains(e.Users, f.UserID)) || (f.TeamName != "" && f.TeamName == e.TeamName)
+}
+
+// размер буфера подписчика; не успевающий читать подписчик отключается и продолжает по Last-Event-ID
+const subscriberBuffer = 64
+
+// pub/sub событий в памяти процесса. Последние события хранятся в кольцевом буфере, чтобы переподключившийся
+// клиент получил пропущенное по Last-Event-ID. Идентификатор - "<эпоха процесса>-<номер>", поэтому
+// идентификатор до перезапуска сервиса не спутать с новым
+type EventBus struct {
+	mu     sync.Mutex
+	epoch  string
+	seq    uint64
+	buffer []Event
+	size   int
+	subs   map[*Subscription]struct{}
+	closed bool
+}
+
+func NewEventBus(bufferSize int) *EventBus {
+	return &EventBus{
+		epoch: strconv.FormatInt(time.Now().UnixNano(), 36),
+		size:  max(bufferSize, 1),
+		subs:  map[*Subscription]struct{}{},
+	}
+}
+
+type Subscription struct {
+	bus    *EventBus
+	org    uuid.UUID
+	filter EventFilter
+	ch     chan Event
+}
+
+// события подписки; канал закрывается при Close, остановке шины или если подписчик не успевает читать
+func (s *Subscription) Events() <-chan Event {
+	return s.ch
+}
+
+func (s *Subscription) Close() {
+	s.bus.mu.Lock()
+	defer s.bus.mu.Unlock()
+	s.bus.unsubscribe(s)
+}
+
+// подписывает на события организации из ctx. Для lastEventID возвращаются пропущенные события из буфера;
+// resumed false - продолжить с lastEventID нельзя (он старше буфера или из прошлого запуска), клиенту стоит
+// перечитать состояние
+func (b *EventBus) Subscribe(ctx context.Context, filter EventFilter, lastEventID string) (sub *Subscription, missed []Event, resumed bool) {
+	sub = &Subscription{bus: b, org: postgresrepository.OrganizationID(ctx), filter: filter, ch: make(chan Event, subscriberBuffer)}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		close(sub.ch)
+		return sub, nil, true
+	}
+	b.subs[sub] = struct{}{}
+
+	if lastEventID == "" {
+		return sub, nil, true
+	}
+	epoch, seqStr, _ := strings.Cut(lastEventID, "-")
+	seq, err := strconv.ParseUint(seqStr, 10, 64)
+	if err != nil || epoch != b.epoch || seq > b.seq {
+		return sub, nil, false
+	}
+	// буфер хранит события с номерами b.seq-len+1 .. b.seq
+	if seq < b.seq-uint64(len(b.buffer)) {
+		return sub, nil, false
+	}
+	for _, e := range b.buffer[len(b.buffer)-int(b.seq-seq):] {
+		if filter.match(sub.org, &e) {
+			missed = append(missed, e)
+		}
+	}
+	return sub, missed, true
+}
+
+// раздаёт события подписчикам; вызывается после фиксации изменений
+func (b *EventBus) Publish(events ...Event) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		return
+	}
+	for _, e := range events {
+		b.seq++
+		e.ID = b.epoch + "-" + strconv.FormatUint(b.seq, 10)
+		if len(b.buffer) == b.size {
+			b.buffer = append(b.buffer[:0], b.buffer[1:]...)
+		}
+		b.buffer = append(b.buffer, e)
+
+		for sub := range b.subs {
+			if !sub.filter.match(sub.org, &e) {
+				continue
+			}
+			select {
+			case sub.ch <- e:
+			default:
+				b.unsubscribe(sub)
+			}
+		}
+	}
+}
+
+// публикует события после фиксации транзакции из ctx, при откате они пропадают вместе с изменениями
+func (b *EventBus) PublishAfterCommit(ctx context.Context, events ...Event) {
+	if b == nil || len(events) == 0 {
+		return
+	}
+	org := postgresrepository.OrganizationID(ctx)
+	now := time.Now().UTC()
+	for i := range events {
+		events[i].OrganizationID = org
+		events[i].At = now
+	}
+	postgresrepository.AfterCommit(ctx, func() { b.Publish(events...) })
+}
+
+// закрывает все подписки, чтобы потоки SSE завершились при остановке сервиса
+func (b *EventBus) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.closed = true
+	for sub := range b.subs {
+		b.unsubscribe(sub)
+	}
+}
+
+func (b *EventBus) unsubscribe(sub *Subscription) {
+	if _, ok := b.subs[sub]; !ok {
+		return
+	}
+	delete(b.subs, sub)
+	close(sub.ch)
+}
+
+func pullRequestEvent(typ string, pr *models.PullRequest, team string) Event {
+	return Event{
+		Type:            typ,
+		TeamName:        team,
+		PullRequestID:   pr.PullRequestCustomID,
+		Repository:      pr.RepositoryName(),
+		PullRequestName: pr.PullRequestName,
+		AuthorID:        pr.Author.UserCustomID,
+	}
+}
+
+func reviewerAssignedEvent(pr *models.PullRequest, reviewer, team, reason string) Event {
+	e := pullRequestEvent(EventReviewerAssigned, pr, team)
+	e.Users = []string{reviewer}
+	e.ReviewerID = reviewer
+	e.Reason = reason
+	return e
+}
+
+// замена ревьювера: новому - назначение, старому - снятие с ревью
+func reassignmentEvents(pr *models.PullRequest, oldReviewer, newReviewer, team, reason string) []Event {
+	assigned := reviewerAssignedEvent(pr, newReviewer, team, reason)
+	assigned.Replaces = oldReviewer
+
+	away := pullRequestEvent(EventReviewerReassignedAway, pr, team)
+	away.Users = []string{oldReviewer}
+	away.ReviewerID = oldReviewer
+	away.ReplacedBy = newReviewer
+	away.Reason = reason
+	return []Event{assigned, away}
+}
+
+func pullRequestMergedEvent(pr *models.PullRequest, team string) Event {
+	e := pullRequestEvent(EventPullRequestMerged, pr, team)
+	e.Users = append(e.Users, pr.Author.UserCustomID)
+	for _, r := range pr.AssignedReviewers {
+		if r != nil {
+			e.Reviewers = append(e.Reviewers, r.UserCustomID)
+			e.Users = append(e.Users, r.UserCustomID)
+		}
+	}
+	return e
+}
+
+// напоминание ревьюверу, не ответившему на PR до срока SLA
+func slaReminderEvent(pr *models.PullRequest, breach *models.SLABreach) Event {
+	e := pullRequestEvent(EventSLAReminder, pr, breach.TeamName)
+	e.Users = []string{breach.ReviewerCustomID}
+	e.ReviewerID = breach.ReviewerCustomID
+	e.Reason = models.ReasonSLABreach
+	deadline := time.Unix(breach.Deadline, 0).UTC()
+	e.Deadline = &deadline
+	return e
+}
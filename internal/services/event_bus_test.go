@@ -0,0 +1,101 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/wozhdeleniye/avito-tech-internship/internal/repo/models"
+)
+
+func assigned(user, team string) Event {
+	return Event{Type: EventReviewerAssigned, OrganizationID: models.DefaultOrganizationID, Users: []string{user}, TeamName: team}
+}
+
+func receive(t *testing.T, sub *Subscription) Event {
+	t.Helper()
+	select {
+	case e := <-sub.Events():
+		return e
+	default:
+		t.Fatal("ожидалось событие")
+		return Event{}
+	}
+}
+
+func TestEventBusFiltersByUserTeamAndOrganization(t *testing.T) {
+	bus := NewEventBus(10)
+	ctx := context.Background()
+	byUser, _, _ := bus.Subscribe(ctx, EventFilter{UserID: "u1"}, "")
+	byTeam, _, _ := bus.Subscribe(ctx, EventFilter{TeamName: "backend"}, "")
+	other, _, _ := bus.Subscribe(WithOrganization(ctx, uuid.New()), EventFilter{UserID: "u1"}, "")
+
+	bus.Publish(assigned("u1", "frontend"), assigned("u2", "backend"))
+
+	if e := receive(t, byUser); e.Users[0] != "u1" || e.ID == "" {
+		t.Fatalf("подписка пользователя получила %+v", e)
+	}
+	if e := receive(t, byTeam); e.Users[0] != "u2" {
+		t.Fatalf("подписка команды получила %+v", e)
+	}
+	if len(byUser.Events()) != 0 || len(byTeam.Events()) != 0 || len(other.Events()) != 0 {
+		t.Fatal("событие не должно приходить подписчикам с другим фильтром или из другой организации")
+	}
+}
+
+func TestEventBusResumesFromLastEventID(t *testing.T) {
+	bus := NewEventBus(3)
+	ctx := context.Background()
+	first, _, _ := bus.Subscribe(ctx, EventFilter{UserID: "u1"}, "")
+	bus.Publish(assigned("u1", ""), assigned("u2", ""), assigned("u1", ""))
+	lastSeen := receive(t, first).ID
+	first.Close()
+	// канал закрыт, непрочитанные события остаются в нём до конца
+	if e, ok := <-first.Events(); !ok || e.Users[0] != "u1" {
+		t.Fatalf("ожидалось непрочитанное событие u1, получено %+v", e)
+	}
+	if _, ok := <-first.Events(); ok {
+		t.Fatal("закрытая подписка должна закрывать канал")
+	}
+
+	sub, missed, resumed := bus.Subscribe(ctx, EventFilter{UserID: "u1"}, lastSeen)
+	defer sub.Close()
+	if !resumed || len(missed) != 1 || missed[0].Users[0] != "u1" || missed[0].ID == lastSeen {
+		t.Fatalf("ожидалось одно пропущенное событие u1, получено %+v, %v", missed, resumed)
+	}
+
+	bus.Publish(assigned("u3", ""), assigned("u3", ""))
+	if _, missed, resumed := bus.Subscribe(ctx, EventFilter{UserID: "u1"}, lastSeen); resumed || len(missed) != 0 {
+		t.Fatalf("идентификатор старше буфера не должен продолжаться, получено %+v, %v", missed, resumed)
+	}
+	if _, _, resumed := bus.Subscribe(ctx, EventFilter{UserID: "u1"}, "previous-1"); resumed {
+		t.Fatal("идентификатор прошлого запуска не должен продолжаться")
+	}
+}
+
+func TestEventBusDropsSlowSubscriberAndCloses(t *testing.T) {
+	bus := NewEventBus(10)
+	ctx := context.Background()
+	slow, _, _ := bus.Subscribe(ctx, EventFilter{UserID: "u1"}, "")
+	idle, _, _ := bus.Subscribe(ctx, EventFilter{UserID: "u2"}, "")
+
+	for i := 0; i <= subscriberBuffer; i++ {
+		bus.Publish(assigned("u1", ""))
+	}
+	for range subscriberBuffer {
+		receive(t, slow)
+	}
+	if _, ok := <-slow.Events(); ok {
+		t.Fatal("переполненная подписка должна закрываться")
+	}
+
+	bus.Close()
+	if _, ok := <-idle.Events(); ok {
+		t.Fatal("остановка шины должна закрывать подписки")
+	}
+	if sub, _, _ := bus.Subscribe(ctx, EventFilter{UserID: "u1"}, ""); sub != nil {
+		if _, ok := <-sub.Events(); ok {
+			t.Fatal("подписка на остановленную шину должна быть закрыта")
+		}
+	}
+}
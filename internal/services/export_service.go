@@ -0,0 +1,142 @@
+package services
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	serviceerrors "github.com/wozhdeleniye/avito-tech-internship/internal/pkg/errors"
+	"github.com/wozhdeleniye/avito-tech-internship/internal/repo/models"
+	postgresrepository "github.com/wozhdeleniye/avito-tech-internship/internal/repo/repositories/postgres_repository"
+)
+
+// выгрузки для таблиц: строки передаются в emit по мере чтения из БД, без загрузки выборки в память
+type ExportService struct {
+	PRRepo    *postgresrepository.PReqRepository
+	AuditRepo *postgresrepository.AuditRepository
+	SLARepo   *postgresrepository.SLARepository
+	Stats     *StatsService
+}
+
+func NewExportService(prRepo *postgresrepository.PReqRepository, auditRepo *postgresrepository.AuditRepository, slaRepo *postgresrepository.SLARepository, stats *StatsService) *ExportService {
+	return &ExportService{
+		PRRepo:    prRepo,
+		AuditRepo: auditRepo,
+		SLARepo:   slaRepo,
+		Stats:     stats,
+	}
+}
+
+func (s *ExportService) ExportPullRequests(ctx context.Context, filter models.PullRequestFilter, emit func(models.ExportRow) error) *serviceerrors.ServiceError {
+	err := s.PRRepo.StreamPullRequests(ctx, filter, func(row *models.PullRequestExportRow) error {
+		return emit(row)
+	})
+	if err != nil {
+		return serviceerrors.ErrUnknown.Wrap(err)
+	}
+	return nil
+}
+
+func (s *ExportService) ExportAssignments(ctx context.Context, filter models.PullRequestFilter, emit func(models.ExportRow) error) *serviceerrors.ServiceError {
+	err := s.PRRepo.StreamReviewerAssignments(ctx, filter, func(row *models.AssignmentExportRow) error {
+		return emit(row)
+	})
+	if err != nil {
+		return serviceerrors.ErrUnknown.Wrap(err)
+	}
+	return nil
+}
+
+func (s *ExportService) ExportAudit(ctx context.Context, filter models.AuditFilter, emit func(models.ExportRow) error) *serviceerrors.ServiceError {
+	err := s.AuditRepo.Stream(ctx, filter, func(e *models.AuditEntry) error {
+		return emit(models.NewAuditExportRow(e))
+	})
+	if err != nil {
+		return serviceerrors.ErrUnknown.Wrap(err)
+	}
+	return nil
+}
+
+// агрегаты из /admin/stats в длинном формате; метрики по времени считаются за [from, to)
+func (s *ExportService) ExportStats(ctx context.Context, from, to *time.Time, emit func(models.ExportRow) error) *serviceerrors.ServiceError {
+	perUser, err := s.PRRepo.CountAssignmentsPerUser(ctx)
+	if err != nil {
+		return serviceerrors.ErrUnknown.Wrap(err)
+	}
+	perPR, err := s.PRRepo.CountAssignmentsPerPR(ctx)
+	if err != nil {
+		return serviceerrors.ErrUnknown.Wrap(err)
+	}
+	breaches, err := s.SLARepo.CountBreachesPerTeam(ctx)
+	if err != nil {
+		return serviceerrors.ErrUnknown.Wrap(err)
+	}
+	stats, serr := s.Stats.ReviewStats(ctx, from, to)
+	if serr != nil {
+		return serr
+	}
+
+	for _, row := range statRows(perUser, perPR, breaches, stats) {
+		if err := emit(row); err != nil {
+			return serviceerrors.ErrUnknown.Wrap(err)
+		}
+	}
+	return nil
+}
+
+// строки статистики в стабильном порядке: по метрике, затем по ключу
+func statRows(perUser, perPR, breaches map[string]int64, stats *models.ReviewStats) []*models.StatExportRow {
+	rows := make([]*models.StatExportRow, 0)
+
+	counts := func(metric, dimension string, values map[string]int64) {
+		for _, key := range sortedKeys(values) {
+			rows = append(rows, &models.StatExportRow{Metric: metric, Dimension: dimension, Key: key, Value: float64(values[key])})
+		}
+	}
+	counts("assignments", "user", perUser)
+	counts("assignments", "pull_request", perPR)
+	counts("sla_breaches", "team", breaches)
+
+	durations := func(dimension string, values map[string]models.DurationPercentiles) {
+		for _, key := range sortedKeys(values) {
+			p := values[key]
+			rows = append(rows,
+				&models.StatExportRow{Metric: "time_to_merge_count", Dimension: dimension, Key: key, Value: float64(p.Count)},
+				&models.StatExportRow{Metric: "time_to_merge_p50_hours", Dimension: dimension, Key: key, Value: p.P50Hours},
+				&models.StatExportRow{Metric: "time_to_merge_p90_hours", Dimension: dimension, Key: key, Value: p.P90Hours},
+				&models.StatExportRow{Metric: "time_to_merge_p99_hours", Dimension: dimension, Key: key, Value: p.P99Hours},
+			)
+		}
+	}
+	durations("team", stats.TimeToMergePerTeam)
+	durations("author", stats.TimeToMergePerAuthor)
+
+	for _, reviewer := range sortedKeys(stats.ReviewerThroughput) {
+		weeks := stats.ReviewerThroughput[reviewer]
+		for _, week := range sortedKeys(weeks) {
+			rows = append(rows, &models.StatExportRow{Metric: "reviewer_throughput", Dimension: "reviewer", Key: reviewer, WeekStart: week, Value: float64(weeks[week])})
+		}
+	}
+
+	for _, bucket := range openPRAgeBuckets {
+		rows = append(rows, &models.StatExportRow{Metric: "open_pr_age", Dimension: "age_bucket", Key: bucket.Name, Value: float64(stats.OpenPRAge[bucket.Name])})
+	}
+
+	for _, point := range stats.ReassignmentTrend {
+		rows = append(rows,
+			&models.StatExportRow{Metric: "reassignments", Dimension: "week", Key: point.WeekStart, WeekStart: point.WeekStart, Value: float64(point.Reassignments)},
+			&models.StatExportRow{Metric: "pull_requests_created", Dimension: "week", Key: point.WeekStart, WeekStart: point.WeekStart, Value: float64(point.PullRequestsCreated)},
+			&models.StatExportRow{Metric: "reassignments_per_pr", Dimension: "week", Key: point.WeekStart, WeekStart: point.WeekStart, Value: point.ReassignmentsPerPR},
+		)
+	}
+	return rows
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
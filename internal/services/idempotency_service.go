@@ -0,0 +1,143 @@
+package services
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"github.com/wozhdeleniye/avito-tech-internship/internal/pkg/clock"
+	serviceerrors "github.com/wozhdeleniye/avito-tech-internship/internal/pkg/errors"
+	"github.com/wozhdeleniye/avito-tech-internship/internal/repo/models"
+	postgresrepository "github.com/wozhdeleniye/avito-tech-internship/internal/repo/repositories/postgres_repository"
+	"gorm.io/gorm"
+)
+
+const (
+	defaultIdempotencyTTL     = 24 * time.Hour
+	defaultIdempotencyLockTTL = 2 * time.Minute
+)
+
+// ключи идемпотентности POST-запросов: первый ответ хранится TTL и воспроизводится для повторов.
+// Ключ без ответа держится LockTTL: если реплика упала посреди запроса, повтор после LockTTL выполнит его заново
+type IdempotencyService struct {
+	IdempotencyRepo *postgresrepository.IdempotencyRepository
+	TTL             time.Duration
+	LockTTL         time.Duration
+	Clock           clock.Clock
+}
+
+func NewIdempotencyService(idempotencyRepo *postgresrepository.IdempotencyRepository, ttl time.Duration, lockTTL time.Duration, clk clock.Clock) *IdempotencyService {
+	if ttl <= 0 {
+		ttl = defaultIdempotencyTTL
+	}
+	if lockTTL <= 0 {
+		lockTTL = defaultIdempotencyLockTTL
+	}
+	return &IdempotencyService{
+		IdempotencyRepo: idempotencyRepo,
+		TTL:             ttl,
+		LockTTL:         lockTTL,
+		Clock:           clk,
+	}
+}
+
+// клиент, в пределах которого действует ключ: хеш токена доступа, иначе пользователя из User-id.
+// Запросы без того и другого делят ключи организации
+func IdempotencyClient(token string, userID string) string {
+	var client string
+	switch {
+	case token != "":
+		client = "token:" + token
+	case userID != "":
+		client = "user:" + userID
+	default:
+		return ""
+	}
+	sum := sha256.Sum256([]byte(client))
+	return hex.EncodeToString(sum[:16])
+}
+
+// отпечаток запроса, с которым связывается ключ: метод, путь с query и тело
+func RequestHash(method string, uri string, body []byte) string {
+	h := sha256.New()
+	h.Write([]byte(method + " " + uri + "\n"))
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// занимает ключ клиента под запрос; если ключ уже использован тем же запросом, возвращает сохранённый ответ,
+// который нужно воспроизвести вместо выполнения. Ключ с другим запросом - ErrIdempotencyKeyReused,
+// ключ, запрос по которому ещё выполняется, - ErrIdempotencyInProgress
+func (s *IdempotencyService) Begin(ctx context.Context, client string, key string, hash string) (*models.IdempotencyRecord, *serviceerrors.ServiceError) {
+	now := s.Clock.Now()
+	if err := s.IdempotencyRepo.DeleteExpired(ctx, now.Unix()); err != nil {
+		return nil, serviceerrors.ErrUnknown.Wrap(err)
+	}
+
+	// ключ может освободиться между попыткой занять его и чтением, тогда пробуем ещё раз
+	for attempt := 0; attempt < 2; attempt++ {
+		reserved, err := s.IdempotencyRepo.Reserve(ctx, &models.IdempotencyRecord{
+			Client:         client,
+			IdempotencyKey: key,
+			RequestHash:    hash,
+			LockedUntil:    now.Add(s.LockTTL).Unix(),
+			CreatedAt:      now.Unix(),
+			ExpiresAt:      now.Add(s.TTL).Unix(),
+		})
+		if err != nil {
+			return nil, serviceerrors.ErrUnknown.Wrap(err)
+		}
+		if reserved {
+			return nil, nil
+		}
+
+		record, err := s.IdempotencyRepo.Find(ctx, client, key)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, serviceerrors.ErrUnknown.Wrap(err)
+		}
+		if record.RequestHash != hash {
+			return nil, serviceerrors.ErrIdempotencyKeyReused
+		}
+		if record.Completed() {
+			return record, nil
+		}
+		if record.LockedUntil > now.Unix() {
+			return nil, serviceerrors.ErrIdempotencyInProgress
+		}
+		// первый запрос оборвался, не сохранив ответ и не освободив ключ
+		record.LockedUntil = now.Add(s.LockTTL).Unix()
+		taken, err := s.IdempotencyRepo.TakeOver(ctx, record, now.Unix())
+		if err != nil {
+			return nil, serviceerrors.ErrUnknown.Wrap(err)
+		}
+		if taken {
+			return nil, nil
+		}
+	}
+	return nil, serviceerrors.ErrIdempotencyInProgress
+}
+
+// сохраняет ответ для повторов; 5xx не сохраняется, ключ освобождается, и повтор выполнит запрос заново
+func (s *IdempotencyService) Complete(ctx context.Context, client string, key string, status int, contentType string, location string, body []byte) error {
+	if status >= 500 {
+		return s.Abandon(ctx, client, key)
+	}
+	return s.IdempotencyRepo.SaveResponse(ctx, &models.IdempotencyRecord{
+		Client:         client,
+		IdempotencyKey: key,
+		StatusCode:     status,
+		ContentType:    contentType,
+		Location:       location,
+		Body:           body,
+	})
+}
+
+// освобождает ключ запроса, который не дошёл до ответа
+func (s *IdempotencyService) Abandon(ctx context.Context, client string, key string) error {
+	return s.IdempotencyRepo.Delete(ctx, client, key)
+}
@@ -0,0 +1,149 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	openapi "github.com/wozhdeleniye/avito-tech-internship/api"
+	serviceerrors "github.com/wozhdeleniye/avito-tech-internship/internal/pkg/errors"
+	"github.com/wozhdeleniye/avito-tech-internship/internal/repo/models"
+	postgresrepository "github.com/wozhdeleniye/avito-tech-internship/internal/repo/repositories/postgres_repository"
+)
+
+type ImportService struct {
+	TeamService *TeamService
+	TeamRepo    *postgresrepository.TeamRepository
+	Tx          *postgresrepository.TxManager
+}
+
+func NewImportService(teamService *TeamService, teamRepo *postgresrepository.TeamRepository, tx *postgresrepository.TxManager) *ImportService {
+	return &ImportService{
+		TeamService: teamService,
+		TeamRepo:    teamRepo,
+		Tx:          tx,
+	}
+}
+
+// массовый импорт файла организации. Файл проверяется целиком до применения: при любой ошибке в строках
+// ничего не сохраняется и отчёт содержит только ошибки. Команды применяются по порядку в одной транзакции
+// через ту же синхронизацию, что и /team/add, без исключения неперечисленных участников;
+// при dryRun транзакция откатывается, а отчёт показывает, что изменилось бы
+func (s *ImportService) Import(ctx context.Context, data []byte, format string, dryRun bool) (*models.ImportReport, *serviceerrors.ServiceError) {
+	file, invalid, err := ParseOrgFile(data, format)
+	if err != nil {
+		return nil, serviceerrors.ErrInvalidImportFile.WithMessage(err.Error())
+	}
+	invalid = append(invalid, ValidateOrgFile(file)...)
+
+	report := &models.ImportReport{DryRun: dryRun, Rows: make([]models.ImportRowResult, 0)}
+	if len(invalid) > 0 {
+		sort.SliceStable(invalid, func(i, j int) bool { return invalid[i].Row < invalid[j].Row })
+		report.Rows = invalid
+		report.Summary.Errors = len(invalid)
+		return report, nil
+	}
+
+	err = s.Tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		for _, team := range file.Teams {
+			if err := s.importTeam(ctx, team, report); err != nil {
+				return err
+			}
+		}
+		if dryRun {
+			return errDryRun
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, errDryRun) {
+		var serr *serviceerrors.ServiceError
+		if errors.As(err, &serr) {
+			return nil, serr
+		}
+		return nil, serviceerrors.ErrUnknown.Wrap(err)
+	}
+
+	report.Applied = !dryRun
+	return report, nil
+}
+
+func (s *ImportService) importTeam(ctx context.Context, team *models.OrgTeam, report *models.ImportReport) error {
+	req := openapi.Team{TeamName: team.TeamName, Members: make([]openapi.TeamMember, 0, len(team.Members))}
+	for _, m := range team.Members {
+		member := openapi.TeamMember{UserId: m.UserID, Username: m.Username, IsActive: m.IsActive}
+		if m.Role != "" {
+			role := openapi.TeamMemberRole(m.Role)
+			member.Role = &role
+		}
+		req.Members = append(req.Members, member)
+	}
+
+	synced, serr := s.TeamService.SyncTeam(ctx, req, false, false)
+	if serr != nil {
+		return serr
+	}
+	diff := synced.Diff
+
+	teamRow := models.ImportRowResult{Row: team.Row, Kind: models.ImportRowTeam, TeamName: team.TeamName, Result: models.ImportUnchanged}
+	if diff.TeamCreated {
+		teamRow.Result = models.ImportCreated
+		report.Summary.TeamsCreated++
+	}
+	if team.SLA != nil && slaChanged(synced.Team.Sla, team.SLA) {
+		current, err := s.TeamRepo.FindTeamByName(ctx, team.TeamName)
+		if err != nil {
+			return err
+		}
+		if err := s.TeamRepo.SetSLAPolicy(ctx, current, team.SLA.FirstReviewHours, team.SLA.Action); err != nil {
+			return err
+		}
+		teamRow.ChangedFields = []string{"sla"}
+		if !diff.TeamCreated {
+			teamRow.Result = models.ImportUpdated
+			report.Summary.TeamsUpdated++
+		}
+	}
+	report.Rows = append(report.Rows, teamRow)
+
+	created := make(map[string]bool, len(diff.Created))
+	for _, id := range diff.Created {
+		created[id] = true
+	}
+	attached := make(map[string]bool, len(diff.Attached))
+	for _, id := range diff.Attached {
+		attached[id] = true
+	}
+	updated := make(map[string][]string, len(diff.Updated))
+	for _, u := range diff.Updated {
+		fields := make([]string, 0, len(u.ChangedFields))
+		for _, f := range u.ChangedFields {
+			fields = append(fields, string(f))
+		}
+		updated[u.UserId] = fields
+	}
+
+	for _, m := range team.Members {
+		row := models.ImportRowResult{Row: m.Row, Kind: models.ImportRowMember, TeamName: team.TeamName, UserID: m.UserID, Result: models.ImportUnchanged}
+		switch {
+		case created[m.UserID]:
+			row.Result = models.ImportCreated
+		case len(updated[m.UserID]) > 0:
+			row.Result, row.ChangedFields = models.ImportUpdated, updated[m.UserID]
+		case attached[m.UserID]:
+			row.Result = models.ImportAttached
+		}
+		report.Rows = append(report.Rows, row)
+	}
+	report.Summary.UsersCreated += len(diff.Created)
+	report.Summary.UsersUpdated += len(diff.Updated)
+	report.Summary.MembershipsAdded += len(diff.Attached)
+	return nil
+}
+
+// SLA с нулевым сроком отключён, действие для него не сравнивается
+func slaChanged(current *openapi.TeamSla, want *models.OrgTeamSLA) bool {
+	if current == nil {
+		return want.FirstReviewHours > 0
+	}
+	return current.FirstReviewHours != want.FirstReviewHours || (want.FirstReviewHours > 0 && string(current.Action) != want.Action)
+}
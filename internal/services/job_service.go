@@ -0,0 +1,553 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	openapi "github.com/wozhdeleniye/avito-tech-internship/api"
+	serviceerrors "github.com/wozhdeleniye/avito-tech-internship/internal/pkg/errors"
+	"github.com/wozhdeleniye/avito-tech-internship/internal/pkg/ptr"
+	"github.com/wozhdeleniye/avito-tech-internship/internal/repo/models"
+	postgresrepository "github.com/wozhdeleniye/avito-tech-internship/internal/repo/repositories/postgres_repository"
+	"gorm.io/gorm"
+)
+
+const defaultJobBatchSize = 50
+
+// аренда задачи продлевается с каждой пачкой; задачу упавшей реплики подхватывает другая по истечении аренды
+const jobLeaseTTL = 2 * time.Minute
+
+// фоновые задачи: план сохраняется при постановке, Run обрабатывает его пачками,
+// каждая пачка вместе с прогрессом задачи - в одной транзакции.
+// Несколько реплик не обработают одну задачу дважды: задачу ведёт реплика, взявшая аренду
+type JobService struct {
+	JobRepo          *postgresrepository.JobRepository
+	OrganizationRepo *postgresrepository.OrganizationRepository
+	PRRepo           *postgresrepository.PReqRepository
+	TeamRepo         *postgresrepository.TeamRepository
+	UserRepo         *postgresrepository.UserRepository
+	ChangesetRepo    *postgresrepository.ChangesetRepository
+	Tx               *postgresrepository.TxManager
+	Audit            *AuditService
+	Events           *EventBus
+	BatchSize        int
+
+	// идентификатор реплики в аренде задач
+	owner string
+	wake  chan struct{}
+}
+
+func NewJobService(jobRepo *postgresrepository.JobRepository, organizationRepo *postgresrepository.OrganizationRepository, prRepo *postgresrepository.PReqRepository, teamRepo *postgresrepository.TeamRepository, userRepo *postgresrepository.UserRepository, changesetRepo *postgresrepository.ChangesetRepository, tx *postgresrepository.TxManager, audit *AuditService, events *EventBus, batchSize int) *JobService {
+	if batchSize <= 0 {
+		batchSize = defaultJobBatchSize
+	}
+	return &JobService{
+		JobRepo:          jobRepo,
+		OrganizationRepo: organizationRepo,
+		PRRepo:           prRepo,
+		TeamRepo:         teamRepo,
+		UserRepo:         userRepo,
+		ChangesetRepo:    changesetRepo,
+		Tx:               tx,
+		Audit:            audit,
+		Events:           events,
+		BatchSize:        batchSize,
+		owner:            uuid.NewString(),
+		wake:             make(chan struct{}, 1),
+	}
+}
+
+// ставит в очередь массовую деактивацию: участники, для которых oldTeamName основная, деактивируются,
+// их ревью в открытых PR переназначаются на участников newTeamName. Команды проверяются сразу, остальное делает Run
+func (s *JobService) SubmitMassDeactivation(ctx context.Context, oldTeamName string, newTeamName string, dryRun bool) (*openapi.Job, *serviceerrors.ServiceError) {
+	oldTeam, err := s.TeamRepo.FindTeamByName(ctx, oldTeamName)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, serviceerrors.ErrTeamNotFound
+	}
+	if err != nil {
+		return nil, serviceerrors.ErrUnknown.Wrap(err)
+	}
+
+	newTeam, err := s.TeamRepo.FindTeamByName(ctx, newTeamName)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, serviceerrors.ErrTeamNotFound
+	}
+	if err != nil {
+		return nil, serviceerrors.ErrUnknown.Wrap(err)
+	}
+	if len(newTeam.Members) == 0 {
+		return nil, serviceerrors.ErrTeamNotFound
+	}
+
+	items, err := s.planMassDeactivation(ctx, oldTeam)
+	if err != nil {
+		return nil, serviceerrors.ErrUnknown.Wrap(err)
+	}
+
+	job := &models.Job{
+		Kind:        models.JobKindMassDeactivation,
+		Status:      models.JobPending,
+		DryRun:      dryRun,
+		Actor:       ActorFromContext(ctx),
+		OldTeamName: oldTeam.TeamName,
+		NewTeamName: newTeam.TeamName,
+	}
+	for _, item := range items {
+		if item.Outcome == "" {
+			job.Total++
+		}
+	}
+	// изменения задачи пишутся в набор по мере применения пачек; у dry run набора нет
+	err = s.Tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		if !dryRun {
+			changeset := &models.Changeset{Kind: models.ChangesetMassDeactivation, Actor: job.Actor, TeamName: job.OldTeamName}
+			if err := s.ChangesetRepo.CreateChangeset(ctx, changeset); err != nil {
+				return err
+			}
+			job.ChangesetID = &changeset.ID
+		}
+		return s.JobRepo.CreateJob(ctx, job, items)
+	})
+	if err != nil {
+		return nil, serviceerrors.ErrUnknown.Wrap(err)
+	}
+
+	select {
+	case s.wake <- struct{}{}:
+	default:
+	}
+	return jobResponse(job, items), nil
+}
+
+// план задачи: сначала деактивации, затем замены ревьюверов по каждому открытому PR уходящих участников
+func (s *JobService) planMassDeactivation(ctx context.Context, oldTeam *models.Team) ([]*models.JobItem, error) {
+	memberships, err := s.TeamRepo.ListMemberships(ctx, oldTeam.ID)
+	if err != nil {
+		return nil, err
+	}
+	primary := make(map[uuid.UUID]bool, len(memberships))
+	for _, m := range memberships {
+		primary[m.UserID] = m.IsPrimary
+	}
+
+	items := make([]*models.JobItem, 0, len(oldTeam.Members))
+	leaving := make(map[uuid.UUID]struct{}, len(oldTeam.Members))
+	ids := make([]string, 0, len(oldTeam.Members))
+	for _, m := range oldTeam.Members {
+		if m == nil {
+			continue
+		}
+		if !primary[m.ID] {
+			items = append(items, &models.JobItem{Kind: models.JobItemKeepActive, UserCustomID: m.UserCustomID, Outcome: models.JobOutcomeKeptActive})
+			continue
+		}
+		items = append(items, &models.JobItem{Kind: models.JobItemDeactivate, UserCustomID: m.UserCustomID})
+		leaving[m.ID] = struct{}{}
+		ids = append(ids, m.ID.String())
+	}
+
+	prs, err := s.PRRepo.ListOpenPullRequestsByReviewerIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	for _, pr := range prs {
+		for _, reviewer := range pr.AssignedReviewers {
+			if reviewer == nil {
+				continue
+			}
+			if _, ok := leaving[reviewer.ID]; !ok {
+				continue
+			}
+			items = append(items, &models.JobItem{Kind: models.JobItemReassign, UserCustomID: reviewer.UserCustomID, PullRequestCustomID: pr.PullRequestCustomID, Repository: pr.RepositoryName()})
+		}
+	}
+	return items, nil
+}
+
+func (s *JobService) GetJob(ctx context.Context, id string) (*openapi.Job, *serviceerrors.ServiceError) {
+	jobID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, serviceerrors.ErrJobNotFound
+	}
+	job, err := s.JobRepo.GetJob(ctx, jobID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, serviceerrors.ErrJobNotFound
+	}
+	if err != nil {
+		return nil, serviceerrors.ErrUnknown.Wrap(err)
+	}
+	items, err := s.JobRepo.ListItems(ctx, jobID)
+	if err != nil {
+		return nil, serviceerrors.ErrUnknown.Wrap(err)
+	}
+	return jobResponse(job, items), nil
+}
+
+// обрабатывает незавершённые задачи, в том числе прерванные перезапуском сервиса;
+// просыпается при постановке новой задачи или раз в interval, пока не отменён ctx
+func (s *JobService) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := s.RunPending(ctx); err != nil {
+			log.Printf("jobs: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.wake:
+		case <-ticker.C:
+		}
+	}
+}
+
+// доводит до конца все незавершённые задачи всех организаций; задача с ошибкой получает статус failed,
+// уже закоммиченные пачки остаются применёнными. Ошибка одной организации не мешает остальным
+func (s *JobService) RunPending(ctx context.Context) error {
+	orgs, err := s.OrganizationRepo.ListOrganizations(ctx)
+	if err != nil {
+		return err
+	}
+	for _, org := range orgs {
+		err := s.runOrganization(WithOrganization(ctx, org.ID))
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if err != nil {
+			log.Printf("jobs: organization %s: %v", org.Slug, err)
+		}
+	}
+	return nil
+}
+
+func (s *JobService) runOrganization(ctx context.Context) error {
+	jobs, err := s.JobRepo.ListUnfinishedJobs(ctx)
+	if err != nil {
+		return err
+	}
+	for _, job := range jobs {
+		claimed, err := s.JobRepo.ClaimJob(ctx, job, s.owner, s.leaseUntil())
+		if err != nil {
+			return err
+		}
+		// задачу ведёт другая реплика
+		if !claimed {
+			continue
+		}
+		err = s.process(ctx, job)
+		if err == nil {
+			continue
+		}
+		// остановка сервиса: задача остаётся running и продолжится после запуска
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		// аренда истекла и задачу подхватила другая реплика, незакоммиченная пачка откатилась
+		if errors.Is(err, postgresrepository.ErrJobLeaseLost) {
+			log.Printf("jobs: job %s: lease taken over by another replica", job.ID)
+			continue
+		}
+		log.Printf("jobs: job %s failed: %v", job.ID, err)
+		job.Status = models.JobFailed
+		job.Error = err.Error()
+		if err := s.complete(ctx, job); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *JobService) process(ctx context.Context, job *models.Job) error {
+	ctx = WithActor(ctx, job.Actor)
+
+	if job.Status == models.JobPending {
+		job.Status = models.JobRunning
+		if err := s.JobRepo.UpdateJob(ctx, job); err != nil {
+			return err
+		}
+	}
+
+	leavingIDs, err := s.JobRepo.ListDeactivatedUserIDs(ctx, job.ID)
+	if err != nil {
+		return err
+	}
+	leaving, err := s.UserRepo.GetUsersByCustomIDs(ctx, leavingIDs)
+	if err != nil {
+		return err
+	}
+
+	for {
+		items, err := s.JobRepo.NextItems(ctx, job.ID, s.BatchSize)
+		if err != nil {
+			return err
+		}
+		if len(items) == 0 {
+			return s.finish(ctx, job)
+		}
+		if err := s.runBatch(ctx, job, items, leaving); err != nil {
+			return err
+		}
+	}
+}
+
+// пачка и прогресс задачи коммитятся вместе, поэтому после перезапуска пачка не повторяется;
+// при dry run изменения откатываются, а исходы сохраняются отдельно
+func (s *JobService) runBatch(ctx context.Context, job *models.Job, items []*models.JobItem, leaving []*models.User) error {
+	newTeam, err := s.TeamRepo.FindTeamByName(ctx, job.NewTeamName)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return fmt.Errorf("team %s not found", job.NewTeamName)
+	}
+	if err != nil {
+		return err
+	}
+	pool, err := s.TeamRepo.ListReviewCandidates(ctx, newTeam.ID)
+	if err != nil {
+		return err
+	}
+
+	next := *job
+	next.Processed += len(items)
+	next.LeaseUntil = s.leaseUntil()
+	err = s.Tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.applyItems(ctx, &next, items, leaving, pool); err != nil {
+			return err
+		}
+		if next.DryRun {
+			return errDryRun
+		}
+		return s.JobRepo.SaveProgress(ctx, &next, items)
+	})
+	if errors.Is(err, errDryRun) {
+		err = s.Tx.WithinTransaction(ctx, func(ctx context.Context) error {
+			return s.JobRepo.SaveProgress(ctx, &next, items)
+		})
+	}
+	if err != nil {
+		return err
+	}
+	*job = next
+	return nil
+}
+
+func (s *JobService) leaseUntil() int64 {
+	return time.Now().Add(jobLeaseTTL).Unix()
+}
+
+func (s *JobService) applyItems(ctx context.Context, job *models.Job, items []*models.JobItem, leaving []*models.User, pool []*models.User) error {
+	byCustomID := make(map[string]*models.User, len(leaving))
+	for _, u := range leaving {
+		byCustomID[u.UserCustomID] = u
+	}
+
+	ids := make([]string, 0)
+	entries := make([]*models.AuditEntry, 0)
+	for _, item := range items {
+		if item.Kind != models.JobItemDeactivate {
+			continue
+		}
+		u, ok := byCustomID[item.UserCustomID]
+		if !ok {
+			item.Outcome = models.JobOutcomeSkipped
+			continue
+		}
+		ids = append(ids, u.ID.String())
+		entries = append(entries, &models.AuditEntry{
+			Action:       models.AuditUserDeactivated,
+			UserCustomID: u.UserCustomID,
+			TeamName:     job.OldTeamName,
+			Reason:       models.ReasonMassDeactivation,
+		})
+		item.Outcome = models.JobOutcomeDeactivated
+	}
+	if len(ids) > 0 {
+		if err := s.UserRepo.SetUsersActiveByIDs(ctx, ids, false); err != nil {
+			return err
+		}
+		if err := s.Audit.Record(ctx, entries...); err != nil {
+			return err
+		}
+	}
+
+	for _, item := range items {
+		if item.Kind != models.JobItemReassign {
+			continue
+		}
+		if err := s.reassignItem(ctx, job, item, leaving, pool); err != nil {
+			return err
+		}
+	}
+	return s.recordChanges(ctx, job, items)
+}
+
+// дописывает применённые изменения пачки в набор задачи в той же транзакции
+func (s *JobService) recordChanges(ctx context.Context, job *models.Job, items []*models.JobItem) error {
+	if job.ChangesetID == nil {
+		return nil
+	}
+	entries := make([]*models.ChangesetEntry, 0, len(items))
+	for _, item := range items {
+		switch item.Outcome {
+		case models.JobOutcomeDeactivated:
+			entries = append(entries, &models.ChangesetEntry{ChangesetID: *job.ChangesetID, Kind: models.ChangeUserDeactivated, UserCustomID: item.UserCustomID})
+		case models.JobOutcomeReassigned:
+			entries = append(entries, &models.ChangesetEntry{
+				ChangesetID:         *job.ChangesetID,
+				Kind:                models.ChangeReviewerReassigned,
+				UserCustomID:        item.UserCustomID,
+				PullRequestCustomID: item.PullRequestCustomID,
+				Repository:          item.Repository,
+				NewReviewerID:       item.NewReviewerID,
+			})
+		}
+	}
+	return s.ChangesetRepo.AppendEntries(ctx, entries)
+}
+
+// заменяет уходящего ревьювера в PR по тем же правилам исключения, что и остальные переназначения
+func (s *JobService) reassignItem(ctx context.Context, job *models.Job, item *models.JobItem, leaving []*models.User, pool []*models.User) error {
+	pr, err := s.PRRepo.GetPullRequest(ctx, item.Repository, item.PullRequestCustomID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		item.Outcome = models.JobOutcomeSkipped
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	idx := -1
+	for i, reviewer := range pr.AssignedReviewers {
+		if reviewer != nil && reviewer.UserCustomID == item.UserCustomID {
+			idx = i
+		}
+	}
+	if pr.Status != "OPEN" || idx < 0 {
+		item.Outcome = models.JobOutcomeSkipped
+		return nil
+	}
+
+	excluded := make([]*models.User, 0, len(pr.AssignedReviewers)+len(leaving)+1)
+	excluded = append(excluded, pr.AssignedReviewers...)
+	excluded = append(excluded, leaving...)
+	excluded = append(excluded, &pr.Author)
+
+	newReviewer := s.TeamRepo.PickMemberNotInList(pool, excluded)
+	if newReviewer == nil {
+		item.Outcome = models.JobOutcomeNoCandidate
+		return nil
+	}
+	pr.AssignedReviewers[idx] = newReviewer
+	if err := s.PRRepo.UpdatePullRequest(ctx, pr); err != nil {
+		return err
+	}
+	if err := s.Audit.Record(ctx, &models.AuditEntry{
+		Action:              models.AuditReviewerReassigned,
+		PullRequestCustomID: pr.PullRequestCustomID,
+		Repository:          pr.RepositoryName(),
+		OldReviewerID:       item.UserCustomID,
+		NewReviewerID:       newReviewer.UserCustomID,
+		TeamName:            job.NewTeamName,
+		Reason:              models.ReasonMassDeactivation,
+		Strategy:            models.StrategyRandomTargetTeam,
+	}); err != nil {
+		return err
+	}
+	s.Events.PublishAfterCommit(ctx, reassignmentEvents(pr, item.UserCustomID, newReviewer.UserCustomID, job.NewTeamName, models.ReasonMassDeactivation)...)
+	item.Outcome = models.JobOutcomeReassigned
+	item.NewReviewerID = newReviewer.UserCustomID
+	return nil
+}
+
+func (s *JobService) finish(ctx context.Context, job *models.Job) error {
+	return s.Tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		if !job.DryRun {
+			if err := s.Audit.Record(ctx, &models.AuditEntry{
+				Action:   models.AuditTeamMassDeactivated,
+				TeamName: job.OldTeamName,
+				Reason:   models.ReasonMassDeactivation,
+			}); err != nil {
+				return err
+			}
+		}
+		job.Status = models.JobSucceeded
+		return s.complete(ctx, job)
+	})
+}
+
+// фиксирует итоговый статус задачи; набор изменений закрывается и в случае ошибки,
+// чтобы уже применённые пачки можно было откатить
+func (s *JobService) complete(ctx context.Context, job *models.Job) error {
+	return s.Tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		now := time.Now().Unix()
+		job.FinishedAt = &now
+		if err := s.JobRepo.UpdateJob(ctx, job); err != nil {
+			return err
+		}
+		if job.ChangesetID == nil {
+			return nil
+		}
+		changeset, err := s.ChangesetRepo.GetChangeset(ctx, *job.ChangesetID)
+		if err != nil {
+			return err
+		}
+		changeset.CompletedAt = &now
+		return s.ChangesetRepo.UpdateChangeset(ctx, changeset)
+	})
+}
+
+func jobResponse(job *models.Job, items []*models.JobItem) *openapi.Job {
+	resp := &openapi.Job{
+		Id:          job.ID.String(),
+		Kind:        openapi.JobKind(job.Kind),
+		Status:      openapi.JobStatus(job.Status),
+		DryRun:      job.DryRun,
+		OldTeamName: job.OldTeamName,
+		NewTeamName: job.NewTeamName,
+		Progress:    openapi.JobProgress{Total: job.Total, Processed: job.Processed},
+		Deactivated: []string{},
+		KeptActive:  []string{},
+		Outcomes:    []openapi.JobOutcome{},
+		CreatedAt:   time.Unix(job.CreatedAt, 0).UTC(),
+		UpdatedAt:   time.Unix(job.UpdatedAt, 0).UTC(),
+	}
+	if job.Error != "" {
+		resp.Error = &job.Error
+	}
+	if job.ChangesetID != nil {
+		id := job.ChangesetID.String()
+		resp.ChangesetId = &id
+	}
+	if job.FinishedAt != nil {
+		t := time.Unix(*job.FinishedAt, 0).UTC()
+		resp.FinishedAt = &t
+	}
+
+	for _, item := range items {
+		switch item.Kind {
+		case models.JobItemDeactivate:
+			if item.Outcome == models.JobOutcomeDeactivated {
+				resp.Deactivated = append(resp.Deactivated, item.UserCustomID)
+			}
+		case models.JobItemKeepActive:
+			resp.KeptActive = append(resp.KeptActive, item.UserCustomID)
+		case models.JobItemReassign:
+			outcome := openapi.JobOutcome{
+				PullRequestId: item.PullRequestCustomID,
+				Repository:    ptr.Optional(item.Repository),
+				OldReviewerId: item.UserCustomID,
+				Outcome:       openapi.JobOutcomeOutcome(item.Outcome),
+				NewReviewerId: ptr.Optional(item.NewReviewerID),
+			}
+			if item.Outcome == "" {
+				outcome.Outcome = openapi.JobOutcomeOutcomePending
+			}
+			resp.Outcomes = append(resp.Outcomes, outcome)
+		}
+	}
+	return resp
+}
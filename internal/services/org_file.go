@@ -0,0 +1,253 @@
+package services
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	serviceerrors "github.com/wozhdeleniye/avito-tech-internship/internal/pkg/errors"
+	"github.com/wozhdeleniye/avito-tech-internship/internal/pkg/validation"
+	"github.com/wozhdeleniye/avito-tech-internship/internal/repo/models"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	OrgFileYAML = "yaml"
+	OrgFileCSV  = "csv"
+)
+
+// колонки CSV-файла организации: одна строка на участника, строка без user_id задаёт только команду.
+// Политики команды можно указать в любой её строке, значения в разных строках должны совпадать.
+// Пустой is_active означает активного пользователя, пустое действие SLA - remind
+var orgCSVColumns = []string{"team_name", "user_id", "username", "is_active", "role", "sla_first_review_hours", "sla_action"}
+
+type yamlOrgFile struct {
+	Teams []yaml.Node `yaml:"teams"`
+}
+
+type yamlOrgTeam struct {
+	TeamName string             `yaml:"team_name"`
+	SLA      *models.OrgTeamSLA `yaml:"sla"`
+	Members  []yaml.Node        `yaml:"members"`
+}
+
+type yamlOrgMember struct {
+	UserID   string `yaml:"user_id"`
+	Username string `yaml:"username"`
+	IsActive *bool  `yaml:"is_active"`
+	Role     string `yaml:"role"`
+}
+
+// разбирает файл организации. Ошибка возвращается, если файл не читается целиком;
+// ошибки отдельных строк попадают в результаты, а сами строки в файл не включаются
+func ParseOrgFile(data []byte, format string) (*models.OrgFile, []models.ImportRowResult, error) {
+	switch format {
+	case OrgFileYAML:
+		return parseOrgYAML(data)
+	case OrgFileCSV:
+		return parseOrgCSV(data)
+	default:
+		return nil, nil, fmt.Errorf("unsupported format %q, expected yaml or csv", format)
+	}
+}
+
+func parseOrgYAML(data []byte) (*models.OrgFile, []models.ImportRowResult, error) {
+	var raw yamlOrgFile
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	if err := dec.Decode(&raw); err != nil && !errors.Is(err, io.EOF) {
+		return nil, nil, fmt.Errorf("invalid yaml: %w", err)
+	}
+
+	file := &models.OrgFile{Teams: make([]*models.OrgTeam, 0, len(raw.Teams))}
+	invalid := make([]models.ImportRowResult, 0)
+	for i := range raw.Teams {
+		node := &raw.Teams[i]
+		var t yamlOrgTeam
+		if err := node.Decode(&t); err != nil {
+			invalid = append(invalid, invalidRow(node.Line, models.ImportRowTeam, "", "", err.Error()))
+			continue
+		}
+
+		team := &models.OrgTeam{Row: node.Line, TeamName: t.TeamName, SLA: t.SLA}
+		if team.SLA != nil && team.SLA.Action == "" {
+			team.SLA.Action = models.SLAActionRemind
+		}
+		for j := range t.Members {
+			mnode := &t.Members[j]
+			var m yamlOrgMember
+			if err := mnode.Decode(&m); err != nil {
+				invalid = append(invalid, invalidRow(mnode.Line, models.ImportRowMember, t.TeamName, "", err.Error()))
+				continue
+			}
+			member := &models.OrgMember{Row: mnode.Line, UserID: m.UserID, Username: m.Username, IsActive: true, Role: m.Role}
+			if m.IsActive != nil {
+				member.IsActive = *m.IsActive
+			}
+			team.Members = append(team.Members, member)
+		}
+		file.Teams = append(file.Teams, team)
+	}
+	return file, invalid, nil
+}
+
+func parseOrgCSV(data []byte) (*models.OrgFile, []models.ImportRowResult, error) {
+	r := csv.NewReader(bytes.NewReader(data))
+	r.TrimLeadingSpace = true
+
+	header, err := r.Read()
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid csv header: %w", err)
+	}
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		name = strings.TrimSpace(name)
+		if !contains(orgCSVColumns, name) {
+			return nil, nil, fmt.Errorf("unknown csv column %q", name)
+		}
+		columns[name] = i
+	}
+	for _, required := range []string{"team_name", "user_id"} {
+		if _, ok := columns[required]; !ok {
+			return nil, nil, fmt.Errorf("csv column %q is required", required)
+		}
+	}
+
+	file := &models.OrgFile{Teams: make([]*models.OrgTeam, 0)}
+	teams := make(map[string]*models.OrgTeam)
+	invalid := make([]models.ImportRowResult, 0)
+	for {
+		record, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid csv: %w", err)
+		}
+		line, _ := r.FieldPos(0)
+		field := func(name string) string {
+			if i, ok := columns[name]; ok {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+
+		teamName, userID := field("team_name"), field("user_id")
+		team, ok := teams[teamName]
+		if !ok {
+			team = &models.OrgTeam{Row: line, TeamName: teamName}
+			teams[teamName] = team
+			file.Teams = append(file.Teams, team)
+		}
+
+		if hours, action := field("sla_first_review_hours"), field("sla_action"); hours != "" || action != "" {
+			sla := &models.OrgTeamSLA{Action: action}
+			if sla.Action == "" {
+				sla.Action = models.SLAActionRemind
+			}
+			if hours != "" {
+				n, err := strconv.Atoi(hours)
+				if err != nil {
+					invalid = append(invalid, invalidRow(line, models.ImportRowTeam, teamName, userID, "sla_first_review_hours must be an integer"))
+					continue
+				}
+				sla.FirstReviewHours = n
+			}
+			if team.SLA != nil && *team.SLA != *sla {
+				invalid = append(invalid, invalidRow(line, models.ImportRowTeam, teamName, userID, "sla differs from an earlier row of the same team"))
+				continue
+			}
+			team.SLA = sla
+		}
+
+		if userID == "" {
+			if field("username") != "" || field("role") != "" || field("is_active") != "" {
+				invalid = append(invalid, invalidRow(line, models.ImportRowMember, teamName, "", "user_id is required"))
+			}
+			continue
+		}
+
+		member := &models.OrgMember{Row: line, UserID: userID, Username: field("username"), IsActive: true, Role: field("role")}
+		if v := field("is_active"); v != "" {
+			active, err := strconv.ParseBool(v)
+			if err != nil {
+				invalid = append(invalid, invalidRow(line, models.ImportRowMember, teamName, userID, "is_active must be true or false"))
+				continue
+			}
+			member.IsActive = active
+		}
+		team.Members = append(team.Members, member)
+	}
+	return file, invalid, nil
+}
+
+// проверяет файл целиком: обязательные поля, роли, политики, повторы и противоречия между командами
+func ValidateOrgFile(file *models.OrgFile) []models.ImportRowResult {
+	invalid := make([]models.ImportRowResult, 0)
+	teams := make(map[string]bool, len(file.Teams))
+	users := make(map[string]*models.OrgMember)
+
+	for _, team := range file.Teams {
+		nameErr := validation.Var(team.TeamName, validation.TeamNameRule)
+		switch {
+		case nameErr != nil:
+			invalid = append(invalid, invalidRow(team.Row, models.ImportRowTeam, team.TeamName, "", "team_name "+nameErr.Error()))
+		case teams[team.TeamName]:
+			invalid = append(invalid, invalidRow(team.Row, models.ImportRowTeam, team.TeamName, "", "team is listed twice"))
+		}
+		teams[team.TeamName] = true
+
+		if team.SLA != nil && (team.SLA.FirstReviewHours < 0 || (team.SLA.Action != models.SLAActionRemind && team.SLA.Action != models.SLAActionReassign)) {
+			invalid = append(invalid, invalidRow(team.Row, models.ImportRowTeam, team.TeamName, "", serviceerrors.ErrInvalidSLA.Message))
+		}
+
+		inTeam := make(map[string]bool, len(team.Members))
+		for _, m := range team.Members {
+			var problem string
+			idErr := validation.Var(m.UserID, validation.IDRule)
+			usernameErr := validation.Var(m.Username, validation.UsernameRule)
+			switch {
+			case idErr != nil:
+				problem = "user_id " + idErr.Error()
+			case usernameErr != nil:
+				problem = "username " + usernameErr.Error()
+			case m.Role != "" && m.Role != models.RoleMember && m.Role != models.RoleLead && m.Role != models.RoleObserver:
+				problem = serviceerrors.ErrInvalidRole.Message
+			case inTeam[m.UserID]:
+				problem = "user is listed twice in the team"
+			}
+			if problem == "" {
+				if prev, ok := users[m.UserID]; ok && (prev.Username != m.Username || prev.IsActive != m.IsActive) {
+					problem = fmt.Sprintf("username or is_active differs from row %d", prev.Row)
+				}
+			}
+			if problem != "" {
+				invalid = append(invalid, invalidRow(m.Row, models.ImportRowMember, team.TeamName, m.UserID, problem))
+				continue
+			}
+
+			inTeam[m.UserID] = true
+			if _, ok := users[m.UserID]; !ok {
+				users[m.UserID] = m
+			}
+		}
+	}
+	return invalid
+}
+
+func invalidRow(row int, kind string, teamName string, userID string, message string) models.ImportRowResult {
+	return models.ImportRowResult{Row: row, Kind: kind, TeamName: teamName, UserID: userID, Result: models.ImportInvalid, Error: message}
+}
+
+func contains(values []string, v string) bool {
+	for _, s := range values {
+		if s == v {
+			return true
+		}
+	}
+	return false
+}
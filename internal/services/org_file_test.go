@@ -0,0 +1,104 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/wozhdeleniye/avito-tech-internship/internal/repo/models"
+)
+
+func TestParseOrgFileYAML(t *testing.T) {
+	data := []byte(`teams:
+  - team_name: backend
+    sla:
+      first_review_hours: 24
+    members:
+      - user_id: u1
+        username: Alice
+        role: lead
+      - user_id: u2
+        username: Bob
+        is_active: false
+  - team_name: frontend
+    members: []
+`)
+
+	file, invalid, err := ParseOrgFile(data, OrgFileYAML)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(invalid) != 0 {
+		t.Fatalf("unexpected invalid rows: %+v", invalid)
+	}
+	if len(file.Teams) != 2 || file.Teams[0].TeamName != "backend" || file.Teams[1].Row != 12 {
+		t.Fatalf("unexpected teams: %+v", file.Teams)
+	}
+	backend := file.Teams[0]
+	if backend.SLA == nil || backend.SLA.FirstReviewHours != 24 || backend.SLA.Action != models.SLAActionRemind {
+		t.Fatalf("unexpected sla: %+v", backend.SLA)
+	}
+	if len(backend.Members) != 2 || !backend.Members[0].IsActive || backend.Members[1].IsActive || backend.Members[1].Row != 9 {
+		t.Fatalf("unexpected members: %+v %+v", backend.Members[0], backend.Members[1])
+	}
+}
+
+func TestParseOrgFileCSV(t *testing.T) {
+	data := []byte(`team_name,user_id,username,is_active,role,sla_first_review_hours,sla_action
+backend,u1,Alice,true,lead,24,reassign
+backend,u2,Bob,,,,
+frontend,,,,,,
+backend,u3,Carol,maybe,,,
+backend,u4,Dan,,,48,
+`)
+
+	file, invalid, err := ParseOrgFile(data, OrgFileCSV)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(file.Teams) != 2 || len(file.Teams[0].Members) != 2 || len(file.Teams[1].Members) != 0 {
+		t.Fatalf("unexpected teams: %+v", file.Teams)
+	}
+	if sla := file.Teams[0].SLA; sla == nil || sla.FirstReviewHours != 24 || sla.Action != models.SLAActionReassign {
+		t.Fatalf("unexpected sla: %+v", sla)
+	}
+	if len(invalid) != 2 || invalid[0].Row != 5 || invalid[1].Row != 6 {
+		t.Fatalf("expected rows 5 and 6 to be invalid, got %+v", invalid)
+	}
+
+	if _, _, err := ParseOrgFile([]byte("team,user\n"), OrgFileCSV); err == nil {
+		t.Fatalf("expected error for unknown columns")
+	}
+}
+
+func TestValidateOrgFile(t *testing.T) {
+	file := &models.OrgFile{Teams: []*models.OrgTeam{
+		{Row: 1, TeamName: "a", Members: []*models.OrgMember{
+			{Row: 2, UserID: "u1", Username: "Alice", IsActive: true},
+			{Row: 3, UserID: "u1", Username: "Alice", IsActive: true},
+			{Row: 4, UserID: "u2", Username: "", IsActive: true},
+			{Row: 5, UserID: "u3", Username: "Carol", IsActive: true, Role: "owner"},
+		}},
+		{Row: 6, TeamName: "b", SLA: &models.OrgTeamSLA{FirstReviewHours: -1, Action: models.SLAActionRemind}, Members: []*models.OrgMember{
+			{Row: 7, UserID: "u1", Username: "Alice", IsActive: false},
+			{Row: 8, UserID: "u4", Username: "Dan", IsActive: true},
+		}},
+		{Row: 9, TeamName: "a"},
+	}}
+
+	invalid := ValidateOrgFile(file)
+	rows := make([]int, 0, len(invalid))
+	for _, r := range invalid {
+		if r.Result != models.ImportInvalid || r.Error == "" {
+			t.Fatalf("unexpected result: %+v", r)
+		}
+		rows = append(rows, r.Row)
+	}
+	want := []int{3, 4, 5, 6, 7, 9}
+	if len(rows) != len(want) {
+		t.Fatalf("expected invalid rows %v, got %v", want, rows)
+	}
+	for i := range want {
+		if rows[i] != want[i] {
+			t.Fatalf("expected invalid rows %v, got %v", want, rows)
+		}
+	}
+}
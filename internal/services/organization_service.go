@@ -0,0 +1,116 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	openapi "github.com/wozhdeleniye/avito-tech-internship/api"
+	"github.com/wozhdeleniye/avito-tech-internship/internal/pkg/auth"
+	serviceerrors "github.com/wozhdeleniye/avito-tech-internship/internal/pkg/errors"
+	"github.com/wozhdeleniye/avito-tech-internship/internal/repo/models"
+	postgresrepository "github.com/wozhdeleniye/avito-tech-internship/internal/repo/repositories/postgres_repository"
+	"gorm.io/gorm"
+)
+
+// организация, в которой работают репозитории при обработке запроса
+func WithOrganization(ctx context.Context, id uuid.UUID) context.Context {
+	return postgresrepository.WithOrganization(ctx, id)
+}
+
+// организации (тенанты) и определение организации запроса; TokenSecret пустой - токены не проверяются
+type OrganizationService struct {
+	OrganizationRepo *postgresrepository.OrganizationRepository
+	TokenSecret      []byte
+}
+
+func NewOrganizationService(organizationRepo *postgresrepository.OrganizationRepository, tokenSecret string) *OrganizationService {
+	return &OrganizationService{
+		OrganizationRepo: organizationRepo,
+		TokenSecret:      []byte(tokenSecret),
+	}
+}
+
+// организация запроса. С TokenSecret она берётся только из claim org проверенного токена: запрос без токена,
+// с невалидным токеном или токеном без org отклоняется, X-Organization может лишь совпадать с org.
+// Без TokenSecret - slug из заголовка, иначе организация по умолчанию
+func (s *OrganizationService) ResolveOrganization(ctx context.Context, token string, slug string) (uuid.UUID, *serviceerrors.ServiceError) {
+	if len(s.TokenSecret) > 0 {
+		if token == "" {
+			return uuid.Nil, serviceerrors.ErrUnauthorized.WithMessage("access token is required")
+		}
+		claims, err := auth.ParseToken(token, s.TokenSecret, time.Now())
+		if err != nil {
+			return uuid.Nil, serviceerrors.ErrInvalidToken
+		}
+		if claims.Organization == "" {
+			return uuid.Nil, serviceerrors.ErrInvalidToken.WithMessage("token has no organization")
+		}
+		if slug != "" && slug != claims.Organization {
+			return uuid.Nil, serviceerrors.ErrInvalidToken.WithMessage("X-Organization does not match token organization")
+		}
+		slug = claims.Organization
+	}
+	if slug == "" || slug == models.DefaultOrganizationSlug {
+		return models.DefaultOrganizationID, nil
+	}
+
+	org, err := s.OrganizationRepo.FindOrganizationBySlug(ctx, slug)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return uuid.Nil, serviceerrors.ErrOrganizationNotFound
+	}
+	if err != nil {
+		return uuid.Nil, serviceerrors.ErrUnknown.Wrap(err)
+	}
+	return org.ID, nil
+}
+
+// служебный токен управляет всеми организациями: с TokenSecret это проверенный токен с claim admin,
+// без TokenSecret токены не проверяются и управление открыто
+func (s *OrganizationService) isAdmin(token string) bool {
+	if len(s.TokenSecret) == 0 {
+		return true
+	}
+	claims, err := auth.ParseToken(token, s.TokenSecret, time.Now())
+	return err == nil && claims.Admin
+}
+
+// создать организацию может только служебный токен, токен организации получает FORBIDDEN
+func (s *OrganizationService) CreateOrganization(ctx context.Context, token string, req openapi.Organization) (*openapi.Organization, *serviceerrors.ServiceError) {
+	if !s.isAdmin(token) {
+		return nil, serviceerrors.ErrForbidden
+	}
+	org := &models.Organization{Slug: req.Slug, Name: req.Name}
+	if err := s.OrganizationRepo.CreateOrganization(ctx, org); err != nil {
+		if errors.Is(err, postgresrepository.ErrOrganizationExists) {
+			return nil, serviceerrors.ErrOrganizationExists
+		}
+		return nil, serviceerrors.ErrUnknown.Wrap(err)
+	}
+	return organizationResponse(org), nil
+}
+
+// служебному токену - все организации, токену организации - только его собственная
+func (s *OrganizationService) ListOrganizations(ctx context.Context, token string) ([]openapi.Organization, *serviceerrors.ServiceError) {
+	if !s.isAdmin(token) {
+		org, err := s.OrganizationRepo.FindOrganization(ctx)
+		if err != nil {
+			return nil, serviceerrors.ErrUnknown.Wrap(err)
+		}
+		return []openapi.Organization{*organizationResponse(org)}, nil
+	}
+	orgs, err := s.OrganizationRepo.ListOrganizations(ctx)
+	if err != nil {
+		return nil, serviceerrors.ErrUnknown.Wrap(err)
+	}
+	resp := make([]openapi.Organization, 0, len(orgs))
+	for _, org := range orgs {
+		resp = append(resp, *organizationResponse(org))
+	}
+	return resp, nil
+}
+
+func organizationResponse(org *models.Organization) *openapi.Organization {
+	return &openapi.Organization{Slug: org.Slug, Name: org.Name}
+}
@@ -0,0 +1,31 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	openapi "github.com/wozhdeleniye/avito-tech-internship/api"
+	"github.com/wozhdeleniye/avito-tech-internship/internal/pkg/auth"
+	serviceerrors "github.com/wozhdeleniye/avito-tech-internship/internal/pkg/errors"
+)
+
+func TestCreateOrganizationRequiresAdminToken(t *testing.T) {
+	// до репозитория запрос не доходит, поэтому он не нужен
+	s := NewOrganizationService(nil, "test-secret")
+	orgToken, err := auth.IssueToken(auth.Claims{Subject: "bot", Organization: "default"}, []byte("test-secret"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	forged, err := auth.IssueToken(auth.Claims{Subject: "bot", Organization: "default", Admin: true}, []byte("other-secret"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, token := range map[string]string{"organization token": orgToken, "foreign admin token": forged, "no token": ""} {
+		_, serr := s.CreateOrganization(context.Background(), token, openapi.Organization{Slug: "payments", Name: "Payments"})
+		if serr == nil || !errors.Is(serr, serviceerrors.ErrForbidden) {
+			t.Errorf("%s: got %v, want FORBIDDEN", name, serr)
+		}
+	}
+}
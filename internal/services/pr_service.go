@@ -2,47 +2,71 @@ package services
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
 	"errors"
+	"strconv"
+	"strings"
 	"time"
 
 	"math/rand"
 
+	"github.com/google/uuid"
 	openapi "github.com/wozhdeleniye/avito-tech-internship/api"
 	serviceerrors "github.com/wozhdeleniye/avito-tech-internship/internal/pkg/errors"
+	"github.com/wozhdeleniye/avito-tech-internship/internal/pkg/ptr"
 	"github.com/wozhdeleniye/avito-tech-internship/internal/repo/models"
 	postgresrepository "github.com/wozhdeleniye/avito-tech-internship/internal/repo/repositories/postgres_repository"
 	"gorm.io/gorm"
 )
 
 type PReqService struct {
-	PRRepo   *postgresrepository.PReqRepository
-	TeamRepo *postgresrepository.TeamRepository
-	UserRepo *postgresrepository.UserRepository
+	PRRepo         *postgresrepository.PReqRepository
+	TeamRepo       *postgresrepository.TeamRepository
+	UserRepo       *postgresrepository.UserRepository
+	RepositoryRepo *postgresrepository.RepositoryRepository
+	Tx             *postgresrepository.TxManager
+	Audit          *AuditService
+	Events         *EventBus
 }
 
-func NewPReqService(prRepo *postgresrepository.PReqRepository, teamRepo *postgresrepository.TeamRepository, userRepo *postgresrepository.UserRepository) *PReqService {
+func NewPReqService(prRepo *postgresrepository.PReqRepository, teamRepo *postgresrepository.TeamRepository, userRepo *postgresrepository.UserRepository, repositoryRepo *postgresrepository.RepositoryRepository, tx *postgresrepository.TxManager, audit *AuditService, events *EventBus) *PReqService {
 	return &PReqService{
-		PRRepo:   prRepo,
-		TeamRepo: teamRepo,
-		UserRepo: userRepo,
+		PRRepo:         prRepo,
+		TeamRepo:       teamRepo,
+		UserRepo:       userRepo,
+		RepositoryRepo: repositoryRepo,
+		Tx:             tx,
+		Audit:          audit,
+		Events:         events,
 	}
 }
 
 func (prserv *PReqService) CreatePullRequest(ctx context.Context, prReqBody openapi.PostPullRequestCreateJSONBody) (*openapi.PullRequest, *serviceerrors.ServiceError) {
 	author, err := prserv.UserRepo.GetUserByCustomId(ctx, prReqBody.AuthorId)
 	if err != nil {
-		return nil, serviceerrors.ErrUnknown
+		return nil, serviceerrors.ErrUnknown.Wrap(err)
 	}
 	if author == nil {
 		return nil, serviceerrors.ErrUserNotFound
 	}
 
-	var team *models.Team
-	if author.TeamID != nil {
-		team, err = prserv.TeamRepo.GetTeamByID(ctx, *author.TeamID)
+	var repo *models.Repository
+	if prReqBody.Repository != nil && *prReqBody.Repository != "" {
+		repo, err = prserv.RepositoryRepo.FindRepositoryByName(ctx, *prReqBody.Repository)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, serviceerrors.ErrRepositoryNotFound
+		}
+		if err != nil {
+			return nil, serviceerrors.ErrUnknown.Wrap(err)
+		}
 	}
+
+	team, strategy, err := assignmentTeam(ctx, prserv.TeamRepo, repo, author.ID)
 	if err != nil {
-		return nil, serviceerrors.ErrUnknown
+		return nil, serviceerrors.ErrUnknown.Wrap(err)
 	}
 	if team == nil {
 		return nil, serviceerrors.ErrTeamNotFound
@@ -50,21 +74,9 @@ func (prserv *PReqService) CreatePullRequest(ctx context.Context, prReqBody open
 
 	candidates, err := prserv.TeamRepo.GetAllParticipantsButNotSpecial(ctx, team.ID.String(), author.ID.String())
 	if err != nil {
-		return nil, serviceerrors.ErrUnknown
-	}
-
-	reviewers := make([]*models.User, 0, 2)
-
-	if len(candidates) >= 3 {
-		ind_1 := rand.Intn(len(candidates))
-		ind_2 := rand.Intn(len(candidates) - 1)
-		if ind_1 == ind_2 {
-			ind_2++
-		}
-		reviewers = append(reviewers, candidates[ind_1], candidates[ind_2])
-	} else {
-		reviewers = candidates
+		return nil, serviceerrors.ErrUnknown.Wrap(err)
 	}
+	reviewers := pickReviewers(candidates, repo.Reviewers())
 
 	pr := &models.PullRequest{
 		PullRequestCustomID: prReqBody.PullRequestId,
@@ -73,19 +85,55 @@ func (prserv *PReqService) CreatePullRequest(ctx context.Context, prReqBody open
 		Status:              "OPEN",
 		AssignedReviewers:   reviewers,
 	}
-	if err := prserv.PRRepo.CreatePullRequest(ctx, pr); err != nil {
-		if err == postgresrepository.ErrPRExists {
+	if repo != nil {
+		pr.RepositoryID = &repo.ID
+		pr.Repository = repo
+	}
+	err = prserv.Tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := prserv.PRRepo.CreatePullRequest(ctx, pr); err != nil {
+			return err
+		}
+
+		pr.Author = *author
+		events := make([]Event, 0, len(reviewers))
+		entries := make([]*models.AuditEntry, 0, len(reviewers)+1)
+		entries = append(entries, &models.AuditEntry{
+			Action:              models.AuditPRCreated,
+			PullRequestCustomID: pr.PullRequestCustomID,
+			Repository:          pr.RepositoryName(),
+			UserCustomID:        author.UserCustomID,
+			TeamName:            team.TeamName,
+		})
+		for _, r := range reviewers {
+			entries = append(entries, &models.AuditEntry{
+				Action:              models.AuditReviewerAssigned,
+				PullRequestCustomID: pr.PullRequestCustomID,
+				Repository:          pr.RepositoryName(),
+				UserCustomID:        r.UserCustomID,
+				TeamName:            team.TeamName,
+				Strategy:            strategy,
+			})
+			events = append(events, reviewerAssignedEvent(pr, r.UserCustomID, team.TeamName, ""))
+		}
+		prserv.Events.PublishAfterCommit(ctx, events...)
+		return prserv.Audit.Record(ctx, entries...)
+	})
+	if err != nil {
+		if errors.Is(err, postgresrepository.ErrPRExists) {
 			return nil, serviceerrors.ErrPRExists
 		}
-		return nil, serviceerrors.ErrUnknown
+		return nil, serviceerrors.ErrUnknown.Wrap(err)
 	}
 
+	crAt := time.Unix(pr.CreatedAt, 0)
 	resp := &openapi.PullRequest{
 		PullRequestId:     pr.PullRequestCustomID,
+		Repository:        ptr.Optional(pr.RepositoryName()),
 		PullRequestName:   pr.PullRequestName,
 		AuthorId:          author.UserCustomID,
 		Status:            openapi.PullRequestStatus(pr.Status),
 		AssignedReviewers: make([]string, 0, len(pr.AssignedReviewers)),
+		CreatedAt:         &crAt,
 	}
 	for _, r := range pr.AssignedReviewers {
 		resp.AssignedReviewers = append(resp.AssignedReviewers, r.UserCustomID)
@@ -93,25 +141,37 @@ func (prserv *PReqService) CreatePullRequest(ctx context.Context, prReqBody open
 	return resp, nil
 }
 
-func (prserv *PReqService) MarkPullReqAsMerged(ctx context.Context, prId string) (*openapi.PullRequest, *serviceerrors.ServiceError) {
-	pullRequest, err := prserv.PRRepo.GetPullRequestByID(ctx, prId)
-	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, serviceerrors.ErrPRNotFound
-		}
-		return nil, serviceerrors.ErrUnknown
-	}
-
-	if pullRequest == nil {
-		return nil, serviceerrors.ErrPRNotFound
+func (prserv *PReqService) MarkPullReqAsMerged(ctx context.Context, repository *string, prId string) (*openapi.PullRequest, *serviceerrors.ServiceError) {
+	pullRequest, serr := findPullRequest(ctx, prserv.PRRepo, repository, prId)
+	if serr != nil {
+		return nil, serr
 	}
 
 	if pullRequest.Status != "MERGED" {
 		pullRequest.Status = "MERGED"
 		now := time.Now().Unix()
 		pullRequest.MergedAt = &now
-		if err := prserv.PRRepo.UpdatePullRequest(ctx, pullRequest); err != nil {
-			return nil, serviceerrors.ErrUnknown
+		err := prserv.Tx.WithinTransaction(ctx, func(ctx context.Context) error {
+			if err := prserv.PRRepo.UpdatePullRequest(ctx, pullRequest); err != nil {
+				return err
+			}
+			team, _, err := assignmentTeam(ctx, prserv.TeamRepo, pullRequest.Repository, pullRequest.AuthorID)
+			if err != nil {
+				return err
+			}
+			teamName := ""
+			if team != nil {
+				teamName = team.TeamName
+			}
+			prserv.Events.PublishAfterCommit(ctx, pullRequestMergedEvent(pullRequest, teamName))
+			return prserv.Audit.Record(ctx, &models.AuditEntry{
+				Action:              models.AuditPRMerged,
+				PullRequestCustomID: pullRequest.PullRequestCustomID,
+				Repository:          pullRequest.RepositoryName(),
+			})
+		})
+		if err != nil {
+			return nil, serviceerrors.ErrUnknown.Wrap(err)
 		}
 	}
 
@@ -127,6 +187,7 @@ func (prserv *PReqService) MarkPullReqAsMerged(ctx context.Context, prId string)
 		CreatedAt:         &crAt,
 		MergedAt:          mrAt,
 		PullRequestId:     pullRequest.PullRequestCustomID,
+		Repository:        ptr.Optional(pullRequest.RepositoryName()),
 		PullRequestName:   pullRequest.PullRequestName,
 		Status:            openapi.PullRequestStatus(pullRequest.Status),
 		AssignedReviewers: make([]string, 0, len(pullRequest.AssignedReviewers)),
@@ -137,19 +198,16 @@ func (prserv *PReqService) MarkPullReqAsMerged(ctx context.Context, prId string)
 	return resp, nil
 }
 
-func (prserv *PReqService) ReassignReviewer(ctx context.Context, prId, old_reviewer_id string) (*models.PullRequestReassign, *serviceerrors.ServiceError) {
-	pullRequest, err := prserv.PRRepo.GetPullRequestByID(ctx, prId)
-	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, serviceerrors.ErrPRNotFound
-		}
-		return nil, serviceerrors.ErrUnknown
-	}
-
-	if pullRequest == nil {
-		return nil, serviceerrors.ErrPRNotFound
+func (prserv *PReqService) ReassignReviewer(ctx context.Context, repository *string, prId, old_reviewer_id string) (*models.PullRequestReassign, *serviceerrors.ServiceError) {
+	pullRequest, serr := findPullRequest(ctx, prserv.PRRepo, repository, prId)
+	if serr != nil {
+		return nil, serr
 	}
+	return prserv.reassignReviewer(ctx, pullRequest, old_reviewer_id, models.ReasonManual)
+}
 
+// переназначение с указанием причины для журнала аудита
+func (prserv *PReqService) reassignReviewer(ctx context.Context, pullRequest *models.PullRequest, old_reviewer_id string, reason string) (*models.PullRequestReassign, *serviceerrors.ServiceError) {
 	if pullRequest.Status != "OPEN" {
 		return nil, serviceerrors.ErrPRMerged
 	}
@@ -167,29 +225,46 @@ func (prserv *PReqService) ReassignReviewer(ctx context.Context, prId, old_revie
 	if oldReviewer == nil {
 		return nil, serviceerrors.ErrNotAssigned
 	}
-	var team *models.Team
-	if oldReviewer.TeamID != nil {
-		team, err = prserv.TeamRepo.GetTeamByID(ctx, *oldReviewer.TeamID)
-	}
+	team, strategy, err := reviewTeam(ctx, prserv.TeamRepo, pullRequest, oldReviewer.ID)
 	if err != nil {
-		return nil, serviceerrors.ErrUnknown
+		return nil, serviceerrors.ErrUnknown.Wrap(err)
 	}
 	if team == nil {
-		return nil, serviceerrors.ErrUnknown
+		return nil, serviceerrors.ErrNoCandidate
+	}
+	candidates, err := prserv.TeamRepo.ListReviewCandidates(ctx, team.ID)
+	if err != nil {
+		return nil, serviceerrors.ErrUnknown.Wrap(err)
 	}
 
 	excluded := make([]*models.User, 0, len(pullRequest.AssignedReviewers)+1)
 	excluded = append(excluded, pullRequest.AssignedReviewers...)
 	excluded = append(excluded, &pullRequest.Author)
 
-	newReviewer := prserv.TeamRepo.PickMemberNotInList(team.Members, excluded)
+	newReviewer := prserv.TeamRepo.PickMemberNotInList(candidates, excluded)
 	if newReviewer == nil {
 		return nil, serviceerrors.ErrNoCandidate
 	}
 
 	pullRequest.AssignedReviewers[oldIndex] = newReviewer
-	if err := prserv.PRRepo.UpdatePullRequest(ctx, pullRequest); err != nil {
-		return nil, serviceerrors.ErrUnknown
+	err = prserv.Tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := prserv.PRRepo.UpdatePullRequest(ctx, pullRequest); err != nil {
+			return err
+		}
+		prserv.Events.PublishAfterCommit(ctx, reassignmentEvents(pullRequest, oldReviewer.UserCustomID, newReviewer.UserCustomID, team.TeamName, reason)...)
+		return prserv.Audit.Record(ctx, &models.AuditEntry{
+			Action:              models.AuditReviewerReassigned,
+			PullRequestCustomID: pullRequest.PullRequestCustomID,
+			Repository:          pullRequest.RepositoryName(),
+			OldReviewerID:       oldReviewer.UserCustomID,
+			NewReviewerID:       newReviewer.UserCustomID,
+			TeamName:            team.TeamName,
+			Reason:              reason,
+			Strategy:            strategy,
+		})
+	})
+	if err != nil {
+		return nil, serviceerrors.ErrUnknown.Wrap(err)
 	}
 
 	crAt := time.Unix(pullRequest.CreatedAt, 0)
@@ -206,6 +281,7 @@ func (prserv *PReqService) ReassignReviewer(ctx context.Context, prId, old_revie
 			CreatedAt:         &crAt,
 			MergedAt:          mrAt,
 			PullRequestId:     pullRequest.PullRequestCustomID,
+			Repository:        ptr.Optional(pullRequest.RepositoryName()),
 			PullRequestName:   pullRequest.PullRequestName,
 			Status:            openapi.PullRequestStatus(pullRequest.Status),
 		},
@@ -217,33 +293,406 @@ func (prserv *PReqService) ReassignReviewer(ctx context.Context, prId, old_revie
 	return &resp, nil
 }
 
-func (prserv *PReqService) GetPullReqsByReviever(ctx context.Context, reviewer_id string) (*models.PullRequestSearch, *serviceerrors.ServiceError) {
-	prList, err := prserv.PRRepo.ListPullRequestsByReviewerCustomID(ctx, reviewer_id)
+// команда, из которой подбирается замена, и стратегия выбора: команда по политике репозитория PR (основная команда
+// автора или команда-владелец), если ревьювер в ней состоит, иначе основная команда ревьювера.
+// Общая для ручного переназначения и деактивации ревьювера
+func reviewTeam(ctx context.Context, teams *postgresrepository.TeamRepository, pr *models.PullRequest, reviewerID uuid.UUID) (*models.Team, string, error) {
+	team, strategy, err := assignmentTeam(ctx, teams, pr.Repository, pr.AuthorID)
+	if err != nil {
+		return nil, "", err
+	}
+	if team != nil {
+		membership, err := teams.GetMembership(ctx, team.ID, reviewerID)
+		if err != nil {
+			return nil, "", err
+		}
+		if membership != nil {
+			return team, strategy, nil
+		}
+	}
+	team, err = teams.GetPrimaryTeam(ctx, reviewerID)
+	return team, models.StrategyRandomReviewerTeam, err
+}
+
+// команда, из которой назначаются ревьюверы PR, по политике репозитория; repo nil - PR вне репозиториев
+func assignmentTeam(ctx context.Context, teams *postgresrepository.TeamRepository, repo *models.Repository, authorID uuid.UUID) (*models.Team, string, error) {
+	if repo.Source() == models.ReviewerSourceOwnerTeam {
+		team, err := teams.GetTeamByID(ctx, repo.OwnerTeamID)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, "", nil
+		}
+		return team, models.StrategyRandomOwnerTeam, err
+	}
+	team, err := teams.GetPrimaryTeam(ctx, authorID)
+	return team, models.StrategyRandomAuthorTeam, err
+}
+
+// до n случайных разных кандидатов; если кандидатов не больше n, назначаются все
+func pickReviewers(candidates []*models.User, n int) []*models.User {
+	if len(candidates) <= n {
+		return candidates
+	}
+	picked := make([]*models.User, 0, n)
+	for _, i := range rand.Perm(len(candidates))[:n] {
+		picked = append(picked, candidates[i])
+	}
+	return picked
+}
+
+// PR по pull_request_id и репозиторию; без репозитория pull_request_id должен быть однозначен среди всех репозиториев,
+// пустой репозиторий - PR вне репозиториев
+func findPullRequest(ctx context.Context, prs *postgresrepository.PReqRepository, repository *string, id string) (*models.PullRequest, *serviceerrors.ServiceError) {
+	if repository != nil {
+		pr, err := prs.GetPullRequest(ctx, *repository, id)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, serviceerrors.ErrPRNotFound
+		}
+		if err != nil {
+			return nil, serviceerrors.ErrUnknown.Wrap(err)
+		}
+		return pr, nil
+	}
+
+	found, err := prs.FindPullRequests(ctx, id, 2)
+	if err != nil {
+		return nil, serviceerrors.ErrUnknown.Wrap(err)
+	}
+	switch len(found) {
+	case 0:
+		return nil, serviceerrors.ErrPRNotFound
+	case 1:
+		return found[0], nil
+	default:
+		return nil, serviceerrors.ErrPRAmbiguous
+	}
+}
+
+// PR по правилам findPullRequest для чтения вне сервиса (GraphQL)
+func (prserv *PReqService) FindPullRequest(ctx context.Context, repository *string, prId string) (*models.PullRequest, *serviceerrors.ServiceError) {
+	return findPullRequest(ctx, prserv.PRRepo, repository, prId)
+}
+
+// фиксирует первый ответ назначенного ревьювера
+func (prserv *PReqService) SubmitReview(ctx context.Context, repository *string, prId string, userId string) (*openapi.PullRequestDetail, *serviceerrors.ServiceError) {
+	pullRequest, serr := findPullRequest(ctx, prserv.PRRepo, repository, prId)
+	if serr != nil {
+		return nil, serr
+	}
+	if pullRequest.Status != "OPEN" {
+		return nil, serviceerrors.ErrPRMerged
+	}
+
+	var reviewer *models.User
+	for _, r := range pullRequest.AssignedReviewers {
+		if r.UserCustomID == userId {
+			reviewer = r
+			break
+		}
+	}
+	if reviewer == nil {
+		return nil, serviceerrors.ErrNotAssigned
+	}
+
+	err := prserv.Tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := prserv.PRRepo.MarkReviewerResponded(ctx, pullRequest.ID, reviewer.ID, time.Now().Unix()); err != nil {
+			return err
+		}
+		return prserv.Audit.Record(ctx, &models.AuditEntry{
+			Action:              models.AuditReviewSubmitted,
+			PullRequestCustomID: pullRequest.PullRequestCustomID,
+			Repository:          pullRequest.RepositoryName(),
+			UserCustomID:        reviewer.UserCustomID,
+		})
+	})
+	if err != nil {
+		return nil, serviceerrors.ErrUnknown.Wrap(err)
+	}
+
+	return prserv.pullRequestDetail(ctx, pullRequest)
+}
+
+func (prserv *PReqService) GetPullReqsByReviever(ctx context.Context, params openapi.GetUsersGetReviewParams) (*models.PullRequestSearch, *serviceerrors.ServiceError) {
+	filter, serr := newPullRequestFilter(params.Limit, params.Status, params.AuthorId, params.TeamName, params.CreatedFrom, params.CreatedTo, params.Sort)
+	if serr != nil {
+		return nil, serr
+	}
+	filter.ReviewerCustomID = params.UserId
+	if serr := applyPullRequestCursor(&filter, params.Cursor); serr != nil {
+		return nil, serr
+	}
+
+	prList, err := prserv.PRRepo.ListPullRequests(ctx, filter)
+	if err != nil {
+		return nil, serviceerrors.ErrUnknown.Wrap(err)
+	}
+
+	page, next := pullRequestPage(prList, filter)
+	return &models.PullRequestSearch{
+		PullRequest: page,
+		Author:      params.UserId,
+		NextCursor:  next,
+	}, nil
+}
+
+func (prserv *PReqService) ListPullRequests(ctx context.Context, params openapi.GetPullRequestListParams) (*models.PullRequestList, *serviceerrors.ServiceError) {
+	filter, serr := newPullRequestFilter(params.Limit, params.Status, params.AuthorId, params.TeamName, params.CreatedFrom, params.CreatedTo, params.Sort)
+	if serr != nil {
+		return nil, serr
+	}
+	if serr := applyPullRequestCursor(&filter, params.Cursor); serr != nil {
+		return nil, serr
+	}
+
+	prList, err := prserv.PRRepo.ListPullRequests(ctx, filter)
 	if err != nil {
-		return nil, serviceerrors.ErrUnknown
+		return nil, serviceerrors.ErrUnknown.Wrap(err)
 	}
 
-	resp := &models.PullRequestSearch{
-		PullRequest: make([]*openapi.PullRequestShort, 0, len(prList)),
-		Author:      reviewer_id,
+	page, next := pullRequestPage(prList, filter)
+	return &models.PullRequestList{
+		PullRequest: page,
+		NextCursor:  next,
+	}, nil
+}
+
+func (prserv *PReqService) GetPullRequest(ctx context.Context, repository *string, prId string) (*openapi.PullRequestDetail, *serviceerrors.ServiceError) {
+	pullRequest, serr := findPullRequest(ctx, prserv.PRRepo, repository, prId)
+	if serr != nil {
+		return nil, serr
 	}
+	return prserv.pullRequestDetail(ctx, pullRequest)
+}
+
+// PR с ревьюверами и краткой историей из журнала аудита
+func (prserv *PReqService) pullRequestDetail(ctx context.Context, pullRequest *models.PullRequest) (*openapi.PullRequestDetail, *serviceerrors.ServiceError) {
+	assignments, err := prserv.PRRepo.ListReviewerAssignments(ctx, pullRequest.ID)
+	if err != nil {
+		return nil, serviceerrors.ErrUnknown.Wrap(err)
+	}
+
+	crAt := time.Unix(pullRequest.CreatedAt, 0)
+	var mrAt *time.Time
+	if pullRequest.MergedAt != nil {
+		t := time.Unix(*pullRequest.MergedAt, 0)
+		mrAt = &t
+	}
+
+	authorID := pullRequest.Author.UserCustomID
+	resp := &openapi.PullRequestDetail{
+		AuthorId:        authorID,
+		CreatedAt:       &crAt,
+		MergedAt:        mrAt,
+		PullRequestId:   pullRequest.PullRequestCustomID,
+		Repository:      ptr.Optional(pullRequest.RepositoryName()),
+		PullRequestName: pullRequest.PullRequestName,
+		Status:          openapi.PullRequestDetailStatus(pullRequest.Status),
+		Reviewers:       make([]openapi.ReviewerAssignment, 0, len(assignments)),
+		History:         make([]openapi.PullRequestEvent, 0),
+	}
+	for _, a := range assignments {
+		reviewer := openapi.ReviewerAssignment{
+			UserId:     a.UserCustomID,
+			Username:   a.Nickname,
+			AssignedAt: time.Unix(a.AssignedAt, 0),
+		}
+		if a.RespondedAt != nil {
+			t := time.Unix(*a.RespondedAt, 0)
+			reviewer.RespondedAt = &t
+		}
+		resp.Reviewers = append(resp.Reviewers, reviewer)
+	}
+
+	repository := pullRequest.RepositoryName()
+	entries, err := prserv.Audit.AuditRepo.List(ctx, models.AuditFilter{PullRequestCustomID: pullRequest.PullRequestCustomID, Repository: &repository})
+	if err != nil {
+		return nil, serviceerrors.ErrUnknown.Wrap(err)
+	}
+	for _, e := range entries {
+		at := time.Unix(e.CreatedAt, 0)
+		switch e.Action {
+		case models.AuditPRCreated:
+			resp.History = append(resp.History, openapi.PullRequestEvent{Event: openapi.PullRequestEventEventCREATED, At: at, UserId: &authorID})
+		case models.AuditReviewerAssigned:
+			resp.History = append(resp.History, openapi.PullRequestEvent{Event: openapi.PullRequestEventEventREVIEWERASSIGNED, At: at, UserId: ptr.Optional(e.UserCustomID)})
+		case models.AuditReviewerReassigned:
+			resp.History = append(resp.History, openapi.PullRequestEvent{
+				Event:         openapi.PullRequestEventEventREVIEWERREASSIGNED,
+				At:            at,
+				UserId:        ptr.Optional(e.NewReviewerID),
+				OldReviewerId: ptr.Optional(e.OldReviewerID),
+				Reason:        ptr.Optional(e.Reason),
+			})
+		case models.AuditReviewSubmitted:
+			resp.History = append(resp.History, openapi.PullRequestEvent{Event: openapi.PullRequestEventEventREVIEWSUBMITTED, At: at, UserId: ptr.Optional(e.UserCustomID)})
+		case models.AuditSLABreached:
+			resp.History = append(resp.History, openapi.PullRequestEvent{Event: openapi.PullRequestEventEventSLABREACHED, At: at, UserId: ptr.Optional(e.UserCustomID), Reason: ptr.Optional(e.Reason)})
+		case models.AuditPRMerged:
+			resp.History = append(resp.History, openapi.PullRequestEvent{Event: openapi.PullRequestEventEventMERGED, At: at})
+		}
+	}
+	return resp, nil
+}
+
+func (prserv *PReqService) SearchPullRequests(ctx context.Context, params openapi.GetPullRequestSearchParams) (*models.PullRequestList, *serviceerrors.ServiceError) {
+	query := strings.TrimSpace(params.Q)
+	if query == "" {
+		return nil, serviceerrors.ErrInvalidQuery
+	}
+
+	filter, serr := newPullRequestFilter(params.Limit, params.Status, nil, params.TeamName, nil, nil, nil)
+	if serr != nil {
+		return nil, serr
+	}
+	filter.Query = query
+
+	prList, err := prserv.PRRepo.SearchPullRequests(ctx, filter)
+	if err != nil {
+		return nil, serviceerrors.ErrUnknown.Wrap(err)
+	}
+
+	page, _ := pullRequestPage(prList, filter)
+	return &models.PullRequestList{PullRequest: page}, nil
+}
+
+const (
+	defaultPageLimit = 20
+	maxPageLimit     = 100
+)
+
+func newPullRequestFilter(limit *int, status *openapi.PullRequestStatusFilter, authorID, teamName *string, createdFrom, createdTo *time.Time, sort *openapi.PullRequestSort) (models.PullRequestFilter, *serviceerrors.ServiceError) {
+	filter := models.PullRequestFilter{
+		Limit: defaultPageLimit,
+		Sort:  openapi.CreatedAtDesc,
+	}
+	if limit != nil {
+		if *limit < 1 || *limit > maxPageLimit {
+			return filter, serviceerrors.ErrInvalidLimit
+		}
+		filter.Limit = *limit
+	}
+	if sort != nil && *sort != "" {
+		filter.Sort = *sort
+	}
+	if status != nil {
+		filter.Status = string(*status)
+	}
+	if authorID != nil {
+		filter.AuthorCustomID = *authorID
+	}
+	if teamName != nil {
+		filter.TeamName = *teamName
+	}
+	if createdFrom != nil {
+		from := createdFrom.Unix()
+		filter.CreatedFrom = &from
+	}
+	if createdTo != nil {
+		to := createdTo.Unix()
+		filter.CreatedTo = &to
+	}
+	return filter, nil
+}
+
+// курсор продолжает выдачу только с той же сортировкой и теми же фильтрами, с которыми был выдан;
+// вызывается после того, как заданы все условия filter
+func applyPullRequestCursor(filter *models.PullRequestFilter, cursor *string) *serviceerrors.ServiceError {
+	if cursor == nil || *cursor == "" {
+		return nil
+	}
+	p, err := decodePullRequestCursor(*cursor)
+	if err != nil {
+		return serviceerrors.ErrInvalidCursor
+	}
+	if p.Sort != string(filter.Sort) {
+		return serviceerrors.ErrInvalidCursor.WithMessage("cursor was issued for a different sort")
+	}
+	if p.Filter != pullRequestFilterHash(*filter) {
+		return serviceerrors.ErrInvalidCursor.WithMessage("cursor was issued for different filters")
+	}
+	filter.After = &models.PullRequestCursor{CreatedAt: p.CreatedAt, Name: p.Name, ID: p.ID}
+	return nil
+}
+
+// обрезает лишнюю запись, запрошенную репозиторием, и строит курсор по последнему элементу страницы
+func pullRequestPage(prList []*models.PullRequest, filter models.PullRequestFilter) ([]*openapi.PullRequestShort, *string) {
+	var next *string
+	if filter.Limit > 0 && len(prList) > filter.Limit {
+		prList = prList[:filter.Limit]
+		last := prList[len(prList)-1]
+		cursor := encodePullRequestCursor(pullRequestCursorPayload{
+			CreatedAt: last.CreatedAt,
+			Name:      last.PullRequestName,
+			ID:        last.ID,
+			Sort:      string(filter.Sort),
+			Filter:    pullRequestFilterHash(filter),
+		})
+		next = &cursor
+	}
+
+	page := make([]*openapi.PullRequestShort, 0, len(prList))
 	for _, pr := range prList {
-		resp.PullRequest = append(resp.PullRequest, &openapi.PullRequestShort{
+		crAt := time.Unix(pr.CreatedAt, 0)
+		page = append(page, &openapi.PullRequestShort{
 			AuthorId:        pr.Author.UserCustomID,
+			CreatedAt:       &crAt,
 			PullRequestId:   pr.PullRequestCustomID,
+			Repository:      ptr.Optional(pr.RepositoryName()),
 			PullRequestName: pr.PullRequestName,
 			Status:          openapi.PullRequestShortStatus(pr.Status),
 		})
 	}
+	return page, next
+}
 
-	return resp, nil
+// позиция в выдаче, сортировка и отпечаток фильтров, с которыми курсор выдан
+type pullRequestCursorPayload struct {
+	CreatedAt int64     `json:"c"`
+	Name      string    `json:"n"`
+	ID        uuid.UUID `json:"i"`
+	Sort      string    `json:"s"`
+	Filter    string    `json:"f"`
+}
 
+// отпечаток условий выборки; размер страницы в него не входит и между страницами может меняться
+func pullRequestFilterHash(f models.PullRequestFilter) string {
+	bound := func(v *int64) string {
+		if v == nil {
+			return ""
+		}
+		return strconv.FormatInt(*v, 10)
+	}
+	h := sha256.New()
+	for _, part := range []string{f.ReviewerCustomID, f.AuthorCustomID, f.TeamName, f.Status, bound(f.CreatedFrom), bound(f.CreatedTo), f.Query} {
+		h.Write([]byte(part))
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil)[:8])
+}
+
+func encodePullRequestCursor(p pullRequestCursorPayload) string {
+	raw, _ := json.Marshal(p)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+func decodePullRequestCursor(s string) (*pullRequestCursorPayload, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	var p pullRequestCursorPayload
+	if err := json.Unmarshal(raw, &p); err != nil {
+		return nil, err
+	}
+	if p.ID == uuid.Nil {
+		return nil, errors.New("cursor without id")
+	}
+	return &p, nil
 }
 
 func (prserv *PReqService) CountAssignmentsPerUser(ctx context.Context) (map[string]int64, *serviceerrors.ServiceError) {
 	res, err := prserv.PRRepo.CountAssignmentsPerUser(ctx)
 	if err != nil {
-		return nil, serviceerrors.ErrUnknown
+		return nil, serviceerrors.ErrUnknown.Wrap(err)
 	}
 	return res, nil
 }
@@ -251,7 +700,7 @@ func (prserv *PReqService) CountAssignmentsPerUser(ctx context.Context) (map[str
 func (prserv *PReqService) CountAssignmentsPerPR(ctx context.Context) (map[string]int64, *serviceerrors.ServiceError) {
 	res, err := prserv.PRRepo.CountAssignmentsPerPR(ctx)
 	if err != nil {
-		return nil, serviceerrors.ErrUnknown
+		return nil, serviceerrors.ErrUnknown.Wrap(err)
 	}
 	return res, nil
 }
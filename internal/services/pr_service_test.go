@@ -0,0 +1,67 @@
+package services
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	openapi "github.com/wozhdeleniye/avito-tech-internship/api"
+	serviceerrors "github.com/wozhdeleniye/avito-tech-internship/internal/pkg/errors"
+	"github.com/wozhdeleniye/avito-tech-internship/internal/repo/models"
+)
+
+func listFilter(t *testing.T, status openapi.PullRequestStatusFilter, sort openapi.PullRequestSort) models.PullRequestFilter {
+	t.Helper()
+	limit := 1
+	filter, serr := newPullRequestFilter(&limit, &status, nil, nil, nil, nil, &sort)
+	if serr != nil {
+		t.Fatal(serr)
+	}
+	return filter
+}
+
+func TestPullRequestCursorKeepsSortAndFilters(t *testing.T) {
+	filter := listFilter(t, openapi.OPEN, openapi.NameAsc)
+	prs := []*models.PullRequest{
+		{ID: uuid.New(), PullRequestName: "a", CreatedAt: 1},
+		{ID: uuid.New(), PullRequestName: "b", CreatedAt: 2},
+	}
+	_, next := pullRequestPage(prs, filter)
+	if next == nil {
+		t.Fatal("у неполной выдачи должен быть курсор")
+	}
+
+	same := listFilter(t, openapi.OPEN, openapi.NameAsc)
+	// размер страницы между запросами можно менять
+	same.Limit = 50
+	if serr := applyPullRequestCursor(&same, next); serr != nil {
+		t.Fatalf("курсор с теми же условиями отклонён: %v", serr)
+	}
+	if same.After == nil || same.After.ID != prs[0].ID || same.After.Name != "a" {
+		t.Fatalf("курсор указывает не на последний элемент страницы: %+v", same.After)
+	}
+
+	garbage := "not-a-cursor"
+	cases := []struct {
+		name   string
+		filter models.PullRequestFilter
+		cursor *string
+	}{
+		{name: "другая сортировка", filter: listFilter(t, openapi.OPEN, openapi.CreatedAtDesc), cursor: next},
+		{name: "другой статус", filter: listFilter(t, openapi.MERGED, openapi.NameAsc), cursor: next},
+		{name: "другой ревьювер", filter: func() models.PullRequestFilter {
+			f := listFilter(t, openapi.OPEN, openapi.NameAsc)
+			f.ReviewerCustomID = "u1"
+			return f
+		}(), cursor: next},
+		{name: "не курсор", filter: listFilter(t, openapi.OPEN, openapi.NameAsc), cursor: &garbage},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			serr := applyPullRequestCursor(&c.filter, c.cursor)
+			if serr == nil || !errors.Is(serr, serviceerrors.ErrInvalidCursor) || serr.HTTPCode != 400 {
+				t.Fatalf("ожидалась 400 INVALID_CURSOR, получено %v", serr)
+			}
+		})
+	}
+}
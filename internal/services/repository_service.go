@@ -0,0 +1,100 @@
+package services
+
+import (
+	"context"
+	"errors"
+
+	openapi "github.com/wozhdeleniye/avito-tech-internship/api"
+	serviceerrors "github.com/wozhdeleniye/avito-tech-internship/internal/pkg/errors"
+	"github.com/wozhdeleniye/avito-tech-internship/internal/repo/models"
+	postgresrepository "github.com/wozhdeleniye/avito-tech-internship/internal/repo/repositories/postgres_repository"
+	"gorm.io/gorm"
+)
+
+// репозитории кода и их политика назначения ревьюверов; саму политику применяет PReqService
+type RepositoryService struct {
+	RepositoryRepo *postgresrepository.RepositoryRepository
+	TeamRepo       *postgresrepository.TeamRepository
+}
+
+func NewRepositoryService(repositoryRepo *postgresrepository.RepositoryRepository, teamRepo *postgresrepository.TeamRepository) *RepositoryService {
+	return &RepositoryService{
+		RepositoryRepo: repositoryRepo,
+		TeamRepo:       teamRepo,
+	}
+}
+
+func (s *RepositoryService) CreateRepository(ctx context.Context, req openapi.Repository) (*openapi.Repository, *serviceerrors.ServiceError) {
+	team, err := s.TeamRepo.FindTeamByName(ctx, req.OwnerTeam)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, serviceerrors.ErrTeamNotFound
+	}
+	if err != nil {
+		return nil, serviceerrors.ErrUnknown.Wrap(err)
+	}
+
+	repo := &models.Repository{Name: req.Name, OwnerTeamID: team.ID, OwnerTeam: *team}
+	if req.ReviewerPolicy != nil {
+		repo.ReviewerSource, repo.ReviewersCount = reviewerPolicy(req.ReviewerPolicy)
+	}
+	if err := s.RepositoryRepo.CreateRepository(ctx, repo); err != nil {
+		if errors.Is(err, postgresrepository.ErrRepositoryExists) {
+			return nil, serviceerrors.ErrRepositoryExists
+		}
+		return nil, serviceerrors.ErrUnknown.Wrap(err)
+	}
+	return repositoryResponse(repo), nil
+}
+
+func (s *RepositoryService) GetRepository(ctx context.Context, name string) (*openapi.Repository, *serviceerrors.ServiceError) {
+	repo, serr := s.findRepository(ctx, name)
+	if serr != nil {
+		return nil, serr
+	}
+	return repositoryResponse(repo), nil
+}
+
+// заменяет переопределения политики; не переданные поля возвращаются к значениям по умолчанию
+func (s *RepositoryService) SetReviewerPolicy(ctx context.Context, name string, policy openapi.ReviewerPolicy) (*openapi.Repository, *serviceerrors.ServiceError) {
+	repo, serr := s.findRepository(ctx, name)
+	if serr != nil {
+		return nil, serr
+	}
+	source, count := reviewerPolicy(&policy)
+	if err := s.RepositoryRepo.SetReviewerPolicy(ctx, repo, source, count); err != nil {
+		return nil, serviceerrors.ErrUnknown.Wrap(err)
+	}
+	return repositoryResponse(repo), nil
+}
+
+func (s *RepositoryService) findRepository(ctx context.Context, name string) (*models.Repository, *serviceerrors.ServiceError) {
+	repo, err := s.RepositoryRepo.FindRepositoryByName(ctx, name)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, serviceerrors.ErrRepositoryNotFound
+	}
+	if err != nil {
+		return nil, serviceerrors.ErrUnknown.Wrap(err)
+	}
+	return repo, nil
+}
+
+func reviewerPolicy(policy *openapi.ReviewerPolicy) (*string, *int) {
+	var source *string
+	if policy.ReviewerSource != nil {
+		s := string(*policy.ReviewerSource)
+		source = &s
+	}
+	return source, policy.ReviewersCount
+}
+
+func repositoryResponse(repo *models.Repository) *openapi.Repository {
+	resp := &openapi.Repository{Name: repo.Name, OwnerTeam: repo.OwnerTeam.TeamName}
+	if repo.ReviewerSource != nil || repo.ReviewersCount != nil {
+		resp.ReviewerPolicy = &openapi.ReviewerPolicy{ReviewersCount: repo.ReviewersCount}
+		if repo.ReviewerSource != nil {
+			source := openapi.ReviewerSource(*repo.ReviewerSource)
+			resp.ReviewerPolicy.ReviewerSource = &source
+		}
+	}
+	return resp
+}
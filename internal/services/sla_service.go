@@ -0,0 +1,217 @@
+package services
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/wozhdeleniye/avito-tech-internship/internal/pkg/clock"
+	serviceerrors "github.com/wozhdeleniye/avito-tech-internship/internal/pkg/errors"
+	"github.com/wozhdeleniye/avito-tech-internship/internal/repo/models"
+	postgresrepository "github.com/wozhdeleniye/avito-tech-internship/internal/repo/repositories/postgres_repository"
+)
+
+// получатель напоминаний о нарушении SLA
+type SLANotifier interface {
+	Remind(ctx context.Context, breach *models.SLABreach) error
+}
+
+// напоминания в лог сервиса
+type LogNotifier struct{}
+
+func (LogNotifier) Remind(ctx context.Context, breach *models.SLABreach) error {
+	log.Printf("sla: reviewer %s has not responded on PR %s (team %s, deadline %s, outcome %s)",
+		breach.ReviewerCustomID, breach.PullRequestCustomID, breach.TeamName, time.Unix(breach.Deadline, 0).UTC().Format(time.RFC3339), breach.Outcome)
+	return nil
+}
+
+// напоминания в лог и в поток событий (sla.reminder ревьюверу и команде), чтобы IDE-плагин и дашборд их показали
+type EventNotifier struct {
+	Events *EventBus
+	PRRepo *postgresrepository.PReqRepository
+}
+
+func NewEventNotifier(events *EventBus, prRepo *postgresrepository.PReqRepository) *EventNotifier {
+	return &EventNotifier{Events: events, PRRepo: prRepo}
+}
+
+func (n *EventNotifier) Remind(ctx context.Context, breach *models.SLABreach) error {
+	_ = LogNotifier{}.Remind(ctx, breach)
+	pr, err := n.PRRepo.GetPullRequest(ctx, breach.Repository, breach.PullRequestCustomID)
+	if err != nil {
+		return err
+	}
+	n.Events.PublishAfterCommit(ctx, slaReminderEvent(pr, breach))
+	return nil
+}
+
+type SLAService struct {
+	SLARepo          *postgresrepository.SLARepository
+	OrganizationRepo *postgresrepository.OrganizationRepository
+	PRService        *PReqService
+	Audit            *AuditService
+	Tx               *postgresrepository.TxManager
+	Clock            clock.Clock
+	Notifier         SLANotifier
+}
+
+func NewSLAService(slaRepo *postgresrepository.SLARepository, organizationRepo *postgresrepository.OrganizationRepository, prService *PReqService, audit *AuditService, tx *postgresrepository.TxManager, clk clock.Clock, notifier SLANotifier) *SLAService {
+	return &SLAService{
+		SLARepo:          slaRepo,
+		OrganizationRepo: organizationRepo,
+		PRService:        prService,
+		Audit:            audit,
+		Tx:               tx,
+		Clock:            clk,
+		Notifier:         notifier,
+	}
+}
+
+// проверяет SLA каждые interval, пока не отменён ctx
+func (s *SLAService) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := s.Scan(ctx); err != nil {
+			log.Printf("sla: scan failed: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// один проход по всем организациям: фиксирует просроченные назначения и выполняет действие из политики команды автора.
+// Ошибка одной организации пишется в лог и не мешает проверить остальные
+func (s *SLAService) Scan(ctx context.Context) ([]*models.SLABreach, error) {
+	ctx = WithActor(ctx, SystemActor)
+
+	orgs, err := s.OrganizationRepo.ListOrganizations(ctx)
+	if err != nil {
+		return nil, err
+	}
+	breaches := make([]*models.SLABreach, 0)
+	for _, org := range orgs {
+		found, err := s.scanOrganization(WithOrganization(ctx, org.ID))
+		if err != nil {
+			log.Printf("sla: organization %s: %v", org.Slug, err)
+			continue
+		}
+		breaches = append(breaches, found...)
+	}
+	return breaches, nil
+}
+
+func (s *SLAService) scanOrganization(ctx context.Context) ([]*models.SLABreach, error) {
+	pending, err := s.SLARepo.ListPendingAssignments(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	now := s.Clock.Now()
+	breaches := make([]*models.SLABreach, 0)
+	for _, a := range overdueAssignments(pending, now) {
+		breach, err := s.handleBreach(ctx, a, now)
+		if err != nil {
+			log.Printf("sla: PR %s, reviewer %s: %v", a.PullRequestCustomID, a.ReviewerCustomID, err)
+			continue
+		}
+		if breach == nil {
+			continue
+		}
+		breaches = append(breaches, breach)
+
+		if breach.Outcome != models.SLAOutcomeReassigned {
+			if err := s.Notifier.Remind(ctx, breach); err != nil {
+				log.Printf("sla: reminder for PR %s failed: %v", breach.PullRequestCustomID, err)
+			}
+		}
+	}
+	return breaches, nil
+}
+
+// nil, если нарушение уже зафиксировано или назначение перестало быть актуальным
+func (s *SLAService) handleBreach(ctx context.Context, a *models.PendingAssignment, now time.Time) (*models.SLABreach, error) {
+	breach := &models.SLABreach{
+		PullRequestCustomID: a.PullRequestCustomID,
+		Repository:          a.Repository,
+		ReviewerCustomID:    a.ReviewerCustomID,
+		AssignedAt:          a.AssignedAt,
+		TeamName:            a.TeamName,
+		Deadline:            slaDeadline(a).Unix(),
+		Outcome:             models.SLAOutcomeReminded,
+		DetectedAt:          now.Unix(),
+	}
+
+	recorded := false
+	err := s.Tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		recorded, err = s.SLARepo.RecordBreach(ctx, breach)
+		if err != nil || !recorded {
+			return err
+		}
+
+		entry := &models.AuditEntry{
+			Action:              models.AuditSLABreached,
+			PullRequestCustomID: a.PullRequestCustomID,
+			Repository:          a.Repository,
+			UserCustomID:        a.ReviewerCustomID,
+			TeamName:            a.TeamName,
+			Reason:              models.ReasonSLABreach,
+		}
+
+		if a.SLAAction == models.SLAActionReassign {
+			pr, err := s.PRService.PRRepo.GetPullRequest(ctx, a.Repository, a.PullRequestCustomID)
+			if err != nil {
+				return err
+			}
+			_, serr := s.PRService.reassignReviewer(ctx, pr, a.ReviewerCustomID, models.ReasonSLABreach)
+			switch serr {
+			case nil:
+				breach.Outcome = models.SLAOutcomeReassigned
+			case serviceerrors.ErrNoCandidate:
+				breach.Outcome = models.SLAOutcomeNoCandidate
+			default:
+				return serr
+			}
+			if err := s.SLARepo.SetOutcome(ctx, breach); err != nil {
+				return err
+			}
+		}
+		return s.Audit.Record(ctx, entry)
+	})
+	if err != nil || !recorded {
+		return nil, err
+	}
+	return breach, nil
+}
+
+func (s *SLAService) CountBreachesPerTeam(ctx context.Context) (map[string]int64, *serviceerrors.ServiceError) {
+	res, err := s.SLARepo.CountBreachesPerTeam(ctx)
+	if err != nil {
+		return nil, serviceerrors.ErrUnknown.Wrap(err)
+	}
+	return res, nil
+}
+
+// назначения, срок ответа по которым истёк к моменту now
+func overdueAssignments(pending []*models.PendingAssignment, now time.Time) []*models.PendingAssignment {
+	overdue := make([]*models.PendingAssignment, 0)
+	for _, a := range pending {
+		if a.SLAHours <= 0 {
+			continue
+		}
+		if !now.Before(slaDeadline(a)) {
+			overdue = append(overdue, a)
+		}
+	}
+	return overdue
+}
+
+func slaDeadline(a *models.PendingAssignment) time.Time {
+	return time.Unix(a.AssignedAt, 0).Add(time.Duration(a.SLAHours) * time.Hour)
+}
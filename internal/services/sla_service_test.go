@@ -0,0 +1,194 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	openapi "github.com/wozhdeleniye/avito-tech-internship/api"
+	"github.com/wozhdeleniye/avito-tech-internship/internal/config"
+	"github.com/wozhdeleniye/avito-tech-internship/internal/pkg/clock"
+	"github.com/wozhdeleniye/avito-tech-internship/internal/pkg/db/database"
+	"github.com/wozhdeleniye/avito-tech-internship/internal/repo/migrations"
+	"github.com/wozhdeleniye/avito-tech-internship/internal/repo/models"
+	postgresrepository "github.com/wozhdeleniye/avito-tech-internship/internal/repo/repositories/postgres_repository"
+)
+
+type fixedClock time.Time
+
+func (c fixedClock) Now() time.Time {
+	return time.Time(c)
+}
+
+var _ clock.Clock = fixedClock{}
+
+func TestOverdueAssignments(t *testing.T) {
+	assigned := time.Date(2025, 10, 24, 12, 0, 0, 0, time.UTC)
+	pending := []*models.PendingAssignment{
+		{PullRequestCustomID: "pr-1", ReviewerCustomID: "u1", AssignedAt: assigned.Unix(), SLAHours: 24},
+		{PullRequestCustomID: "pr-2", ReviewerCustomID: "u2", AssignedAt: assigned.Unix(), SLAHours: 48},
+		{PullRequestCustomID: "pr-3", ReviewerCustomID: "u3", AssignedAt: assigned.Unix(), SLAHours: 0},
+	}
+
+	cases := []struct {
+		name string
+		now  time.Time
+		want []string
+	}{
+		{name: "до срока", now: assigned.Add(23*time.Hour + 59*time.Minute), want: []string{}},
+		{name: "ровно в срок", now: assigned.Add(24 * time.Hour), want: []string{"pr-1"}},
+		{name: "оба просрочены", now: assigned.Add(72 * time.Hour), want: []string{"pr-1", "pr-2"}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var clk clock.Clock = fixedClock(tc.now)
+			got := overdueAssignments(pending, clk.Now())
+			if len(got) != len(tc.want) {
+				t.Fatalf("ожидалось %v, получено %d назначений", tc.want, len(got))
+			}
+			for i, a := range got {
+				if a.PullRequestCustomID != tc.want[i] {
+					t.Fatalf("ожидалось %v, получено %s на позиции %d", tc.want, a.PullRequestCustomID, i)
+				}
+			}
+		})
+	}
+}
+
+// сервисы поверх базы из DB_*, каждый тест - в своей новой организации; без базы тест пропускается
+func newSLATestEnv(t *testing.T) (context.Context, *SLAService, *TeamService) {
+	t.Helper()
+	cfg := config.Load().Database
+	db, err := database.NewPostgresConnection(cfg.Host, cfg.Port, cfg.User, cfg.Password, cfg.DBName, cfg.SSLMode)
+	if err != nil {
+		t.Skipf("база недоступна: %v", err)
+	}
+	if err := migrations.NewGormMigrator(db, false).Migrate(); err != nil {
+		t.Fatalf("миграция не удалась: %v", err)
+	}
+
+	orgRepo := postgresrepository.NewOrganizationRepository(db)
+	org := &models.Organization{Slug: "sla-test-" + uuid.NewString()[:8], Name: "SLA test"}
+	if err := orgRepo.CreateOrganization(context.Background(), org); err != nil {
+		t.Fatalf("организация не создана: %v", err)
+	}
+	ctx := WithActor(WithOrganization(context.Background(), org.ID), SystemActor)
+
+	prRepo, teamRepo, userRepo := postgresrepository.NewPReqRepository(db), postgresrepository.NewTeamRepository(db), postgresrepository.NewUserRepository(db)
+	tx := postgresrepository.NewTxManager(db)
+	audit := NewAuditService(postgresrepository.NewAuditRepository(db), prRepo)
+	events := NewEventBus(100)
+	prs := NewPReqService(prRepo, teamRepo, userRepo, postgresrepository.NewRepositoryRepository(db), tx, audit, events)
+	teams := NewTeamService(prRepo, teamRepo, userRepo, postgresrepository.NewChangesetRepository(db), tx, audit, events)
+	sla := NewSLAService(postgresrepository.NewSLARepository(db), orgRepo, prs, audit, tx, fixedClock(time.Now()), NewEventNotifier(events, prRepo))
+	return ctx, sla, teams
+}
+
+// команда из size участников с политикой reassign и PR её первого участника; назначения PR на ревью
+func overdueReviews(t *testing.T, ctx context.Context, sla *SLAService, teams *TeamService, team string, size int) []*models.PendingAssignment {
+	t.Helper()
+	members := make([]openapi.TeamMember, 0, size)
+	for i := 0; i < size; i++ {
+		members = append(members, openapi.TeamMember{UserId: fmt.Sprintf("%s-u%d", team, i), Username: "sla", IsActive: true})
+	}
+	if _, serr := teams.SyncTeam(ctx, openapi.Team{TeamName: team, Members: members}, false, false); serr != nil {
+		t.Fatalf("команда не создана: %v", serr)
+	}
+	if _, serr := teams.SetSLA(ctx, team, 24, openapi.Reassign); serr != nil {
+		t.Fatalf("SLA не задан: %v", serr)
+	}
+	if _, serr := sla.PRService.CreatePullRequest(ctx, openapi.PostPullRequestCreateJSONBody{PullRequestId: team + "-pr", PullRequestName: "sla", AuthorId: members[0].UserId}); serr != nil {
+		t.Fatalf("PR не создан: %v", serr)
+	}
+	pending, err := sla.SLARepo.ListPendingAssignments(ctx)
+	if err != nil || len(pending) != 2 {
+		t.Fatalf("ожидалось 2 назначения, получено %d, %v", len(pending), err)
+	}
+	return pending
+}
+
+func reviewersOf(t *testing.T, ctx context.Context, sla *SLAService, prID string) []string {
+	t.Helper()
+	pr, err := sla.PRService.PRRepo.GetPullRequest(ctx, "", prID)
+	if err != nil {
+		t.Fatalf("PR не найден: %v", err)
+	}
+	ids := make([]string, 0, len(pr.AssignedReviewers))
+	for _, u := range pr.AssignedReviewers {
+		ids = append(ids, u.UserCustomID)
+	}
+	return ids
+}
+
+func TestHandleBreachReassignsOnce(t *testing.T) {
+	ctx, sla, teams := newSLATestEnv(t)
+	// автор, два ревьювера и один свободный кандидат
+	a := overdueReviews(t, ctx, sla, teams, "sla-reassign", 4)[0]
+	now := time.Unix(a.AssignedAt, 0).Add(25 * time.Hour)
+
+	breach, err := sla.handleBreach(ctx, a, now)
+	if err != nil || breach == nil || breach.Outcome != models.SLAOutcomeReassigned {
+		t.Fatalf("ожидалось переназначение, получено %+v, %v", breach, err)
+	}
+	reviewers := reviewersOf(t, ctx, sla, a.PullRequestCustomID)
+	if len(reviewers) != 2 || slices.Contains(reviewers, a.ReviewerCustomID) {
+		t.Fatalf("ревьювер %s должен быть заменён, ревьюверы %v", a.ReviewerCustomID, reviewers)
+	}
+
+	// нарушение уже зафиксировано: повторный проход ничего не делает
+	again, err := sla.handleBreach(ctx, a, now)
+	if err != nil || again != nil {
+		t.Fatalf("повторное нарушение должно пропускаться, получено %+v, %v", again, err)
+	}
+	if got := reviewersOf(t, ctx, sla, a.PullRequestCustomID); !slices.Equal(got, reviewers) {
+		t.Fatalf("повторный проход изменил ревьюверов: %v и %v", reviewers, got)
+	}
+}
+
+func TestHandleBreachWithoutCandidate(t *testing.T) {
+	ctx, sla, teams := newSLATestEnv(t)
+	// автор и два ревьювера, заменить некем
+	a := overdueReviews(t, ctx, sla, teams, "sla-no-candidate", 3)[0]
+	before := reviewersOf(t, ctx, sla, a.PullRequestCustomID)
+
+	breach, err := sla.handleBreach(ctx, a, time.Unix(a.AssignedAt, 0).Add(25*time.Hour))
+	if err != nil || breach == nil || breach.Outcome != models.SLAOutcomeNoCandidate {
+		t.Fatalf("ожидался исход no_candidate, получено %+v, %v", breach, err)
+	}
+	if got := reviewersOf(t, ctx, sla, a.PullRequestCustomID); !slices.Equal(got, before) {
+		t.Fatalf("ревьюверы не должны меняться: %v и %v", before, got)
+	}
+}
+
+func TestEventNotifierPublishesReminder(t *testing.T) {
+	ctx, sla, teams := newSLATestEnv(t)
+	a := overdueReviews(t, ctx, sla, teams, "sla-remind", 3)[0]
+	sub, _, _ := sla.PRService.Events.Subscribe(ctx, EventFilter{UserID: a.ReviewerCustomID}, "")
+	defer sub.Close()
+
+	deadline := slaDeadline(a)
+	breach := &models.SLABreach{
+		PullRequestCustomID: a.PullRequestCustomID,
+		ReviewerCustomID:    a.ReviewerCustomID,
+		TeamName:            a.TeamName,
+		Deadline:            deadline.Unix(),
+		Outcome:             models.SLAOutcomeReminded,
+	}
+	if err := sla.Notifier.Remind(ctx, breach); err != nil {
+		t.Fatalf("напоминание не отправлено: %v", err)
+	}
+
+	select {
+	case e := <-sub.Events():
+		if e.Type != EventSLAReminder || e.PullRequestID != a.PullRequestCustomID || e.ReviewerID != a.ReviewerCustomID ||
+			e.AuthorID == "" || e.Deadline == nil || !e.Deadline.Equal(deadline) {
+			t.Fatalf("неожиданное событие: %+v", e)
+		}
+	default:
+		t.Fatal("событие sla.reminder не опубликовано")
+	}
+}
@@ -0,0 +1,176 @@
+package services
+
+import (
+	"context"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/wozhdeleniye/avito-tech-internship/internal/pkg/clock"
+	serviceerrors "github.com/wozhdeleniye/avito-tech-internship/internal/pkg/errors"
+	"github.com/wozhdeleniye/avito-tech-internship/internal/repo/models"
+	postgresrepository "github.com/wozhdeleniye/avito-tech-internship/internal/repo/repositories/postgres_repository"
+)
+
+// корзины возраста открытых PR, верхняя граница не включается
+var openPRAgeBuckets = []struct {
+	Name  string
+	Upper time.Duration
+}{
+	{"lt_1d", 24 * time.Hour},
+	{"1d_3d", 3 * 24 * time.Hour},
+	{"3d_7d", 7 * 24 * time.Hour},
+	{"7d_14d", 14 * 24 * time.Hour},
+	{"gte_14d", math.MaxInt64},
+}
+
+type StatsService struct {
+	PRRepo    *postgresrepository.PReqRepository
+	AuditRepo *postgresrepository.AuditRepository
+	Clock     clock.Clock
+}
+
+func NewStatsService(prRepo *postgresrepository.PReqRepository, auditRepo *postgresrepository.AuditRepository, clk clock.Clock) *StatsService {
+	return &StatsService{
+		PRRepo:    prRepo,
+		AuditRepo: auditRepo,
+		Clock:     clk,
+	}
+}
+
+// метрики по времени за [from, to): время до merge и пропускная способность считаются по merged_at,
+// возраст открытых PR и тренд переназначений - по моменту создания PR и записи журнала
+func (s *StatsService) ReviewStats(ctx context.Context, from, to *time.Time) (*models.ReviewStats, *serviceerrors.ServiceError) {
+	fromUnix, toUnix := unixOrNil(from), unixOrNil(to)
+
+	merged, err := s.PRRepo.ListMergedPullRequests(ctx, fromUnix, toUnix)
+	if err != nil {
+		return nil, serviceerrors.ErrUnknown.Wrap(err)
+	}
+	reviews, err := s.PRRepo.ListMergedReviews(ctx, fromUnix, toUnix)
+	if err != nil {
+		return nil, serviceerrors.ErrUnknown.Wrap(err)
+	}
+	open, err := s.PRRepo.ListCreatedAt(ctx, "OPEN", fromUnix, toUnix)
+	if err != nil {
+		return nil, serviceerrors.ErrUnknown.Wrap(err)
+	}
+	created, err := s.PRRepo.ListCreatedAt(ctx, "", fromUnix, toUnix)
+	if err != nil {
+		return nil, serviceerrors.ErrUnknown.Wrap(err)
+	}
+	reassigned, err := s.AuditRepo.ListActionTimes(ctx, models.AuditReviewerReassigned, fromUnix, toUnix)
+	if err != nil {
+		return nil, serviceerrors.ErrUnknown.Wrap(err)
+	}
+
+	byTeam := make(map[string][]float64)
+	byAuthor := make(map[string][]float64)
+	for _, pr := range merged {
+		hours := float64(pr.MergedAt-pr.CreatedAt) / 3600
+		if pr.TeamName != "" {
+			byTeam[pr.TeamName] = append(byTeam[pr.TeamName], hours)
+		}
+		byAuthor[pr.AuthorCustomID] = append(byAuthor[pr.AuthorCustomID], hours)
+	}
+
+	throughput := make(map[string]map[string]int)
+	for _, r := range reviews {
+		week := weekStart(r.MergedAt)
+		if throughput[r.ReviewerCustomID] == nil {
+			throughput[r.ReviewerCustomID] = make(map[string]int)
+		}
+		throughput[r.ReviewerCustomID][week]++
+	}
+
+	now := s.Clock.Now()
+	age := make(map[string]int, len(openPRAgeBuckets))
+	for _, b := range openPRAgeBuckets {
+		age[b.Name] = 0
+	}
+	for _, createdAt := range open {
+		d := now.Sub(time.Unix(createdAt, 0))
+		for _, b := range openPRAgeBuckets {
+			if d < b.Upper {
+				age[b.Name]++
+				break
+			}
+		}
+	}
+
+	return &models.ReviewStats{
+		TimeToMergePerTeam:   percentilesByKey(byTeam),
+		TimeToMergePerAuthor: percentilesByKey(byAuthor),
+		ReviewerThroughput:   throughput,
+		OpenPRAge:            age,
+		ReassignmentTrend:    reassignmentTrend(reassigned, created),
+	}, nil
+}
+
+func percentilesByKey(samples map[string][]float64) map[string]models.DurationPercentiles {
+	res := make(map[string]models.DurationPercentiles, len(samples))
+	for key, values := range samples {
+		sort.Float64s(values)
+		res[key] = models.DurationPercentiles{
+			Count:    len(values),
+			P50Hours: percentile(values, 50),
+			P90Hours: percentile(values, 90),
+			P99Hours: percentile(values, 99),
+		}
+	}
+	return res
+}
+
+// перцентиль методом ближайшего ранга по отсортированной выборке, округлён до сотых
+func percentile(sorted []float64, p float64) float64 {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	if rank < 1 {
+		rank = 1
+	}
+	return math.Round(sorted[rank-1]*100) / 100
+}
+
+func reassignmentTrend(reassigned []int64, created []int64) []models.ReassignmentTrendPoint {
+	points := make(map[string]*models.ReassignmentTrendPoint)
+	point := func(ts int64) *models.ReassignmentTrendPoint {
+		week := weekStart(ts)
+		if points[week] == nil {
+			points[week] = &models.ReassignmentTrendPoint{WeekStart: week}
+		}
+		return points[week]
+	}
+	for _, ts := range reassigned {
+		point(ts).Reassignments++
+	}
+	for _, ts := range created {
+		point(ts).PullRequestsCreated++
+	}
+
+	trend := make([]models.ReassignmentTrendPoint, 0, len(points))
+	for _, p := range points {
+		if p.PullRequestsCreated > 0 {
+			p.ReassignmentsPerPR = math.Round(float64(p.Reassignments)/float64(p.PullRequestsCreated)*100) / 100
+		}
+		trend = append(trend, *p)
+	}
+	sort.Slice(trend, func(i, j int) bool { return trend[i].WeekStart < trend[j].WeekStart })
+	return trend
+}
+
+// понедельник недели момента ts в UTC в формате YYYY-MM-DD
+func weekStart(ts int64) string {
+	t := time.Unix(ts, 0).UTC()
+	offset := (int(t.Weekday()) + 6) % 7
+	return t.AddDate(0, 0, -offset).Format("2006-01-02")
+}
+
+func unixOrNil(t *time.Time) *int64 {
+	if t == nil {
+		return nil
+	}
+	u := t.Unix()
+	return &u
+}
@@ -0,0 +1,51 @@
+package services
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPercentile(t *testing.T) {
+	values := make([]float64, 0, 100)
+	for i := 1; i <= 100; i++ {
+		values = append(values, float64(i))
+	}
+
+	cases := []struct {
+		p    float64
+		want float64
+	}{
+		{p: 50, want: 50},
+		{p: 90, want: 90},
+		{p: 99, want: 99},
+	}
+	for _, tc := range cases {
+		if got := percentile(values, tc.p); got != tc.want {
+			t.Fatalf("p%v: ожидалось %v, получено %v", tc.p, tc.want, got)
+		}
+	}
+
+	if got := percentile([]float64{7.5}, 99); got != 7.5 {
+		t.Fatalf("перцентиль одного значения: ожидалось 7.5, получено %v", got)
+	}
+	if got := percentile(nil, 50); got != 0 {
+		t.Fatalf("перцентиль пустой выборки: ожидалось 0, получено %v", got)
+	}
+}
+
+func TestWeekStart(t *testing.T) {
+	cases := []struct {
+		at   time.Time
+		want string
+	}{
+		{at: time.Date(2025, 10, 20, 0, 0, 0, 0, time.UTC), want: "2025-10-20"},
+		{at: time.Date(2025, 10, 24, 15, 30, 0, 0, time.UTC), want: "2025-10-20"},
+		{at: time.Date(2025, 10, 26, 23, 59, 59, 0, time.UTC), want: "2025-10-20"},
+		{at: time.Date(2025, 10, 27, 0, 0, 0, 0, time.UTC), want: "2025-10-27"},
+	}
+	for _, tc := range cases {
+		if got := weekStart(tc.at.Unix()); got != tc.want {
+			t.Fatalf("%s: ожидалось %s, получено %s", tc.at, tc.want, got)
+		}
+	}
+}
@@ -3,178 +3,849 @@ package services
 import (
 	"context"
 	"errors"
+	"time"
 
+	"github.com/google/uuid"
 	openapi "github.com/wozhdeleniye/avito-tech-internship/api"
 	serviceerrors "github.com/wozhdeleniye/avito-tech-internship/internal/pkg/errors"
+	"github.com/wozhdeleniye/avito-tech-internship/internal/pkg/ptr"
+	"github.com/wozhdeleniye/avito-tech-internship/internal/pkg/validation"
 	"github.com/wozhdeleniye/avito-tech-internship/internal/repo/models"
 	postgresrepository "github.com/wozhdeleniye/avito-tech-internship/internal/repo/repositories/postgres_repository"
+	"gorm.io/gorm"
 )
 
 type TeamService struct {
-	PRRepo   *postgresrepository.PReqRepository
-	TeamRepo *postgresrepository.TeamRepository
-	UserRepo *postgresrepository.UserRepository
+	PRRepo        *postgresrepository.PReqRepository
+	TeamRepo      *postgresrepository.TeamRepository
+	UserRepo      *postgresrepository.UserRepository
+	ChangesetRepo *postgresrepository.ChangesetRepository
+	Tx            *postgresrepository.TxManager
+	Audit         *AuditService
+	Events        *EventBus
 }
 
-func NewTeamService(prRepo *postgresrepository.PReqRepository, teamRepo *postgresrepository.TeamRepository, userRepo *postgresrepository.UserRepository) *TeamService {
+func NewTeamService(prRepo *postgresrepository.PReqRepository, teamRepo *postgresrepository.TeamRepository, userRepo *postgresrepository.UserRepository, changesetRepo *postgresrepository.ChangesetRepository, tx *postgresrepository.TxManager, audit *AuditService, events *EventBus) *TeamService {
 	return &TeamService{
-		PRRepo:   prRepo,
-		TeamRepo: teamRepo,
-		UserRepo: userRepo,
+		PRRepo:        prRepo,
+		TeamRepo:      teamRepo,
+		UserRepo:      userRepo,
+		ChangesetRepo: changesetRepo,
+		Tx:            tx,
+		Audit:         audit,
+		Events:        events,
 	}
 }
 
-func (ts *TeamService) CreateTeam(ctx context.Context, req openapi.Team) (*openapi.Team, *serviceerrors.ServiceError) {
-	newTeam := models.Team{
-		TeamName: req.TeamName,
-		Members:  make([]*models.User, 0, len(req.Members)),
+// причина и стратегия переназначения для журнала аудита
+type reassignCause struct {
+	Reason   string
+	Strategy string
+	TeamName string
+}
+
+var errDryRun = errors.New("dry run")
+
+// декларативная синхронизация команды: создаёт недостающих пользователей, обновляет существующих и добавляет их в команду;
+// при prune исключает неперечисленных участников, при dryRun откатывает транзакцию и возвращает только diff
+func (ts *TeamService) SyncTeam(ctx context.Context, req openapi.Team, prune bool, dryRun bool) (*openapi.TeamSyncResult, *serviceerrors.ServiceError) {
+	// те же правила, что у транспортов: повтор user_id - INVALID_REQUEST, а не повод выбрать одно из описаний
+	if serr := validation.Struct(req); serr != nil {
+		return nil, serr
 	}
+	members := make([]openapi.TeamMember, 0, len(req.Members))
+	seen := make(map[string]bool, len(req.Members))
 	for _, member := range req.Members {
 		if member.UserId == "" {
 			continue
 		}
-
-		user := &models.User{
-			Nickname:     member.Username,
-			UserCustomID: member.UserId,
-			IsActive:     member.IsActive,
+		if _, serr := memberRole(member.Role); serr != nil {
+			return nil, serr
 		}
+		seen[member.UserId] = true
+		members = append(members, member)
+	}
 
-		newTeam.Members = append(newTeam.Members, user)
+	diff := openapi.TeamSyncDiff{
+		Created:       make([]string, 0),
+		Updated:       make([]openapi.TeamMemberUpdate, 0),
+		Attached:      make([]string, 0),
+		Detached:      make([]string, 0),
+		Reassignments: make([]openapi.Reassignment, 0),
+		NotReassigned: make([]string, 0),
 	}
+	var teamResp *openapi.Team
+
+	err := ts.Tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		team, err := ts.TeamRepo.FindTeamByName(ctx, req.TeamName)
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+		if team == nil {
+			team = &models.Team{TeamName: req.TeamName}
+			if err := ts.TeamRepo.CreateTeam(ctx, team); err != nil {
+				return err
+			}
+			diff.TeamCreated = true
+		}
 
-	if err := ts.TeamRepo.CreateTeamWithMembers(ctx, &newTeam); err != nil {
+		memberships, err := ts.TeamRepo.ListMemberships(ctx, team.ID)
+		if err != nil {
+			return err
+		}
+		current := make(map[uuid.UUID]*models.TeamMembership, len(memberships))
+		for _, m := range memberships {
+			current[m.UserID] = m
+		}
+
+		ids := make([]string, 0, len(members))
+		for _, m := range members {
+			ids = append(ids, m.UserId)
+		}
+		existing, err := ts.UserRepo.GetUsersByCustomIDs(ctx, ids)
+		if err != nil {
+			return err
+		}
+		byID := make(map[string]*models.User, len(existing))
+		for _, u := range existing {
+			byID[u.UserCustomID] = u
+		}
+
+		toAttach := make(map[string][]*models.User)
+		for _, m := range members {
+			role, _ := memberRole(m.Role)
+
+			user, ok := byID[m.UserId]
+			if !ok {
+				user = &models.User{
+					UserCustomID: m.UserId,
+					Nickname:     m.Username,
+					IsActive:     m.IsActive,
+				}
+				if err := ts.UserRepo.CreateUser(ctx, user); err != nil {
+					return err
+				}
+				diff.Created = append(diff.Created, user.UserCustomID)
+				toAttach[role] = append(toAttach[role], user)
+				diff.Attached = append(diff.Attached, user.UserCustomID)
+				continue
+			}
+
+			changed := make([]openapi.TeamMemberUpdateChangedFields, 0, 3)
+			if user.Nickname != m.Username {
+				user.Nickname = m.Username
+				changed = append(changed, openapi.Username)
+			}
+			if user.IsActive != m.IsActive {
+				user.IsActive = m.IsActive
+				changed = append(changed, openapi.IsActive)
+				if err := ts.Audit.Record(ctx, activityEntry(user, models.ReasonTeamSync, team.TeamName)); err != nil {
+					return err
+				}
+			}
+			if len(changed) > 0 {
+				if err := ts.UserRepo.UpdateUser(ctx, user); err != nil {
+					return err
+				}
+			}
+
+			membership, ok := current[user.ID]
+			if !ok {
+				toAttach[role] = append(toAttach[role], user)
+				diff.Attached = append(diff.Attached, user.UserCustomID)
+			} else if m.Role != nil && membership.Role != role {
+				if err := ts.TeamRepo.SetMemberRole(ctx, team.ID, user.ID, role); err != nil {
+					return err
+				}
+				changed = append(changed, openapi.Role)
+			}
+
+			if len(changed) > 0 {
+				diff.Updated = append(diff.Updated, openapi.TeamMemberUpdate{UserId: user.UserCustomID, ChangedFields: changed})
+			}
+		}
+
+		for _, role := range []string{models.RoleMember, models.RoleLead, models.RoleObserver} {
+			if err := ts.TeamRepo.AddMembers(ctx, team, toAttach[role], role); err != nil {
+				return err
+			}
+		}
+
+		team, err = ts.TeamRepo.FindTeamByName(ctx, req.TeamName)
+		if err != nil {
+			return err
+		}
+
+		if prune {
+			toDetach := make([]*models.User, 0)
+			for _, m := range team.Members {
+				if !seen[m.UserCustomID] {
+					toDetach = append(toDetach, m)
+				}
+			}
+			if len(toDetach) > 0 {
+				pool, err := ts.TeamRepo.ListReviewCandidates(ctx, team.ID)
+				if err != nil {
+					return err
+				}
+				reassignments, notReassigned, err := ts.reassignOpenReviews(ctx, toDetach, pool, team, reassignCause{Reason: models.ReasonTeamSync, Strategy: models.StrategyRandomTeam, TeamName: team.TeamName})
+				if err != nil {
+					return err
+				}
+				diff.Reassignments, diff.NotReassigned = reassignments, notReassigned
+
+				if err := ts.TeamRepo.RemoveMembers(ctx, team, toDetach); err != nil {
+					return err
+				}
+				for _, u := range toDetach {
+					diff.Detached = append(diff.Detached, u.UserCustomID)
+				}
+
+				team, err = ts.TeamRepo.FindTeamByName(ctx, req.TeamName)
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		teamResp, err = ts.teamResponse(ctx, team)
+		if err != nil {
+			return err
+		}
+
+		if dryRun {
+			return errDryRun
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, errDryRun) {
 		if errors.Is(err, postgresrepository.ErrUserExists) {
 			return nil, serviceerrors.ErrUserExists
 		}
 		if errors.Is(err, postgresrepository.ErrTeamExists) {
 			return nil, serviceerrors.ErrTeamExists
 		}
-		return nil, serviceerrors.ErrUnknown
+		return nil, serviceerrors.ErrUnknown.Wrap(err)
 	}
 
-	teamResp := openapi.Team{
-		TeamName: newTeam.TeamName,
-		Members:  make([]openapi.TeamMember, 0, len(newTeam.Members)),
-	}
-	for _, member := range newTeam.Members {
-		teamResp.Members = append(teamResp.Members, openapi.TeamMember{
-			IsActive: member.IsActive,
-			UserId:   member.UserCustomID,
-			Username: member.Nickname,
-		})
-	}
-	return &teamResp, nil
+	return &openapi.TeamSyncResult{Team: *teamResp, Diff: diff}, nil
 }
 
 func (ts *TeamService) GetTeamQuery(ctx context.Context, req openapi.TeamNameQuery) (*openapi.Team, *serviceerrors.ServiceError) {
 	team, err := ts.TeamRepo.FindTeamByName(ctx, req)
-	if err != nil {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
 		return nil, serviceerrors.ErrTeamNotFound
 	}
+	if err != nil {
+		return nil, serviceerrors.ErrUnknown.Wrap(err)
+	}
 	if team == nil {
 		return nil, serviceerrors.ErrTeamNotFound
 	}
 
-	teamResp := openapi.Team{
-		TeamName: team.TeamName,
-		Members:  make([]openapi.TeamMember, 0, len(team.Members)),
-	}
-	for _, member := range team.Members {
-		teamResp.Members = append(teamResp.Members, openapi.TeamMember{
-			IsActive: member.IsActive,
-			UserId:   member.UserCustomID,
-			Username: member.Nickname,
-		})
+	resp, err := ts.teamResponse(ctx, team)
+	if err != nil {
+		return nil, serviceerrors.ErrUnknown.Wrap(err)
 	}
-	return &teamResp, nil
+	return resp, nil
 }
 
-func (s *TeamService) SetUserActive(ctx context.Context, userId string, isActive bool) (*models.User, *serviceerrors.ServiceError) {
+// меняет флаг активности; при деактивации с reassignReviews открытые ревью пользователя переназначаются
+// в той же транзакции по правилам ReassignReviewer, PR без подходящей замены возвращаются в NotReassigned
+func (s *TeamService) SetUserActive(ctx context.Context, userId string, isActive bool, reassignReviews bool) (*openapi.UserActivityChange, *serviceerrors.ServiceError) {
 	user, err := s.UserRepo.GetUserByCustomId(ctx, userId)
 	if err != nil {
-		return nil, serviceerrors.ErrUnknown
+		return nil, serviceerrors.ErrUnknown.Wrap(err)
 	}
 	if user == nil {
 		return nil, serviceerrors.ErrUserNotFound
 	}
 
-	user.IsActive = isActive
+	resp := &openapi.UserActivityChange{
+		Reassignments: make([]openapi.Reassignment, 0),
+		NotReassigned: make([]string, 0),
+	}
+	// повторная деактивация с reassignReviews только переназначает оставшиеся ревью
+	changed := user.IsActive != isActive
+	reassign := reassignReviews && !isActive
+	if changed || reassign {
+		user.IsActive = isActive
 
-	if err := s.UserRepo.UpdateUser(ctx, user); err != nil {
-		return nil, serviceerrors.ErrUnknown
+		err = s.Tx.WithinTransaction(ctx, func(ctx context.Context) error {
+			if changed {
+				if err := s.UserRepo.UpdateUser(ctx, user); err != nil {
+					return err
+				}
+				if err := s.Audit.Record(ctx, activityEntry(user, models.ReasonManual, "")); err != nil {
+					return err
+				}
+			}
+			if !reassign {
+				return nil
+			}
+			reassignments, notReassigned, err := s.reassignReviewsOf(ctx, user)
+			if err != nil {
+				return err
+			}
+			resp.Reassignments, resp.NotReassigned = reassignments, notReassigned
+			if !changed && len(reassignments) == 0 {
+				return nil
+			}
+			changeset, err := s.recordDeactivation(ctx, user, changed, reassignments)
+			if err != nil {
+				return err
+			}
+			id := changeset.ID.String()
+			resp.ChangesetId = &id
+			return nil
+		})
+		if err != nil {
+			return nil, serviceerrors.ErrUnknown.Wrap(err)
+		}
 	}
 
-	return user, nil
+	resp.User = openapi.User{UserId: user.UserCustomID, Username: user.Nickname, IsActive: user.IsActive}
+	team, err := s.TeamRepo.GetPrimaryTeam(ctx, user.ID)
+	if err != nil {
+		return nil, serviceerrors.ErrUnknown.Wrap(err)
+	}
+	if team != nil {
+		resp.User.TeamName = team.TeamName
+	}
+	return resp, nil
 }
 
-func (s *TeamService) MassDeactivateTeam(ctx context.Context, oldTeamName string, newTeamName string) (map[string]interface{}, *serviceerrors.ServiceError) {
-	oldTeam, err := s.TeamRepo.FindTeamByName(ctx, oldTeamName)
+func (s *TeamService) RenameTeam(ctx context.Context, teamName string, newTeamName string) (*openapi.Team, *serviceerrors.ServiceError) {
+	team, serr := s.findTeam(ctx, teamName)
+	if serr != nil {
+		return nil, serr
+	}
+
+	if err := s.TeamRepo.RenameTeam(ctx, team, newTeamName); err != nil {
+		if errors.Is(err, postgresrepository.ErrTeamExists) {
+			return nil, serviceerrors.ErrTeamExists
+		}
+		return nil, serviceerrors.ErrUnknown.Wrap(err)
+	}
+	team.TeamName = newTeamName
+
+	resp, err := s.teamResponse(ctx, team)
 	if err != nil {
-		return nil, serviceerrors.ErrTeamNotFound
+		return nil, serviceerrors.ErrUnknown.Wrap(err)
+	}
+	return resp, nil
+}
+
+func (s *TeamService) SetSLA(ctx context.Context, teamName string, hours int, action openapi.SlaAction) (*openapi.Team, *serviceerrors.ServiceError) {
+	if hours < 0 || (action != openapi.Remind && action != openapi.Reassign) {
+		return nil, serviceerrors.ErrInvalidSLA
+	}
+
+	team, serr := s.findTeam(ctx, teamName)
+	if serr != nil {
+		return nil, serr
+	}
+
+	if err := s.TeamRepo.SetSLAPolicy(ctx, team, hours, string(action)); err != nil {
+		return nil, serviceerrors.ErrUnknown.Wrap(err)
 	}
-	if oldTeam == nil || len(oldTeam.Members) == 0 {
-		return map[string]interface{}{"deactivated": []string{}, "reassignments": []interface{}{}}, nil
+	team.SLAFirstReviewHours, team.SLAAction = hours, string(action)
+
+	resp, err := s.teamResponse(ctx, team)
+	if err != nil {
+		return nil, serviceerrors.ErrUnknown.Wrap(err)
 	}
+	return resp, nil
+}
 
-	newTeam, err := s.TeamRepo.FindTeamByName(ctx, newTeamName)
+func (s *TeamService) DeleteTeam(ctx context.Context, teamName string) *serviceerrors.ServiceError {
+	team, serr := s.findTeam(ctx, teamName)
+	if serr != nil {
+		return serr
+	}
+	if len(team.Members) > 0 {
+		return serviceerrors.ErrTeamNotEmpty
+	}
+	repositories, err := s.TeamRepo.CountOwnedRepositories(ctx, team.ID)
 	if err != nil {
-		return nil, serviceerrors.ErrTeamNotFound
+		return serviceerrors.ErrUnknown.Wrap(err)
 	}
-	if newTeam == nil || len(newTeam.Members) == 0 {
-		return nil, serviceerrors.ErrTeamNotFound
+	if repositories > 0 {
+		return serviceerrors.ErrTeamNotEmpty.WithMessage("team still owns repositories")
 	}
 
-	ids := make([]string, 0, len(oldTeam.Members))
-	customIDs := make([]string, 0, len(oldTeam.Members))
-	for _, m := range oldTeam.Members {
-		if m == nil {
-			continue
+	if err := s.TeamRepo.DeleteTeam(ctx, team); err != nil {
+		return serviceerrors.ErrUnknown.Wrap(err)
+	}
+	return nil
+}
+
+// добавляет в команду существующих пользователей с указанной ролью; состоящим в команде роль обновляется, если она передана
+func (s *TeamService) AddMembers(ctx context.Context, teamName string, userIDs []string, role *openapi.TeamMemberRole) (*openapi.Team, *serviceerrors.ServiceError) {
+	newRole, serr := memberRole(role)
+	if serr != nil {
+		return nil, serr
+	}
+
+	team, serr := s.findTeam(ctx, teamName)
+	if serr != nil {
+		return nil, serr
+	}
+
+	users, serr := s.findUsers(ctx, userIDs)
+	if serr != nil {
+		return nil, serr
+	}
+
+	err := s.Tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		memberships, err := s.TeamRepo.ListMemberships(ctx, team.ID)
+		if err != nil {
+			return err
+		}
+		current := make(map[uuid.UUID]*models.TeamMembership, len(memberships))
+		for _, m := range memberships {
+			current[m.UserID] = m
+		}
+
+		toAdd := make([]*models.User, 0, len(users))
+		for _, u := range users {
+			membership, ok := current[u.ID]
+			if !ok {
+				toAdd = append(toAdd, u)
+				continue
+			}
+			if role != nil && membership.Role != newRole {
+				if err := s.TeamRepo.SetMemberRole(ctx, team.ID, u.ID, newRole); err != nil {
+					return err
+				}
+			}
+		}
+
+		return s.TeamRepo.AddMembers(ctx, team, toAdd, newRole)
+	})
+	if err != nil {
+		return nil, serviceerrors.ErrUnknown.Wrap(err)
+	}
+
+	return s.reloadTeam(ctx, team.TeamName)
+}
+
+// исключает пользователей из команды, их открытые ревью по PR участников команды переходят к оставшимся участникам
+func (s *TeamService) RemoveMembers(ctx context.Context, teamName string, userIDs []string) (*openapi.TeamMembersChange, *serviceerrors.ServiceError) {
+	team, serr := s.findTeam(ctx, teamName)
+	if serr != nil {
+		return nil, serr
+	}
+
+	users, serr := s.findUsers(ctx, userIDs)
+	if serr != nil {
+		return nil, serr
+	}
+	for _, u := range users {
+		membership, err := s.TeamRepo.GetMembership(ctx, team.ID, u.ID)
+		if err != nil {
+			return nil, serviceerrors.ErrUnknown.Wrap(err)
+		}
+		if membership == nil {
+			return nil, serviceerrors.ErrNotTeamMember
+		}
+	}
+
+	resp := &openapi.TeamMembersChange{}
+	err := s.Tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		pool, err := s.TeamRepo.ListReviewCandidates(ctx, team.ID)
+		if err != nil {
+			return err
 		}
-		ids = append(ids, m.ID.String())
-		customIDs = append(customIDs, m.UserCustomID)
+		reassignments, notReassigned, err := s.reassignOpenReviews(ctx, users, pool, team, reassignCause{Reason: models.ReasonMemberRemoved, Strategy: models.StrategyRandomTeam, TeamName: team.TeamName})
+		if err != nil {
+			return err
+		}
+		resp.Reassignments, resp.NotReassigned = reassignments, notReassigned
+
+		return s.TeamRepo.RemoveMembers(ctx, team, users)
+	})
+	if err != nil {
+		return nil, serviceerrors.ErrUnknown.Wrap(err)
+	}
+
+	teamResp, serr := s.reloadTeam(ctx, team.TeamName)
+	if serr != nil {
+		return nil, serr
 	}
+	resp.Team = *teamResp
+	return resp, nil
+}
 
-	if err := s.UserRepo.SetUsersActiveByIDs(ctx, ids, false); err != nil {
-		return nil, serviceerrors.ErrUnknown
+// переводит пользователя из fromTeamName (по умолчанию из основной команды) в другую команду;
+// роль сохраняется, основная команда переезжает вместе с пользователем, открытые ревью переходят к участникам старой команды
+func (s *TeamService) MoveMember(ctx context.Context, userID string, toTeamName string, fromTeamName *string) (*openapi.TeamMembersChange, *serviceerrors.ServiceError) {
+	user, err := s.UserRepo.GetUserByCustomId(ctx, userID)
+	if err != nil {
+		return nil, serviceerrors.ErrUnknown.Wrap(err)
+	}
+	if user == nil {
+		return nil, serviceerrors.ErrUserNotFound
+	}
+
+	toTeam, serr := s.findTeam(ctx, toTeamName)
+	if serr != nil {
+		return nil, serr
+	}
+
+	var fromTeam *models.Team
+	if fromTeamName != nil {
+		fromTeam, serr = s.findTeam(ctx, *fromTeamName)
+		if serr != nil {
+			return nil, serr
+		}
+	} else {
+		fromTeam, err = s.TeamRepo.GetPrimaryTeam(ctx, user.ID)
+		if err != nil {
+			return nil, serviceerrors.ErrUnknown.Wrap(err)
+		}
+	}
+
+	var fromMembership *models.TeamMembership
+	if fromTeam != nil {
+		fromMembership, err = s.TeamRepo.GetMembership(ctx, fromTeam.ID, user.ID)
+		if err != nil {
+			return nil, serviceerrors.ErrUnknown.Wrap(err)
+		}
+		if fromMembership == nil {
+			return nil, serviceerrors.ErrNotTeamMember
+		}
+	}
+
+	resp := &openapi.TeamMembersChange{
+		Reassignments: make([]openapi.Reassignment, 0),
+		NotReassigned: make([]string, 0),
+	}
+	if fromTeam == nil || fromTeam.ID != toTeam.ID {
+		err = s.Tx.WithinTransaction(ctx, func(ctx context.Context) error {
+			role := models.RoleMember
+			if fromMembership != nil {
+				role = fromMembership.Role
+
+				pool, err := s.TeamRepo.ListReviewCandidates(ctx, fromTeam.ID)
+				if err != nil {
+					return err
+				}
+				reassignments, notReassigned, err := s.reassignOpenReviews(ctx, []*models.User{user}, pool, fromTeam, reassignCause{Reason: models.ReasonMemberMoved, Strategy: models.StrategyRandomTeam, TeamName: fromTeam.TeamName})
+				if err != nil {
+					return err
+				}
+				resp.Reassignments, resp.NotReassigned = reassignments, notReassigned
+
+				if err := s.TeamRepo.RemoveMembers(ctx, fromTeam, []*models.User{user}); err != nil {
+					return err
+				}
+			}
+
+			if err := s.TeamRepo.AddMembers(ctx, toTeam, []*models.User{user}, role); err != nil {
+				return err
+			}
+			if fromMembership != nil && fromMembership.IsPrimary {
+				return s.TeamRepo.SetPrimaryTeam(ctx, user.ID, toTeam.ID)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, serviceerrors.ErrUnknown.Wrap(err)
+		}
+	}
+
+	teamResp, serr := s.reloadTeam(ctx, toTeam.TeamName)
+	if serr != nil {
+		return nil, serr
+	}
+	resp.Team = *teamResp
+	return resp, nil
+}
+
+// переназначает открытые ревью уходящих пользователей на активных участников pool;
+// если задана team, затрагиваются только PR, автор которых состоит в этой команде
+func (s *TeamService) reassignOpenReviews(ctx context.Context, leaving []*models.User, pool []*models.User, team *models.Team, cause reassignCause) ([]openapi.Reassignment, []string, error) {
+	reassignments := make([]openapi.Reassignment, 0)
+	notReassigned := make([]string, 0)
+
+	ids := make([]string, 0, len(leaving))
+	leavingSet := make(map[uuid.UUID]struct{}, len(leaving))
+	for _, u := range leaving {
+		ids = append(ids, u.ID.String())
+		leavingSet[u.ID] = struct{}{}
 	}
 
 	prs, err := s.PRRepo.ListOpenPullRequestsByReviewerIDs(ctx, ids)
 	if err != nil {
-		return nil, serviceerrors.ErrUnknown
+		return nil, nil, err
+	}
+
+	var authors map[uuid.UUID]struct{}
+	if team != nil {
+		authors = make(map[uuid.UUID]struct{}, len(team.Members))
+		for _, m := range team.Members {
+			authors[m.ID] = struct{}{}
+		}
 	}
 
-	reassignments := make([]map[string]string, 0)
 	for _, pr := range prs {
-		if pr == nil {
-			continue
+		if authors != nil {
+			if _, ok := authors[pr.AuthorID]; !ok {
+				continue
+			}
 		}
-		changed := false
+		changed, stuck := false, false
 		for i, reviewer := range pr.AssignedReviewers {
 			if reviewer == nil {
 				continue
 			}
-			for _, did := range ids {
-				if reviewer.ID.String() == did {
-					excluded := make([]*models.User, 0, len(pr.AssignedReviewers)+1)
-					excluded = append(excluded, pr.AssignedReviewers...)
-					excluded = append(excluded, &pr.Author)
-					newReviewer := s.TeamRepo.PickMemberNotInList(newTeam.Members, excluded)
-					if newReviewer != nil {
-						pr.AssignedReviewers[i] = newReviewer
-						changed = true
-						reassignments = append(reassignments, map[string]string{"pr_id": pr.PullRequestCustomID, "new_reviewer": newReviewer.UserCustomID})
-					}
-					break
-				}
+			if _, ok := leavingSet[reviewer.ID]; !ok {
+				continue
+			}
+
+			excluded := make([]*models.User, 0, len(pr.AssignedReviewers)+len(leaving)+1)
+			excluded = append(excluded, pr.AssignedReviewers...)
+			excluded = append(excluded, leaving...)
+			excluded = append(excluded, &pr.Author)
+
+			newReviewer := s.TeamRepo.PickMemberNotInList(pool, excluded)
+			if newReviewer == nil {
+				stuck = true
+				continue
 			}
+			pr.AssignedReviewers[i] = newReviewer
+			changed = true
+			reassignments = append(reassignments, openapi.Reassignment{
+				PullRequestId: pr.PullRequestCustomID,
+				Repository:    ptr.Optional(pr.RepositoryName()),
+				OldReviewerId: reviewer.UserCustomID,
+				NewReviewerId: newReviewer.UserCustomID,
+			})
+			if err := s.Audit.Record(ctx, &models.AuditEntry{
+				Action:              models.AuditReviewerReassigned,
+				PullRequestCustomID: pr.PullRequestCustomID,
+				Repository:          pr.RepositoryName(),
+				OldReviewerID:       reviewer.UserCustomID,
+				NewReviewerID:       newReviewer.UserCustomID,
+				TeamName:            cause.TeamName,
+				Reason:              cause.Reason,
+				Strategy:            cause.Strategy,
+			}); err != nil {
+				return nil, nil, err
+			}
+			s.Events.PublishAfterCommit(ctx, reassignmentEvents(pr, reviewer.UserCustomID, newReviewer.UserCustomID, cause.TeamName, cause.Reason)...)
+		}
+		if stuck {
+			notReassigned = append(notReassigned, pr.Key())
 		}
 		if changed {
 			if err := s.PRRepo.UpdatePullRequest(ctx, pr); err != nil {
-				return nil, serviceerrors.ErrUnknown
+				return nil, nil, err
 			}
 		}
 	}
 
-	return map[string]interface{}{"deactivated": customIDs, "reassignments": reassignments}, nil
+	return reassignments, notReassigned, nil
+}
+
+// переназначает открытые ревью деактивированного пользователя: команда замены и исключения (назначенные ревьюверы
+// и автор) выбираются для каждого PR так же, как в PReqService.ReassignReviewer
+func (s *TeamService) reassignReviewsOf(ctx context.Context, user *models.User) ([]openapi.Reassignment, []string, error) {
+	reassignments := make([]openapi.Reassignment, 0)
+	notReassigned := make([]string, 0)
+
+	prs, err := s.PRRepo.ListOpenPullRequestsByReviewerIDs(ctx, []string{user.ID.String()})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pools := make(map[uuid.UUID][]*models.User)
+	for _, pr := range prs {
+		index := -1
+		for i, reviewer := range pr.AssignedReviewers {
+			if reviewer != nil && reviewer.ID == user.ID {
+				index = i
+				break
+			}
+		}
+		if index < 0 {
+			continue
+		}
+
+		team, strategy, err := reviewTeam(ctx, s.TeamRepo, pr, user.ID)
+		if err != nil {
+			return nil, nil, err
+		}
+		if team == nil {
+			notReassigned = append(notReassigned, pr.Key())
+			continue
+		}
+		pool, ok := pools[team.ID]
+		if !ok {
+			if pool, err = s.TeamRepo.ListReviewCandidates(ctx, team.ID); err != nil {
+				return nil, nil, err
+			}
+			pools[team.ID] = pool
+		}
+
+		excluded := make([]*models.User, 0, len(pr.AssignedReviewers)+1)
+		excluded = append(excluded, pr.AssignedReviewers...)
+		excluded = append(excluded, &pr.Author)
+
+		newReviewer := s.TeamRepo.PickMemberNotInList(pool, excluded)
+		if newReviewer == nil {
+			notReassigned = append(notReassigned, pr.Key())
+			continue
+		}
+		pr.AssignedReviewers[index] = newReviewer
+		if err := s.PRRepo.UpdatePullRequest(ctx, pr); err != nil {
+			return nil, nil, err
+		}
+		if err := s.Audit.Record(ctx, &models.AuditEntry{
+			Action:              models.AuditReviewerReassigned,
+			PullRequestCustomID: pr.PullRequestCustomID,
+			Repository:          pr.RepositoryName(),
+			OldReviewerID:       user.UserCustomID,
+			NewReviewerID:       newReviewer.UserCustomID,
+			TeamName:            team.TeamName,
+			Reason:              models.ReasonUserDeactivated,
+			Strategy:            strategy,
+		}); err != nil {
+			return nil, nil, err
+		}
+		s.Events.PublishAfterCommit(ctx, reassignmentEvents(pr, user.UserCustomID, newReviewer.UserCustomID, team.TeamName, models.ReasonUserDeactivated)...)
+		reassignments = append(reassignments, openapi.Reassignment{
+			PullRequestId: pr.PullRequestCustomID,
+			Repository:    ptr.Optional(pr.RepositoryName()),
+			OldReviewerId: user.UserCustomID,
+			NewReviewerId: newReviewer.UserCustomID,
+		})
+	}
+	return reassignments, notReassigned, nil
+}
+
+func (s *TeamService) findTeam(ctx context.Context, teamName string) (*models.Team, *serviceerrors.ServiceError) {
+	team, err := s.TeamRepo.FindTeamByName(ctx, teamName)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, serviceerrors.ErrTeamNotFound
+		}
+		return nil, serviceerrors.ErrUnknown.Wrap(err)
+	}
+	return team, nil
+}
+
+// все пользователи должны существовать, иначе USER_NOT_FOUND
+func (s *TeamService) findUsers(ctx context.Context, userIDs []string) ([]*models.User, *serviceerrors.ServiceError) {
+	users, err := s.UserRepo.GetUsersByCustomIDs(ctx, userIDs)
+	if err != nil {
+		return nil, serviceerrors.ErrUnknown.Wrap(err)
+	}
+
+	found := make(map[string]struct{}, len(users))
+	for _, u := range users {
+		found[u.UserCustomID] = struct{}{}
+	}
+	for _, id := range userIDs {
+		if _, ok := found[id]; !ok {
+			return nil, serviceerrors.ErrUserNotFound
+		}
+	}
+	return users, nil
+}
+
+func (s *TeamService) reloadTeam(ctx context.Context, teamName string) (*openapi.Team, *serviceerrors.ServiceError) {
+	team, serr := s.findTeam(ctx, teamName)
+	if serr != nil {
+		return nil, serr
+	}
+	resp, err := s.teamResponse(ctx, team)
+	if err != nil {
+		return nil, serviceerrors.ErrUnknown.Wrap(err)
+	}
+	return resp, nil
+}
+
+func (s *TeamService) teamResponse(ctx context.Context, team *models.Team) (*openapi.Team, error) {
+	memberships, err := s.TeamRepo.ListMemberships(ctx, team.ID)
+	if err != nil {
+		return nil, err
+	}
+	byUser := make(map[uuid.UUID]*models.TeamMembership, len(memberships))
+	for _, m := range memberships {
+		byUser[m.UserID] = m
+	}
+
+	teamResp := openapi.Team{
+		TeamName: team.TeamName,
+		Members:  make([]openapi.TeamMember, 0, len(team.Members)),
+	}
+	for _, member := range team.Members {
+		m := openapi.TeamMember{
+			IsActive: member.IsActive,
+			UserId:   member.UserCustomID,
+			Username: member.Nickname,
+		}
+		if membership, ok := byUser[member.ID]; ok {
+			role := openapi.TeamMemberRole(membership.Role)
+			isPrimary := membership.IsPrimary
+			m.Role, m.IsPrimary = &role, &isPrimary
+		}
+		teamResp.Members = append(teamResp.Members, m)
+	}
+	if team.SLAFirstReviewHours > 0 {
+		teamResp.Sla = &openapi.TeamSla{FirstReviewHours: team.SLAFirstReviewHours, Action: openapi.SlaAction(team.SLAAction)}
+	}
+	return &teamResp, nil
+}
+
+// набор изменений деактивации с переназначением, чтобы её можно было откатить через ChangesetService
+func (s *TeamService) recordDeactivation(ctx context.Context, user *models.User, deactivated bool, reassignments []openapi.Reassignment) (*models.Changeset, error) {
+	now := time.Now().Unix()
+	changeset := &models.Changeset{Kind: models.ChangesetUserDeactivation, Actor: ActorFromContext(ctx), CompletedAt: &now}
+	if err := s.ChangesetRepo.CreateChangeset(ctx, changeset); err != nil {
+		return nil, err
+	}
+
+	entries := make([]*models.ChangesetEntry, 0, len(reassignments)+1)
+	if deactivated {
+		entries = append(entries, &models.ChangesetEntry{ChangesetID: changeset.ID, Kind: models.ChangeUserDeactivated, UserCustomID: user.UserCustomID})
+	}
+	for _, r := range reassignments {
+		entries = append(entries, &models.ChangesetEntry{
+			ChangesetID:         changeset.ID,
+			Kind:                models.ChangeReviewerReassigned,
+			UserCustomID:        r.OldReviewerId,
+			PullRequestCustomID: r.PullRequestId,
+			Repository:          ptr.Deref(r.Repository),
+			NewReviewerID:       r.NewReviewerId,
+		})
+	}
+	return changeset, s.ChangesetRepo.AppendEntries(ctx, entries)
+}
+
+func activityEntry(user *models.User, reason string, teamName string) *models.AuditEntry {
+	action := models.AuditUserDeactivated
+	if user.IsActive {
+		action = models.AuditUserActivated
+	}
+	return &models.AuditEntry{
+		Action:       action,
+		UserCustomID: user.UserCustomID,
+		TeamName:     teamName,
+		Reason:       reason,
+	}
+}
+
+// роль по умолчанию - member
+func memberRole(role *openapi.TeamMemberRole) (string, *serviceerrors.ServiceError) {
+	if role == nil {
+		return models.RoleMember, nil
+	}
+	switch *role {
+	case openapi.Member, openapi.Lead, openapi.Observer:
+		return string(*role), nil
+	}
+	return "", serviceerrors.ErrInvalidRole
 }
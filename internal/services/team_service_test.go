@@ -0,0 +1,27 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	openapi "github.com/wozhdeleniye/avito-tech-internship/api"
+	serviceerrors "github.com/wozhdeleniye/avito-tech-internship/internal/pkg/errors"
+)
+
+// повтор user_id отклоняется до обращения к базе, поэтому сервису не нужны репозитории
+func TestSyncTeamRejectsDuplicateMembers(t *testing.T) {
+	team := openapi.Team{TeamName: "backend", Members: []openapi.TeamMember{
+		{UserId: "u1", Username: "Alice", IsActive: true},
+		{UserId: "u2", Username: "Bob", IsActive: true},
+		{UserId: "u1", Username: "Alice again", IsActive: false},
+	}}
+
+	_, serr := (&TeamService{}).SyncTeam(context.Background(), team, false, false)
+	if serr == nil || !errors.Is(serr, serviceerrors.ErrInvalidRequest) {
+		t.Fatalf("ожидалась INVALID_REQUEST, получено %v", serr)
+	}
+	if len(serr.Details) != 1 || serr.Details[0].Field != "members[2].user_id" || serr.Details[0].Rule != "unique" {
+		t.Fatalf("ожидалась ошибка уникальности members[2].user_id, получено %+v", serr.Details)
+	}
+}
@@ -0,0 +1,116 @@
+// Package client - типизированный клиент сервиса назначения ревьюверов.
+//
+// Обёртка над сгенерированным openapi.ClientWithResponses (api/client.gen.go): повторы с
+// экспоненциальной задержкой на 5xx, ошибки сервиса в виде *Error, итераторы по страницам.
+//
+//	c, err := client.New("http://localhost:8085", client.WithUserID("admin"))
+//	pr, err := c.CreatePullRequest(ctx, "pr-1", "Add search", "u1")
+//	if errors.Is(err, client.ErrPRExists) { ... }
+//	for pr, err := range c.PullRequests(ctx, openapi.GetPullRequestListParams{}) { ... }
+package client
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	openapi "github.com/wozhdeleniye/avito-tech-internship/api"
+)
+
+type Client struct {
+	api    *openapi.ClientWithResponses
+	stream *openapi.Client
+}
+
+type options struct {
+	doer         openapi.HttpRequestDoer
+	customDoer   bool
+	token        string
+	userID       string
+	organization string
+	retry        RetryPolicy
+}
+
+type Option func(*options)
+
+// HTTP-клиент для запросов, по умолчанию http.Client с таймаутом 30s
+func WithHTTPClient(doer openapi.HttpRequestDoer) Option {
+	return func(o *options) { o.doer, o.customDoer = doer, true }
+}
+
+// токен доступа, передаётся в Authorization: Bearer
+func WithToken(token string) Option {
+	return func(o *options) { o.token = token }
+}
+
+// инициатор действий для журнала аудита (заголовок User-id)
+func WithUserID(userID string) Option {
+	return func(o *options) { o.userID = userID }
+}
+
+// slug организации (заголовок X-Organization); без него - организация из токена или default
+func WithOrganization(slug string) Option {
+	return func(o *options) { o.organization = slug }
+}
+
+// политика повторов; RetryPolicy{MaxAttempts: 1} отключает повторы
+func WithRetry(policy RetryPolicy) Option {
+	return func(o *options) { o.retry = policy }
+}
+
+type idempotencyKey struct{}
+
+// ключ идемпотентности (заголовок Idempotency-Key) для POST-запросов с этим ctx: сервис выполнит запрос
+// один раз и вернёт повторам тот же ответ, поэтому такие запросы повторяются и при любом 5xx
+func WithIdempotencyKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, idempotencyKey{}, key)
+}
+
+// server - адрес сервиса без /api, например http://localhost:8085
+func New(server string, opts ...Option) (*Client, error) {
+	o := options{
+		doer:  &http.Client{Timeout: 30 * time.Second},
+		retry: DefaultRetryPolicy,
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	headers := openapi.WithRequestEditorFn(func(ctx context.Context, req *http.Request) error {
+		if o.token != "" {
+			req.Header.Set("Authorization", "Bearer "+o.token)
+		}
+		if o.userID != "" {
+			req.Header.Set("User-id", o.userID)
+		}
+		if o.organization != "" {
+			req.Header.Set("X-Organization", o.organization)
+		}
+		if key, ok := ctx.Value(idempotencyKey{}).(string); ok && key != "" && req.Method == http.MethodPost {
+			req.Header.Set("Idempotency-Key", key)
+		}
+		return nil
+	})
+	api, err := openapi.NewClientWithResponses(strings.TrimRight(server, "/")+"/api",
+		openapi.WithHTTPClient(&retryDoer{next: o.doer, policy: o.retry}), headers)
+	if err != nil {
+		return nil, err
+	}
+
+	// поток событий открыт сколько угодно долго, поэтому таймаут клиента по умолчанию к нему не применяется
+	streamDoer := o.doer
+	if !o.customDoer {
+		streamDoer = &http.Client{}
+	}
+	stream, err := openapi.NewClient(strings.TrimRight(server, "/")+"/api", openapi.WithHTTPClient(streamDoer), headers)
+	if err != nil {
+		return nil, err
+	}
+	return &Client{api: api, stream: stream}, nil
+}
+
+// сгенерированный клиент для вызовов, которых нет в обёртке; повторы и заголовки те же
+func (c *Client) API() *openapi.ClientWithResponses {
+	return c.api
+}
@@ -0,0 +1,243 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	openapi "github.com/wozhdeleniye/avito-tech-internship/api"
+)
+
+func newTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	t.Helper()
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+	c, err := New(srv.URL, WithUserID("tester"), WithRetry(RetryPolicy{MaxAttempts: 3, MinBackoff: time.Millisecond, MaxBackoff: 10 * time.Millisecond}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return c
+}
+
+func TestRetryGetOn5xx(t *testing.T) {
+	var calls atomic.Int32
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/team/get" || r.Header.Get("User-id") != "tester" {
+			t.Errorf("unexpected request %s %v", r.URL.Path, r.Header)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if calls.Add(1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte(`{"team_name":"backend","members":[]}`))
+	})
+
+	team, err := c.GetTeam(context.Background(), "backend")
+	if err != nil || team.TeamName != "backend" {
+		t.Fatalf("unexpected result: %+v %v", team, err)
+	}
+	if calls.Load() != 3 {
+		t.Fatalf("expected 3 attempts, got %d", calls.Load())
+	}
+}
+
+func TestRetryPostOnlyOnGatewayErrors(t *testing.T) {
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch calls.Add(1) {
+		case 1:
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		case 2:
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		// 504: сервис мог уже создать PR, повтор без Idempotency-Key создал бы его второй раз
+		w.WriteHeader(http.StatusGatewayTimeout)
+		_, _ = w.Write([]byte(`{"error":{"code":"UNKNOWN_ERROR","message":"unknown error"}}`))
+	}))
+	t.Cleanup(srv.Close)
+	c, err := New(srv.URL, WithRetry(RetryPolicy{MaxAttempts: 5, MinBackoff: time.Millisecond, MaxBackoff: 10 * time.Millisecond}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	_, err = c.CreatePullRequest(context.Background(), "pr-1", "name", "u1")
+	if !errors.Is(err, ErrUnknown) {
+		t.Fatalf("expected ErrUnknown, got %v", err)
+	}
+	var apiErr *Error
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusGatewayTimeout {
+		t.Fatalf("expected *Error with status 504, got %v", err)
+	}
+	if calls.Load() != 3 {
+		t.Fatalf("expected retries after 502 and 503 only, got %d attempts", calls.Load())
+	}
+}
+
+func TestRetryPostWithIdempotencyKeyOn5xx(t *testing.T) {
+	var calls atomic.Int32
+	keys := make(chan string, 3)
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		keys <- r.Header.Get("Idempotency-Key")
+		w.Header().Set("Content-Type", "application/json")
+		if calls.Add(1) < 3 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"pr":{"pull_request_id":"pr-1","pull_request_name":"name","author_id":"u1","status":"OPEN","assigned_reviewers":[]}}`))
+	})
+
+	pr, err := c.CreatePullRequest(WithIdempotencyKey(context.Background(), "create-pr-1"), "pr-1", "name", "u1")
+	if err != nil || pr.PullRequestId != "pr-1" {
+		t.Fatalf("unexpected result: %+v %v", pr, err)
+	}
+	if calls.Load() != 3 {
+		t.Fatalf("expected 3 attempts, got %d", calls.Load())
+	}
+	close(keys)
+	for key := range keys {
+		if key != "create-pr-1" {
+			t.Fatalf("expected Idempotency-Key on every attempt, got %q", key)
+		}
+	}
+}
+
+func TestRetryPostOn429(t *testing.T) {
+	var calls atomic.Int32
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if calls.Add(1) == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			_, _ = w.Write([]byte(`{"error":{"code":"RATE_LIMITED","message":"too many requests"}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"pr":{"pull_request_id":"pr-1","pull_request_name":"name","author_id":"u1","status":"OPEN","assigned_reviewers":["u3"]},"replaced_by":"u3"}`))
+	})
+
+	if _, _, err := c.ReassignReviewer(context.Background(), "pr-1", "u2"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if calls.Load() != 2 {
+		t.Fatalf("expected retry after 429, got %d attempts", calls.Load())
+	}
+}
+
+func TestTypedErrors(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusConflict)
+		_, _ = w.Write([]byte(`{"error":{"code":"PR_EXISTS","message":"PR id already exists"}}`))
+	})
+
+	_, err := c.CreatePullRequest(context.Background(), "pr-1", "name", "u1")
+	if !errors.Is(err, ErrPRExists) || errors.Is(err, ErrPRMerged) {
+		t.Fatalf("expected ErrPRExists, got %v", err)
+	}
+}
+
+func TestImportOrganizationReturnsReportWithRowErrors(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("format") != "csv" || r.Header.Get("Content-Type") != "text/csv" {
+			t.Errorf("unexpected request %s %v", r.URL, r.Header)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"dry_run":false,"applied":false,"summary":{"errors":1},"rows":[{"row":2,"kind":"member","result":"invalid","error":"user_id is required"}]}`))
+	})
+
+	report, err := c.ImportOrganization(context.Background(), []byte("team_name,user_id\n"), ImportOptions{Format: openapi.Csv})
+	if err != nil || report.Applied || report.Summary.Errors != 1 || len(report.Rows) != 1 {
+		t.Fatalf("unexpected result: %+v %v", report, err)
+	}
+}
+
+func TestPullRequestsIterator(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Query().Get("cursor") {
+		case "":
+			_, _ = w.Write([]byte(`{"pull_requests":[{"pull_request_id":"a","pull_request_name":"a","author_id":"u1","status":"OPEN"},{"pull_request_id":"b","pull_request_name":"b","author_id":"u1","status":"OPEN"}],"next_cursor":"c1"}`))
+		case "c1":
+			_, _ = w.Write([]byte(`{"pull_requests":[{"pull_request_id":"c","pull_request_name":"c","author_id":"u1","status":"OPEN"}]}`))
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error":{"code":"INVALID_CURSOR","message":"cursor is malformed"}}`))
+		}
+	})
+
+	ids := ""
+	for pr, err := range c.PullRequests(context.Background(), openapi.GetPullRequestListParams{}) {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		ids += pr.PullRequestId
+	}
+	if ids != "abc" {
+		t.Fatalf("expected abc, got %s", ids)
+	}
+
+	bad := "bad"
+	for _, err := range c.PullRequests(context.Background(), openapi.GetPullRequestListParams{Cursor: &bad}) {
+		if !errors.Is(err, ErrInvalidCursor) {
+			t.Fatalf("expected ErrInvalidCursor, got %v", err)
+		}
+	}
+}
+
+func TestStreamEvents(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("user_id") != "u1" || r.Header.Get("Last-Event-ID") != "e-1" {
+			t.Errorf("unexpected request %s %v", r.URL, r.Header)
+		}
+		w.Header().Set("Content-Type", "text/event-stream")
+		_, _ = w.Write([]byte("retry: 3000\n\nevent: reset\ndata: {}\n\n: heartbeat\n\n" +
+			"id: e-2\nevent: reviewer.assigned\ndata: {\"id\":\"e-2\",\"type\":\"reviewer.assigned\",\"at\":\"2025-10-24T12:00:00Z\"," +
+			"\"pull_request_id\":\"pr-1\",\"pull_request_name\":\"name\",\"author_id\":\"u2\",\"reviewer_id\":\"u1\"}\n\n"))
+	})
+
+	var got []StreamEvent
+	userID, lastID := "u1", "e-1"
+	err := c.StreamEvents(context.Background(), openapi.GetEventsStreamParams{UserId: &userID, LastEventID: &lastID}, func(e StreamEvent) error {
+		got = append(got, e)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(got) != 2 || !got[0].Reset || got[1].Event.Id != "e-2" || got[1].Event.Type != openapi.ReviewerAssigned || *got[1].Event.ReviewerId != "u1" {
+		t.Fatalf("unexpected events: %+v", got)
+	}
+}
+
+func TestGraphQL(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		var req openapi.GraphQLRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil || r.URL.Path != "/api/graphql" || (*req.Variables)["team"] != "core" {
+			t.Errorf("unexpected request %s %+v %v", r.URL, req, err)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":{"team":{"name":"core"},"pullRequest":null},` +
+			`"errors":[{"message":"PR id exists in several repositories, specify repository","path":["pullRequest"],"extensions":{"code":"PR_AMBIGUOUS"}}]}`))
+	})
+
+	var out struct {
+		Team struct{ Name string }
+	}
+	err := c.GraphQL(context.Background(), `query($team: String!) { team(name: $team) { name } pullRequest(id: "pr-1") { id } }`, map[string]any{"team": "core"}, &out)
+	if !errors.Is(err, ErrPRAmbiguous) {
+		t.Fatalf("expected ErrPRAmbiguous, got %v", err)
+	}
+	if out.Team.Name != "core" {
+		t.Fatalf("partial data not decoded: %+v", out)
+	}
+}
@@ -0,0 +1,90 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	openapi "github.com/wozhdeleniye/avito-tech-internship/api"
+)
+
+// ошибка сервиса из ErrorResponse; errors.Is сравнивает по Code
+//
+//	if errors.Is(err, client.ErrTeamNotFound) { ... }
+type Error struct {
+	StatusCode int
+	Code       string
+	Message    string
+	// ошибки по полям для INVALID_REQUEST
+	Details []openapi.FieldError
+}
+
+func (e *Error) Error() string {
+	if e.Code == "" {
+		return fmt.Sprintf("HTTP %d: %s", e.StatusCode, e.Message)
+	}
+	return fmt.Sprintf("%s: %s (HTTP %d)", e.Code, e.Message, e.StatusCode)
+}
+
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+	return ok && t.Code != "" && t.Code == e.Code
+}
+
+var (
+	ErrTeamExists    = &Error{Code: "TEAM_EXISTS"}
+	ErrTeamNotFound  = &Error{Code: "TEAM_NOT_FOUND"}
+	ErrTeamNotEmpty  = &Error{Code: "TEAM_NOT_EMPTY"}
+	ErrNotTeamMember = &Error{Code: "NOT_TEAM_MEMBER"}
+	ErrUserNotFound  = &Error{Code: "USER_NOT_FOUND"}
+	ErrUserExists    = &Error{Code: "USER_EXISTS"}
+	ErrInvalidRole   = &Error{Code: "INVALID_ROLE"}
+	ErrInvalidSLA    = &Error{Code: "INVALID_SLA"}
+)
+var (
+	ErrNotFound              = &Error{Code: "NOT_FOUND"}
+	ErrPRNotFound            = &Error{Code: "PR_NOT_FOUND"}
+	ErrJobNotFound           = &Error{Code: "JOB_NOT_FOUND"}
+	ErrChangesetNotFound     = &Error{Code: "CHANGESET_NOT_FOUND"}
+	ErrChangesetReverted     = &Error{Code: "CHANGESET_REVERTED"}
+	ErrChangesetInProgress   = &Error{Code: "CHANGESET_IN_PROGRESS"}
+	ErrPRExists              = &Error{Code: "PR_EXISTS"}
+	ErrPRAmbiguous           = &Error{Code: "PR_AMBIGUOUS"}
+	ErrRepositoryNotFound    = &Error{Code: "REPOSITORY_NOT_FOUND"}
+	ErrRepositoryExists      = &Error{Code: "REPOSITORY_EXISTS"}
+	ErrOrganizationNotFound  = &Error{Code: "ORGANIZATION_NOT_FOUND"}
+	ErrOrganizationExists    = &Error{Code: "ORGANIZATION_EXISTS"}
+	ErrIdempotencyInProgress = &Error{Code: "IDEMPOTENCY_IN_PROGRESS"}
+	ErrIdempotencyKeyReused  = &Error{Code: "IDEMPOTENCY_KEY_REUSED"}
+	ErrRateLimited           = &Error{Code: "RATE_LIMITED"}
+	ErrPRMerged              = &Error{Code: "PR_MERGED"}
+	ErrNotAssigned           = &Error{Code: "NOT_ASSIGNED"}
+	ErrNoCandidate           = &Error{Code: "NO_CANDIDATE"}
+	ErrNoAvailableReviewers  = &Error{Code: "NO_AVAILABLE_REVIEWERS"}
+)
+var (
+	ErrInvalidCursor     = &Error{Code: "INVALID_CURSOR"}
+	ErrInvalidLimit      = &Error{Code: "INVALID_LIMIT"}
+	ErrInvalidQuery      = &Error{Code: "INVALID_QUERY"}
+	ErrInvalidRequest    = &Error{Code: "INVALID_REQUEST"}
+	ErrInvalidFormat     = &Error{Code: "INVALID_FORMAT"}
+	ErrInvalidImportFile = &Error{Code: "INVALID_IMPORT_FILE"}
+	ErrMethodNotAllowed  = &Error{Code: "METHOD_NOT_ALLOWED"}
+	ErrUnauthorized      = &Error{Code: "UNAUTHORIZED"}
+	ErrInvalidToken      = &Error{Code: "INVALID_TOKEN"}
+	ErrForbidden         = &Error{Code: "FORBIDDEN"}
+	ErrUnknown           = &Error{Code: "UNKNOWN_ERROR"}
+)
+
+// ответ без ожидаемого тела; если тело не ErrorResponse, Code пустой, а Message - текст ответа
+func apiError(status int, body []byte) error {
+	var resp openapi.ErrorResponse
+	if err := json.Unmarshal(body, &resp); err == nil && resp.Error.Code != "" {
+		e := &Error{StatusCode: status, Code: string(resp.Error.Code), Message: resp.Error.Message}
+		if resp.Error.Details != nil {
+			e.Details = *resp.Error.Details
+		}
+		return e
+	}
+	return &Error{StatusCode: status, Message: strings.TrimSpace(string(body))}
+}
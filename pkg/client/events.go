@@ -0,0 +1,72 @@
+package client
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	openapi "github.com/wozhdeleniye/avito-tech-internship/api"
+)
+
+// событие потока /events/stream. Reset - сервис не смог продолжить с LastEventID (идентификатор старше
+// его буфера или сервис перезапущен), состояние стоит перечитать; Event у него пустой
+type StreamEvent struct {
+	Reset bool
+	Event openapi.ReviewEvent
+}
+
+// читает поток событий пользователя или команды и вызывает handle для каждого события. Возвращает nil, когда
+// сервис закрыл поток (остановка или медленное чтение): для продолжения нужно вызвать снова с LastEventID,
+// равным Id последнего события. Ошибка handle или отмена ctx прерывают чтение
+func (c *Client) StreamEvents(ctx context.Context, params openapi.GetEventsStreamParams, handle func(StreamEvent) error) error {
+	res, err := c.stream.GetEventsStream(ctx, &params)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(res.Body)
+		return apiError(res.StatusCode, body)
+	}
+
+	scanner := bufio.NewScanner(res.Body)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1<<20)
+	var event string
+	var data []string
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line != "" {
+			field, value, _ := strings.Cut(line, ":")
+			value = strings.TrimPrefix(value, " ")
+			switch field {
+			case "event":
+				event = value
+			case "data":
+				data = append(data, value)
+			}
+			continue
+		}
+
+		// пустая строка завершает событие; комментарии-пульс и retry событий не образуют
+		if event != "" || len(data) > 0 {
+			se := StreamEvent{Reset: event == "reset"}
+			if !se.Reset {
+				if err := json.Unmarshal([]byte(strings.Join(data, "\n")), &se.Event); err != nil {
+					return fmt.Errorf("event %s: %w", event, err)
+				}
+			}
+			if err := handle(se); err != nil {
+				return err
+			}
+		}
+		event, data = "", nil
+	}
+	if err := scanner.Err(); err != nil && ctx.Err() == nil {
+		return err
+	}
+	return ctx.Err()
+}
@@ -0,0 +1,46 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+
+	openapi "github.com/wozhdeleniye/avito-tech-internship/api"
+)
+
+// выполняет запрос GraphQL и разбирает data в out (nil - не разбирать); при ошибках в ответе возвращает первую из них
+// как *Error с кодом из extensions.code, частичный data при этом уже разобран в out
+func (c *Client) GraphQL(ctx context.Context, query string, variables map[string]any, out any) error {
+	body := openapi.PostGraphqlJSONRequestBody{Query: query}
+	if variables != nil {
+		body.Variables = &variables
+	}
+	res, err := c.api.PostGraphqlWithResponse(ctx, body)
+	if err != nil {
+		return err
+	}
+	if res.JSON200 == nil {
+		return apiError(res.StatusCode(), res.Body)
+	}
+
+	var resp struct {
+		Data   json.RawMessage        `json:"data"`
+		Errors []openapi.GraphQLError `json:"errors"`
+	}
+	if err := json.Unmarshal(res.Body, &resp); err != nil {
+		return err
+	}
+	if out != nil && len(resp.Data) > 0 && string(resp.Data) != "null" {
+		if err := json.Unmarshal(resp.Data, out); err != nil {
+			return err
+		}
+	}
+	if len(resp.Errors) > 0 {
+		first := resp.Errors[0]
+		e := &Error{StatusCode: res.StatusCode(), Message: first.Message}
+		if first.Extensions != nil {
+			e.Code, _ = (*first.Extensions)["code"].(string)
+		}
+		return e
+	}
+	return nil
+}
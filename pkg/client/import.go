@@ -0,0 +1,45 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+
+	openapi "github.com/wozhdeleniye/avito-tech-internship/api"
+)
+
+// параметры /admin/import: Format - yaml или csv (по умолчанию yaml), DryRun проверяет файл без сохранения
+type ImportOptions struct {
+	Format openapi.PostAdminImportParamsFormat
+	DryRun bool
+}
+
+// импорт файла организации через /admin/import. Файл с ошибками в строках не применяется, но это не ошибка
+// вызова: возвращается отчёт с Applied=false и Summary.Errors > 0
+func (c *Client) ImportOrganization(ctx context.Context, data []byte, opts ImportOptions) (*openapi.ImportReport, error) {
+	format := opts.Format
+	if format == "" {
+		format = openapi.Yaml
+	}
+	contentType := "application/yaml"
+	if format == openapi.Csv {
+		contentType = "text/csv"
+	}
+
+	res, err := c.api.PostAdminImportWithBodyWithResponse(ctx, &openapi.PostAdminImportParams{Format: &format, DryRun: &opts.DryRun},
+		contentType, bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	if res.JSON200 != nil {
+		return res.JSON200, nil
+	}
+	if res.StatusCode() == http.StatusBadRequest {
+		var report openapi.ImportReport
+		if err := json.Unmarshal(res.Body, &report); err == nil && report.Rows != nil {
+			return &report, nil
+		}
+	}
+	return nil, apiError(res.StatusCode(), res.Body)
+}
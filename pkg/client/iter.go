@@ -0,0 +1,27 @@
+package client
+
+import "iter"
+
+// обходит страницы по next_cursor, начиная со start; ошибка отдаётся последним элементом, после неё обход прекращается
+func paginate[T any](start *string, fetch func(cursor *string) ([]T, *string, error)) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		cursor := start
+		for {
+			items, next, err := fetch(cursor)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			if next == nil || *next == "" {
+				return
+			}
+			cursor = next
+		}
+	}
+}
@@ -0,0 +1,30 @@
+package client
+
+import (
+	"context"
+
+	openapi "github.com/wozhdeleniye/avito-tech-internship/api"
+)
+
+// создаёт организацию; для занятого slug - ErrOrganizationExists
+func (c *Client) CreateOrganization(ctx context.Context, slug string, name string) (*openapi.Organization, error) {
+	res, err := c.api.PostAdminOrganizationsWithResponse(ctx, openapi.PostAdminOrganizationsJSONRequestBody{Slug: slug, Name: name})
+	if err != nil {
+		return nil, err
+	}
+	if res.JSON201 == nil {
+		return nil, apiError(res.StatusCode(), res.Body)
+	}
+	return &res.JSON201.Organization, nil
+}
+
+func (c *Client) ListOrganizations(ctx context.Context) ([]openapi.Organization, error) {
+	res, err := c.api.GetAdminOrganizationsWithResponse(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if res.JSON200 == nil {
+		return nil, apiError(res.StatusCode(), res.Body)
+	}
+	return res.JSON200.Organizations, nil
+}
@@ -0,0 +1,116 @@
+package client
+
+import (
+	"context"
+	"iter"
+
+	openapi "github.com/wozhdeleniye/avito-tech-internship/api"
+)
+
+// создаёт PR и назначает ревьюверов из основной команды автора
+func (c *Client) CreatePullRequest(ctx context.Context, pullRequestID string, name string, authorID string) (*openapi.PullRequest, error) {
+	res, err := c.api.PostPullRequestCreateWithResponse(ctx, openapi.PostPullRequestCreateJSONRequestBody{
+		PullRequestId:   pullRequestID,
+		PullRequestName: name,
+		AuthorId:        authorID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if res.JSON201 == nil || res.JSON201.Pr == nil {
+		return nil, apiError(res.StatusCode(), res.Body)
+	}
+	return res.JSON201.Pr, nil
+}
+
+// идемпотентно: повторный merge возвращает уже смерженный PR
+func (c *Client) MergePullRequest(ctx context.Context, pullRequestID string) (*openapi.PullRequest, error) {
+	res, err := c.api.PostPullRequestMergeWithResponse(ctx, openapi.PostPullRequestMergeJSONRequestBody{PullRequestId: pullRequestID})
+	if err != nil {
+		return nil, err
+	}
+	if res.JSON200 == nil || res.JSON200.Pr == nil {
+		return nil, apiError(res.StatusCode(), res.Body)
+	}
+	return res.JSON200.Pr, nil
+}
+
+// заменяет ревьювера oldUserID; возвращает PR и user_id нового ревьювера
+func (c *Client) ReassignReviewer(ctx context.Context, pullRequestID string, oldUserID string) (*openapi.PullRequest, string, error) {
+	res, err := c.api.PostPullRequestReassignWithResponse(ctx, openapi.PostPullRequestReassignJSONRequestBody{PullRequestId: pullRequestID, OldUserId: oldUserID})
+	if err != nil {
+		return nil, "", err
+	}
+	if res.JSON200 == nil {
+		return nil, "", apiError(res.StatusCode(), res.Body)
+	}
+	return &res.JSON200.Pr, res.JSON200.ReplacedBy, nil
+}
+
+// фиксирует ответ назначенного ревьювера
+func (c *Client) SubmitReview(ctx context.Context, pullRequestID string, userID string) (*openapi.PullRequestDetail, error) {
+	res, err := c.api.PostPullRequestReviewWithResponse(ctx, openapi.PostPullRequestReviewJSONRequestBody{PullRequestId: pullRequestID, UserId: userID})
+	if err != nil {
+		return nil, err
+	}
+	if res.JSON200 == nil {
+		return nil, apiError(res.StatusCode(), res.Body)
+	}
+	return &res.JSON200.Pr, nil
+}
+
+// PR с ревьюверами и историей событий
+func (c *Client) GetPullRequest(ctx context.Context, pullRequestID string) (*openapi.PullRequestDetail, error) {
+	res, err := c.api.GetPullRequestGetWithResponse(ctx, &openapi.GetPullRequestGetParams{PullRequestId: pullRequestID})
+	if err != nil {
+		return nil, err
+	}
+	if res.JSON200 == nil {
+		return nil, apiError(res.StatusCode(), res.Body)
+	}
+	return &res.JSON200.Pr, nil
+}
+
+// полнотекстовый поиск по названию; выдача не постраничная, размер ограничен params.Limit
+func (c *Client) SearchPullRequests(ctx context.Context, params openapi.GetPullRequestSearchParams) ([]openapi.PullRequestShort, error) {
+	res, err := c.api.GetPullRequestSearchWithResponse(ctx, &params)
+	if err != nil {
+		return nil, err
+	}
+	if res.JSON200 == nil {
+		return nil, apiError(res.StatusCode(), res.Body)
+	}
+	return res.JSON200.PullRequests, nil
+}
+
+// PR по всем страницам /pullRequest/list; Limit задаёт размер страницы, Cursor - начальную страницу
+func (c *Client) PullRequests(ctx context.Context, params openapi.GetPullRequestListParams) iter.Seq2[openapi.PullRequestShort, error] {
+	return paginate(params.Cursor, func(cursor *string) ([]openapi.PullRequestShort, *string, error) {
+		p := params
+		p.Cursor = cursor
+		res, err := c.api.GetPullRequestListWithResponse(ctx, &p)
+		if err != nil {
+			return nil, nil, err
+		}
+		if res.JSON200 == nil {
+			return nil, nil, apiError(res.StatusCode(), res.Body)
+		}
+		return res.JSON200.PullRequests, res.JSON200.NextCursor, nil
+	})
+}
+
+// журнал аудита PR по всем страницам /pullRequest/history
+func (c *Client) PullRequestHistory(ctx context.Context, params openapi.GetPullRequestHistoryParams) iter.Seq2[openapi.AuditEntry, error] {
+	return paginate(params.Cursor, func(cursor *string) ([]openapi.AuditEntry, *string, error) {
+		p := params
+		p.Cursor = cursor
+		res, err := c.api.GetPullRequestHistoryWithResponse(ctx, &p)
+		if err != nil {
+			return nil, nil, err
+		}
+		if res.JSON200 == nil {
+			return nil, nil, apiError(res.StatusCode(), res.Body)
+		}
+		return res.JSON200.Entries, res.JSON200.NextCursor, nil
+	})
+}
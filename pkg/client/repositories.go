@@ -0,0 +1,42 @@
+package client
+
+import (
+	"context"
+
+	openapi "github.com/wozhdeleniye/avito-tech-internship/api"
+)
+
+// регистрирует репозиторий команды; для занятого имени - ErrRepositoryExists
+func (c *Client) AddRepository(ctx context.Context, repo openapi.Repository) (*openapi.Repository, error) {
+	res, err := c.api.PostRepositoryAddWithResponse(ctx, repo)
+	if err != nil {
+		return nil, err
+	}
+	if res.JSON201 == nil {
+		return nil, apiError(res.StatusCode(), res.Body)
+	}
+	return &res.JSON201.Repository, nil
+}
+
+func (c *Client) GetRepository(ctx context.Context, name string) (*openapi.Repository, error) {
+	res, err := c.api.GetRepositoryGetWithResponse(ctx, &openapi.GetRepositoryGetParams{Name: name})
+	if err != nil {
+		return nil, err
+	}
+	if res.JSON200 == nil {
+		return nil, apiError(res.StatusCode(), res.Body)
+	}
+	return &res.JSON200.Repository, nil
+}
+
+// заменяет политику назначения ревьюверов; пустые поля policy возвращаются к значениям по умолчанию
+func (c *Client) SetRepositoryPolicy(ctx context.Context, name string, policy openapi.ReviewerPolicy) (*openapi.Repository, error) {
+	res, err := c.api.PostRepositorySetPolicyWithResponse(ctx, openapi.PostRepositorySetPolicyJSONRequestBody{Name: name, ReviewerPolicy: policy})
+	if err != nil {
+		return nil, err
+	}
+	if res.JSON200 == nil {
+		return nil, apiError(res.StatusCode(), res.Body)
+	}
+	return &res.JSON200.Repository, nil
+}
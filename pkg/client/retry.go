@@ -0,0 +1,97 @@
+package client
+
+import (
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+
+	openapi "github.com/wozhdeleniye/avito-tech-internship/api"
+)
+
+// повторы запросов: GET и POST с Idempotency-Key повторяются при сетевой ошибке и любом 5xx, остальные POST -
+// только при 502 и 503, когда запрос не дошёл до сервиса; 504 означает, что сервис мог его уже выполнить
+// (создание PR и переназначение не идемпотентны).
+// 429 повторяется для любого запроса: сервис отклонил его до обработки
+type RetryPolicy struct {
+	MaxAttempts int
+	MinBackoff  time.Duration
+	MaxBackoff  time.Duration
+}
+
+var DefaultRetryPolicy = RetryPolicy{MaxAttempts: 3, MinBackoff: 200 * time.Millisecond, MaxBackoff: 5 * time.Second}
+
+type retryDoer struct {
+	next   openapi.HttpRequestDoer
+	policy RetryPolicy
+}
+
+func (d *retryDoer) Do(req *http.Request) (*http.Response, error) {
+	// тело без GetBody нельзя отправить повторно
+	replayable := req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+	for attempt := 1; ; attempt++ {
+		res, err := d.next.Do(req)
+		if !replayable || attempt >= d.policy.MaxAttempts || !retryable(req, res, err) {
+			return res, err
+		}
+
+		wait := d.backoff(attempt, res)
+		if res != nil {
+			_, _ = io.Copy(io.Discard, res.Body)
+			res.Body.Close()
+		}
+		timer := time.NewTimer(wait)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req.Body = body
+		}
+	}
+}
+
+func retryable(req *http.Request, res *http.Response, err error) bool {
+	if req.Context().Err() != nil {
+		return false
+	}
+	if err == nil && res.StatusCode == http.StatusTooManyRequests {
+		return true
+	}
+	if req.Method == http.MethodGet || req.Header.Get("Idempotency-Key") != "" {
+		return err != nil || res.StatusCode >= 500
+	}
+	if err != nil {
+		return false
+	}
+	switch res.StatusCode {
+	case http.StatusBadGateway, http.StatusServiceUnavailable:
+		return true
+	}
+	return false
+}
+
+// MinBackoff*2^(attempt-1) со случайной добавкой до 50%, не больше MaxBackoff; Retry-After в секундах имеет приоритет
+func (d *retryDoer) backoff(attempt int, res *http.Response) time.Duration {
+	if res != nil {
+		if s, err := strconv.Atoi(res.Header.Get("Retry-After")); err == nil && s >= 0 {
+			return min(time.Duration(s)*time.Second, d.policy.MaxBackoff)
+		}
+	}
+	wait := d.policy.MinBackoff << (attempt - 1)
+	if wait > 0 {
+		wait += time.Duration(rand.Int63n(int64(wait)/2 + 1))
+	}
+	if wait > d.policy.MaxBackoff || wait < 0 {
+		wait = d.policy.MaxBackoff
+	}
+	return wait
+}
@@ -0,0 +1,116 @@
+package client
+
+import (
+	"context"
+
+	openapi "github.com/wozhdeleniye/avito-tech-internship/api"
+)
+
+// параметры /team/add: Prune исключает неперечисленных участников, DryRun возвращает diff без сохранения
+type SyncOptions struct {
+	Prune  bool
+	DryRun bool
+}
+
+// декларативная синхронизация команды через /team/add
+func (c *Client) SyncTeam(ctx context.Context, team openapi.Team, opts SyncOptions) (*openapi.TeamSyncResult, error) {
+	res, err := c.api.PostTeamAddWithResponse(ctx, &openapi.PostTeamAddParams{Prune: &opts.Prune, DryRun: &opts.DryRun}, team)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case res.JSON201 != nil:
+		return res.JSON201, nil
+	case res.JSON200 != nil:
+		return res.JSON200, nil
+	}
+	return nil, apiError(res.StatusCode(), res.Body)
+}
+
+func (c *Client) GetTeam(ctx context.Context, teamName string) (*openapi.Team, error) {
+	res, err := c.api.GetTeamGetWithResponse(ctx, &openapi.GetTeamGetParams{TeamName: teamName})
+	if err != nil {
+		return nil, err
+	}
+	if res.JSON200 == nil {
+		return nil, apiError(res.StatusCode(), res.Body)
+	}
+	return res.JSON200, nil
+}
+
+func (c *Client) RenameTeam(ctx context.Context, teamName string, newTeamName string) (*openapi.Team, error) {
+	res, err := c.api.PostTeamRenameWithResponse(ctx, openapi.PostTeamRenameJSONRequestBody{TeamName: teamName, NewTeamName: newTeamName})
+	if err != nil {
+		return nil, err
+	}
+	if res.JSON200 == nil || res.JSON200.Team == nil {
+		return nil, apiError(res.StatusCode(), res.Body)
+	}
+	return res.JSON200.Team, nil
+}
+
+// удаляет пустую команду, для команды с участниками - ErrTeamNotEmpty
+func (c *Client) DeleteTeam(ctx context.Context, teamName string) error {
+	res, err := c.api.PostTeamDeleteWithResponse(ctx, openapi.PostTeamDeleteJSONRequestBody{TeamName: teamName})
+	if err != nil {
+		return err
+	}
+	if res.JSON200 == nil {
+		return apiError(res.StatusCode(), res.Body)
+	}
+	return nil
+}
+
+// добавляет членство в команде; пустая role - роль по умолчанию (member)
+func (c *Client) AddMembers(ctx context.Context, teamName string, userIDs []string, role openapi.TeamMemberRole) (*openapi.Team, error) {
+	body := openapi.PostTeamAddMembersJSONRequestBody{TeamName: teamName, UserIds: userIDs}
+	if role != "" {
+		body.Role = &role
+	}
+	res, err := c.api.PostTeamAddMembersWithResponse(ctx, body)
+	if err != nil {
+		return nil, err
+	}
+	if res.JSON200 == nil || res.JSON200.Team == nil {
+		return nil, apiError(res.StatusCode(), res.Body)
+	}
+	return res.JSON200.Team, nil
+}
+
+func (c *Client) RemoveMembers(ctx context.Context, teamName string, userIDs []string) (*openapi.TeamMembersChange, error) {
+	res, err := c.api.PostTeamRemoveMembersWithResponse(ctx, openapi.PostTeamRemoveMembersJSONRequestBody{TeamName: teamName, UserIds: userIDs})
+	if err != nil {
+		return nil, err
+	}
+	if res.JSON200 == nil {
+		return nil, apiError(res.StatusCode(), res.Body)
+	}
+	return res.JSON200, nil
+}
+
+// переводит пользователя в команду toTeamName; пустой fromTeamName - из основной команды
+func (c *Client) MoveMember(ctx context.Context, userID string, toTeamName string, fromTeamName string) (*openapi.TeamMembersChange, error) {
+	body := openapi.PostTeamMoveMemberJSONRequestBody{UserId: userID, ToTeamName: toTeamName}
+	if fromTeamName != "" {
+		body.FromTeamName = &fromTeamName
+	}
+	res, err := c.api.PostTeamMoveMemberWithResponse(ctx, body)
+	if err != nil {
+		return nil, err
+	}
+	if res.JSON200 == nil {
+		return nil, apiError(res.StatusCode(), res.Body)
+	}
+	return res.JSON200, nil
+}
+
+func (c *Client) SetTeamSLA(ctx context.Context, teamName string, firstReviewHours int, action openapi.SlaAction) (*openapi.Team, error) {
+	res, err := c.api.PostTeamSetSlaWithResponse(ctx, openapi.PostTeamSetSlaJSONRequestBody{TeamName: teamName, FirstReviewHours: firstReviewHours, Action: action})
+	if err != nil {
+		return nil, err
+	}
+	if res.JSON200 == nil || res.JSON200.Team == nil {
+		return nil, apiError(res.StatusCode(), res.Body)
+	}
+	return res.JSON200.Team, nil
+}
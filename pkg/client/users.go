@@ -0,0 +1,49 @@
+package client
+
+import (
+	"context"
+	"iter"
+
+	openapi "github.com/wozhdeleniye/avito-tech-internship/api"
+)
+
+func (c *Client) SetUserActive(ctx context.Context, userID string, active bool) (*openapi.User, error) {
+	change, err := c.setUserActive(ctx, openapi.PostUsersSetIsActiveJSONRequestBody{UserId: userID, IsActive: active})
+	if err != nil {
+		return nil, err
+	}
+	return &change.User, nil
+}
+
+// деактивирует пользователя и переназначает его открытые ревью; PR без замены - в NotReassigned
+func (c *Client) DeactivateUser(ctx context.Context, userID string) (*openapi.UserActivityChange, error) {
+	reassign := true
+	return c.setUserActive(ctx, openapi.PostUsersSetIsActiveJSONRequestBody{UserId: userID, IsActive: false, ReassignReviews: &reassign})
+}
+
+func (c *Client) setUserActive(ctx context.Context, body openapi.PostUsersSetIsActiveJSONRequestBody) (*openapi.UserActivityChange, error) {
+	res, err := c.api.PostUsersSetIsActiveWithResponse(ctx, body)
+	if err != nil {
+		return nil, err
+	}
+	if res.JSON200 == nil {
+		return nil, apiError(res.StatusCode(), res.Body)
+	}
+	return res.JSON200, nil
+}
+
+// PR, где пользователь ревьювер, по всем страницам /users/getReview; Cursor в params - начальная страница
+func (c *Client) UserReviews(ctx context.Context, params openapi.GetUsersGetReviewParams) iter.Seq2[openapi.PullRequestShort, error] {
+	return paginate(params.Cursor, func(cursor *string) ([]openapi.PullRequestShort, *string, error) {
+		p := params
+		p.Cursor = cursor
+		res, err := c.api.GetUsersGetReviewWithResponse(ctx, &p)
+		if err != nil {
+			return nil, nil, err
+		}
+		if res.JSON200 == nil {
+			return nil, nil, apiError(res.StatusCode(), res.Body)
+		}
+		return res.JSON200.PullRequests, res.JSON200.NextCursor, nil
+	})
+}